@@ -41,6 +41,8 @@ const _ = grpc.SupportPackageIsVersion7
 const (
 	QueryService_ERC20AddressForCoinDenom_FullMethodName = "/jinx.erc20.v1alpha1.QueryService/ERC20AddressForCoinDenom"
 	QueryService_CoinDenomForERC20Address_FullMethodName = "/jinx.erc20.v1alpha1.QueryService/CoinDenomForERC20Address"
+	QueryService_ERC20Pairs_FullMethodName               = "/jinx.erc20.v1alpha1.QueryService/ERC20Pairs"
+	QueryService_ERC20Pair_FullMethodName                = "/jinx.erc20.v1alpha1.QueryService/ERC20Pair"
 )
 
 // QueryServiceClient is the client API for QueryService service.
@@ -51,6 +53,11 @@ type QueryServiceClient interface {
 	ERC20AddressForCoinDenom(ctx context.Context, in *ERC20AddressForCoinDenomRequest, opts ...grpc.CallOption) (*ERC20AddressForCoinDenomResponse, error)
 	// CoinDenomForERC20Address queries the SDK coin denomination for a given ERC20 token address.
 	CoinDenomForERC20Address(ctx context.Context, in *CoinDenomForERC20AddressRequest, opts ...grpc.CallOption) (*CoinDenomForERC20AddressResponse, error)
+	// ERC20Pairs queries all the registered ERC20 token <> SDK coin denomination pairs.
+	ERC20Pairs(ctx context.Context, in *ERC20PairsRequest, opts ...grpc.CallOption) (*ERC20PairsResponse, error)
+	// ERC20Pair queries the ERC20 token <> SDK coin denomination pair of a given SDK coin
+	// denomination or ERC20 token address.
+	ERC20Pair(ctx context.Context, in *ERC20PairRequest, opts ...grpc.CallOption) (*ERC20PairResponse, error)
 }
 
 type queryServiceClient struct {
@@ -79,6 +86,24 @@ func (c *queryServiceClient) CoinDenomForERC20Address(ctx context.Context, in *C
 	return out, nil
 }
 
+func (c *queryServiceClient) ERC20Pairs(ctx context.Context, in *ERC20PairsRequest, opts ...grpc.CallOption) (*ERC20PairsResponse, error) {
+	out := new(ERC20PairsResponse)
+	err := c.cc.Invoke(ctx, QueryService_ERC20Pairs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) ERC20Pair(ctx context.Context, in *ERC20PairRequest, opts ...grpc.CallOption) (*ERC20PairResponse, error) {
+	out := new(ERC20PairResponse)
+	err := c.cc.Invoke(ctx, QueryService_ERC20Pair_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServiceServer is the server API for QueryService service.
 // All implementations must embed UnimplementedQueryServiceServer
 // for forward compatibility
@@ -87,6 +112,11 @@ type QueryServiceServer interface {
 	ERC20AddressForCoinDenom(context.Context, *ERC20AddressForCoinDenomRequest) (*ERC20AddressForCoinDenomResponse, error)
 	// CoinDenomForERC20Address queries the SDK coin denomination for a given ERC20 token address.
 	CoinDenomForERC20Address(context.Context, *CoinDenomForERC20AddressRequest) (*CoinDenomForERC20AddressResponse, error)
+	// ERC20Pairs queries all the registered ERC20 token <> SDK coin denomination pairs.
+	ERC20Pairs(context.Context, *ERC20PairsRequest) (*ERC20PairsResponse, error)
+	// ERC20Pair queries the ERC20 token <> SDK coin denomination pair of a given SDK coin
+	// denomination or ERC20 token address.
+	ERC20Pair(context.Context, *ERC20PairRequest) (*ERC20PairResponse, error)
 	mustEmbedUnimplementedQueryServiceServer()
 }
 
@@ -100,6 +130,12 @@ func (UnimplementedQueryServiceServer) ERC20AddressForCoinDenom(context.Context,
 func (UnimplementedQueryServiceServer) CoinDenomForERC20Address(context.Context, *CoinDenomForERC20AddressRequest) (*CoinDenomForERC20AddressResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CoinDenomForERC20Address not implemented")
 }
+func (UnimplementedQueryServiceServer) ERC20Pairs(context.Context, *ERC20PairsRequest) (*ERC20PairsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ERC20Pairs not implemented")
+}
+func (UnimplementedQueryServiceServer) ERC20Pair(context.Context, *ERC20PairRequest) (*ERC20PairResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ERC20Pair not implemented")
+}
 func (UnimplementedQueryServiceServer) mustEmbedUnimplementedQueryServiceServer() {}
 
 // UnsafeQueryServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -149,6 +185,42 @@ func _QueryService_CoinDenomForERC20Address_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _QueryService_ERC20Pairs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ERC20PairsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).ERC20Pairs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueryService_ERC20Pairs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).ERC20Pairs(ctx, req.(*ERC20PairsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_ERC20Pair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ERC20PairRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).ERC20Pair(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QueryService_ERC20Pair_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).ERC20Pair(ctx, req.(*ERC20PairRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // QueryService_ServiceDesc is the grpc.ServiceDesc for QueryService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -164,6 +236,14 @@ var QueryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CoinDenomForERC20Address",
 			Handler:    _QueryService_CoinDenomForERC20Address_Handler,
 		},
+		{
+			MethodName: "ERC20Pairs",
+			Handler:    _QueryService_ERC20Pairs_Handler,
+		},
+		{
+			MethodName: "ERC20Pair",
+			Handler:    _QueryService_ERC20Pair_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "jinx/erc20/v1alpha1/query.proto",
@@ -40,6 +40,8 @@ const _ = grpc.SupportPackageIsVersion7
 
 const (
 	MsgService_EthTransaction_FullMethodName = "/jinx.evm.v1alpha1.MsgService/EthTransaction"
+	MsgService_EthereumCall_FullMethodName   = "/jinx.evm.v1alpha1.MsgService/EthereumCall"
+	MsgService_EthereumCreate_FullMethodName = "/jinx.evm.v1alpha1.MsgService/EthereumCreate"
 )
 
 // MsgServiceClient is the client API for MsgService service.
@@ -48,6 +50,10 @@ const (
 type MsgServiceClient interface {
 	// EthTransaction defines a method submitting Ethereum transactions.
 	EthTransaction(ctx context.Context, in *WrappedEthereumTransaction, opts ...grpc.CallOption) (*WrappedEthereumTransactionResult, error)
+	// EthereumCall defines a method for Cosmos accounts to call EVM contracts.
+	EthereumCall(ctx context.Context, in *MsgEthereumCall, opts ...grpc.CallOption) (*MsgEthereumCallResponse, error)
+	// EthereumCreate defines a method for Cosmos accounts to deploy EVM contracts.
+	EthereumCreate(ctx context.Context, in *MsgEthereumCreate, opts ...grpc.CallOption) (*MsgEthereumCreateResponse, error)
 }
 
 type msgServiceClient struct {
@@ -67,12 +73,34 @@ func (c *msgServiceClient) EthTransaction(ctx context.Context, in *WrappedEthere
 	return out, nil
 }
 
+func (c *msgServiceClient) EthereumCall(ctx context.Context, in *MsgEthereumCall, opts ...grpc.CallOption) (*MsgEthereumCallResponse, error) {
+	out := new(MsgEthereumCallResponse)
+	err := c.cc.Invoke(ctx, MsgService_EthereumCall_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgServiceClient) EthereumCreate(ctx context.Context, in *MsgEthereumCreate, opts ...grpc.CallOption) (*MsgEthereumCreateResponse, error) {
+	out := new(MsgEthereumCreateResponse)
+	err := c.cc.Invoke(ctx, MsgService_EthereumCreate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServiceServer is the server API for MsgService service.
 // All implementations must embed UnimplementedMsgServiceServer
 // for forward compatibility
 type MsgServiceServer interface {
 	// EthTransaction defines a method submitting Ethereum transactions.
 	EthTransaction(context.Context, *WrappedEthereumTransaction) (*WrappedEthereumTransactionResult, error)
+	// EthereumCall defines a method for Cosmos accounts to call EVM contracts.
+	EthereumCall(context.Context, *MsgEthereumCall) (*MsgEthereumCallResponse, error)
+	// EthereumCreate defines a method for Cosmos accounts to deploy EVM contracts.
+	EthereumCreate(context.Context, *MsgEthereumCreate) (*MsgEthereumCreateResponse, error)
 	mustEmbedUnimplementedMsgServiceServer()
 }
 
@@ -83,6 +111,12 @@ type UnimplementedMsgServiceServer struct {
 func (UnimplementedMsgServiceServer) EthTransaction(context.Context, *WrappedEthereumTransaction) (*WrappedEthereumTransactionResult, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EthTransaction not implemented")
 }
+func (UnimplementedMsgServiceServer) EthereumCall(context.Context, *MsgEthereumCall) (*MsgEthereumCallResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EthereumCall not implemented")
+}
+func (UnimplementedMsgServiceServer) EthereumCreate(context.Context, *MsgEthereumCreate) (*MsgEthereumCreateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EthereumCreate not implemented")
+}
 func (UnimplementedMsgServiceServer) mustEmbedUnimplementedMsgServiceServer() {}
 
 // UnsafeMsgServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -114,6 +148,42 @@ func _MsgService_EthTransaction_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MsgService_EthereumCall_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgEthereumCall)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).EthereumCall(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgService_EthereumCall_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).EthereumCall(ctx, req.(*MsgEthereumCall))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_EthereumCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgEthereumCreate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).EthereumCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgService_EthereumCreate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).EthereumCreate(ctx, req.(*MsgEthereumCreate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // MsgService_ServiceDesc is the grpc.ServiceDesc for MsgService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -125,6 +195,14 @@ var MsgService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "EthTransaction",
 			Handler:    _MsgService_EthTransaction_Handler,
 		},
+		{
+			MethodName: "EthereumCall",
+			Handler:    _MsgService_EthereumCall_Handler,
+		},
+		{
+			MethodName: "EthereumCreate",
+			Handler:    _MsgService_EthereumCreate_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "jinx/evm/v1alpha1/tx.proto",
@@ -0,0 +1,150 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Blackchain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package config
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cast"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+
+	"pkg.berachain.dev/jinx/eth/jinx"
+	errorslib "pkg.berachain.dev/jinx/lib/errors"
+)
+
+// ReadJinxConfigFromAppOpts reads the Jinx config from the `jinx.toml` file in the config
+// directory of the node home, or from the file set by `--jinx.config`, and applies the command
+// line overrides. If the default file does not exist, the default config is used. An invalid file
+// or an invalid resulting config is an error.
+func ReadJinxConfigFromAppOpts(appOpts servertypes.AppOptions, homePath string) (*jinx.Config, error) {
+	var (
+		cfg *jinx.Config
+		err error
+	)
+
+	path := cast.ToString(appOpts.Get(FlagConfig))
+	switch {
+	case path != "":
+		cfg, err = jinx.LoadConfigFromFilePath(path)
+	case fileExists(filepath.Join(homePath, "config", jinxConfigFile)):
+		cfg, err = jinx.LoadConfigFromFilePath(filepath.Join(homePath, "config", jinxConfigFile))
+	default:
+		cfg = jinx.DefaultConfig()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	applyFlags(cfg, appOpts)
+	if err = cfg.Validate(); err != nil {
+		return nil, errorslib.Wrap(err, "invalid jinx config")
+	}
+	return cfg, nil
+}
+
+// applyFlags overrides the config with the command line flags that were set. The values are
+// already validated by the flag parser.
+func applyFlags(cfg *jinx.Config, appOpts servertypes.AppOptions) {
+	get := func(flag string) (any, bool) {
+		if !isSet(appOpts, flag) {
+			return nil, false
+		}
+		return appOpts.Get(flag), true
+	}
+
+	// Node config.
+	if v, ok := get(FlagHTTPAddr); ok {
+		cfg.NodeConfig.HTTPHost = cast.ToString(v)
+	}
+	if v, ok := get(FlagHTTPPort); ok {
+		cfg.NodeConfig.HTTPPort = cast.ToInt(v)
+	}
+	if v, ok := get(FlagHTTPAPI); ok {
+		cfg.NodeConfig.HTTPModules = cast.ToStringSlice(v)
+	}
+	if v, ok := get(FlagHTTPCors); ok {
+		cfg.NodeConfig.HTTPCors = cast.ToStringSlice(v)
+	}
+	if v, ok := get(FlagHTTPVHosts); ok {
+		cfg.NodeConfig.HTTPVirtualHosts = cast.ToStringSlice(v)
+	}
+	if v, ok := get(FlagWSAddr); ok {
+		cfg.NodeConfig.WSHost = cast.ToString(v)
+	}
+	if v, ok := get(FlagWSPort); ok {
+		cfg.NodeConfig.WSPort = cast.ToInt(v)
+	}
+	if v, ok := get(FlagWSAPI); ok {
+		cfg.NodeConfig.WSModules = cast.ToStringSlice(v)
+	}
+	if v, ok := get(FlagWSOrigins); ok {
+		cfg.NodeConfig.WSOrigins = cast.ToStringSlice(v)
+	}
+
+	// GraphQL config.
+	if v, ok := get(FlagGraphQL); ok {
+		cfg.GraphQLConfig.Enabled = cast.ToBool(v)
+	}
+	if v, ok := get(FlagGraphQLCors); ok {
+		cfg.NodeConfig.GraphQLCors = cast.ToStringSlice(v)
+	}
+	if v, ok := get(FlagGraphQLVHosts); ok {
+		cfg.NodeConfig.GraphQLVirtualHosts = cast.ToStringSlice(v)
+	}
+
+	// RPC config.
+	if v, ok := get(FlagRPCGasCap); ok {
+		cfg.RPCConfig.RPCGasCap = cast.ToUint64(v)
+	}
+	if v, ok := get(FlagRPCEVMTimeout); ok {
+		cfg.RPCConfig.RPCEVMTimeout = cast.ToDuration(v)
+	}
+	if v, ok := get(FlagRPCTxFeeCap); ok {
+		cfg.RPCConfig.RPCTxFeeCap = cast.ToFloat64(v)
+	}
+
+	// History config.
+	if v, ok := get(FlagHistoryRetention); ok {
+		cfg.HistoryConfig.Retention = cast.ToUint64(v)
+	}
+
+	// Execution config.
+	if v, ok := get(FlagParallelWorkers); ok {
+		cfg.ExecutionConfig.ParallelWorkers = cast.ToInt(v)
+	}
+}
+
+// isSet returns whether the option was explicitly set. App options that do not track this (i.e.
+// are not backed by viper) are considered set when they hold a value.
+func isSet(appOpts servertypes.AppOptions, key string) bool {
+	if v, ok := appOpts.(interface{ IsSet(string) bool }); ok {
+		return v.IsSet(key)
+	}
+	return appOpts.Get(key) != nil
+}
+
+// fileExists returns whether a file exists at the given path.
+func fileExists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
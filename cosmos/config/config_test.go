@@ -0,0 +1,170 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Blackchain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package config_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+
+	"pkg.berachain.dev/jinx/cosmos/config"
+	"pkg.berachain.dev/jinx/eth/jinx"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestConfig(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "cosmos/config")
+}
+
+var _ = Describe("Jinx Config", func() {
+	var home string
+
+	writeConfig := func(contents string) {
+		Expect(os.MkdirAll(filepath.Join(home, "config"), 0o755)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(home, "config", "jinx.toml"), []byte(contents), 0o600)).
+			To(Succeed())
+	}
+
+	BeforeEach(func() {
+		home = GinkgoT().TempDir()
+	})
+
+	It("should use the defaults if there is no config file", func() {
+		cfg, err := config.ReadJinxConfigFromAppOpts(simtestutil.AppOptionsMap{}, home)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cfg).To(Equal(jinx.DefaultConfig()))
+	})
+
+	It("should load the config file over the defaults", func() {
+		writeConfig(`
+[NodeConfig]
+HTTPPort = 9545
+HTTPModules = ["eth", "txpool"]
+
+[GraphQLConfig]
+Enabled = true
+
+[FilterConfig]
+Timeout = "1m"
+`)
+		cfg, err := config.ReadJinxConfigFromAppOpts(simtestutil.AppOptionsMap{}, home)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cfg.NodeConfig.HTTPPort).To(Equal(9545))
+		Expect(cfg.NodeConfig.HTTPModules).To(Equal([]string{"eth", "txpool"}))
+		Expect(cfg.GraphQLConfig.Enabled).To(BeTrue())
+		Expect(cfg.FilterConfig.Timeout).To(Equal(time.Minute))
+		Expect(cfg.NodeConfig.WSPort).To(Equal(jinx.DefaultConfig().NodeConfig.WSPort))
+		Expect(cfg.RPCConfig).To(Equal(jinx.DefaultConfig().RPCConfig))
+	})
+
+	It("should disable GraphQL by default", func() {
+		cfg, err := config.ReadJinxConfigFromAppOpts(simtestutil.AppOptionsMap{}, home)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cfg.GraphQLConfig.Enabled).To(BeFalse())
+		Expect(cfg.NodeConfig.GraphQLCors).To(BeEmpty())
+	})
+
+	It("should load config files in the legacy flat layout", func() {
+		writeConfig(`
+RPCGasCap = 20000000
+RPCEVMTimeout = "3s"
+
+[GPO]
+Blocks = 5
+Default = 2000000000
+
+[NodeConfig]
+Name = "my-node"
+Version = "1.0.0"
+HTTPPort = 9545
+`)
+		cfg, err := config.ReadJinxConfigFromAppOpts(simtestutil.AppOptionsMap{}, home)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cfg.RPCConfig.RPCGasCap).To(Equal(uint64(20000000)))
+		Expect(cfg.RPCConfig.RPCEVMTimeout).To(Equal(3 * time.Second))
+		Expect(cfg.RPCConfig.RPCTxFeeCap).To(Equal(jinx.DefaultConfig().RPCConfig.RPCTxFeeCap))
+		Expect(cfg.RPCConfig.GPO.Blocks).To(Equal(5))
+		Expect(cfg.RPCConfig.GPO.Default.Int64()).To(Equal(int64(2000000000)))
+		Expect(cfg.NodeConfig.HTTPPort).To(Equal(9545))
+	})
+
+	It("should reject unknown keys", func() {
+		writeConfig(`
+[NodeConfig]
+HTTPPrt = 9545
+`)
+		_, err := config.ReadJinxConfigFromAppOpts(simtestutil.AppOptionsMap{}, home)
+		Expect(err).To(MatchError(ContainSubstring("NodeConfig.HTTPPrt")))
+	})
+
+	It("should reject malformed and invalid files", func() {
+		writeConfig(`[NodeConfig`)
+		_, err := config.ReadJinxConfigFromAppOpts(simtestutil.AppOptionsMap{}, home)
+		Expect(err).To(HaveOccurred())
+
+		writeConfig(`
+[NodeConfig]
+HTTPPort = 70000
+`)
+		_, err = config.ReadJinxConfigFromAppOpts(simtestutil.AppOptionsMap{}, home)
+		Expect(err).To(MatchError(ContainSubstring("NodeConfig.HTTPPort")))
+	})
+
+	It("should error if the given config file does not exist", func() {
+		_, err := config.ReadJinxConfigFromAppOpts(simtestutil.AppOptionsMap{
+			config.FlagConfig: filepath.Join(home, "missing.toml"),
+		}, home)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should apply the flag overrides", func() {
+		writeConfig(`
+[NodeConfig]
+HTTPPort = 9545
+`)
+		cfg, err := config.ReadJinxConfigFromAppOpts(simtestutil.AppOptionsMap{
+			config.FlagHTTPPort:         10545,
+			config.FlagWSAPI:            []string{"eth", "net"},
+			config.FlagGraphQL:          true,
+			config.FlagRPCEVMTimeout:    "2s",
+			config.FlagHistoryRetention: 100000,
+			config.FlagParallelWorkers:  4,
+		}, home)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cfg.NodeConfig.HTTPPort).To(Equal(10545))
+		Expect(cfg.NodeConfig.WSModules).To(Equal([]string{"eth", "net"}))
+		Expect(cfg.GraphQLConfig.Enabled).To(BeTrue())
+		Expect(cfg.RPCConfig.RPCEVMTimeout).To(Equal(2 * time.Second))
+		Expect(cfg.HistoryConfig.Retention).To(Equal(uint64(100000)))
+		Expect(cfg.ExecutionConfig.ParallelWorkers).To(Equal(4))
+
+		_, err = config.ReadJinxConfigFromAppOpts(simtestutil.AppOptionsMap{
+			config.FlagHTTPPort: -1,
+		}, home)
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,95 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Blackchain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package config
+
+import (
+	"github.com/spf13/cobra"
+
+	"pkg.berachain.dev/jinx/eth/jinx"
+)
+
+const (
+	// jinxConfigFile is the name of the Jinx config file in the config directory of the node home.
+	jinxConfigFile = "jinx.toml"
+
+	// FlagConfig is the path of the Jinx config file, defaults to `<home>/config/jinx.toml`.
+	FlagConfig = "jinx.config"
+
+	FlagHTTPAddr   = "jinx.http.addr"
+	FlagHTTPPort   = "jinx.http.port"
+	FlagHTTPAPI    = "jinx.http.api"
+	FlagHTTPCors   = "jinx.http.corsdomain"
+	FlagHTTPVHosts = "jinx.http.vhosts"
+
+	FlagWSAddr    = "jinx.ws.addr"
+	FlagWSPort    = "jinx.ws.port"
+	FlagWSAPI     = "jinx.ws.api"
+	FlagWSOrigins = "jinx.ws.origins"
+
+	FlagGraphQL       = "jinx.graphql"
+	FlagGraphQLCors   = "jinx.graphql.corsdomain"
+	FlagGraphQLVHosts = "jinx.graphql.vhosts"
+
+	FlagRPCGasCap     = "jinx.rpc.gascap"
+	FlagRPCEVMTimeout = "jinx.rpc.evmtimeout"
+	FlagRPCTxFeeCap   = "jinx.rpc.txfeecap"
+
+	FlagHistoryRetention = "jinx.history.retention"
+
+	FlagParallelWorkers = "jinx.execution.parallel-workers"
+)
+
+// AddJinxFlags adds the flags that override the Jinx config to the start command. The defaults
+// shown are the defaults of the config, a flag only takes effect if it is set.
+func AddJinxFlags(startCmd *cobra.Command) {
+	defaults := jinx.DefaultConfig()
+	f := startCmd.Flags()
+
+	f.String(FlagConfig, "", "Path of the Jinx config file (default \"<home>/config/jinx.toml\")")
+
+	f.String(FlagHTTPAddr, defaults.NodeConfig.HTTPHost, "JSON-RPC HTTP server listening interface")
+	f.Int(FlagHTTPPort, defaults.NodeConfig.HTTPPort, "JSON-RPC HTTP server listening port")
+	f.StringSlice(FlagHTTPAPI, defaults.NodeConfig.HTTPModules, "APIs offered over the HTTP interface")
+	f.StringSlice(FlagHTTPCors, defaults.NodeConfig.HTTPCors, "Domains from which to accept cross origin requests")
+	f.StringSlice(FlagHTTPVHosts, defaults.NodeConfig.HTTPVirtualHosts, "Virtual hostnames from which to accept requests")
+
+	f.String(FlagWSAddr, defaults.NodeConfig.WSHost, "JSON-RPC WebSocket server listening interface")
+	f.Int(FlagWSPort, defaults.NodeConfig.WSPort, "JSON-RPC WebSocket server listening port")
+	f.StringSlice(FlagWSAPI, defaults.NodeConfig.WSModules, "APIs offered over the WebSocket interface")
+	f.StringSlice(FlagWSOrigins, defaults.NodeConfig.WSOrigins, "Origins from which to accept WebSocket requests")
+
+	f.Bool(FlagGraphQL, defaults.GraphQLConfig.Enabled, "Enable GraphQL on the HTTP server")
+	f.StringSlice(FlagGraphQLCors, defaults.NodeConfig.GraphQLCors,
+		"Domains from which to accept cross origin GraphQL requests")
+	f.StringSlice(FlagGraphQLVHosts, defaults.NodeConfig.GraphQLVirtualHosts,
+		"Virtual hostnames from which to accept GraphQL requests")
+
+	f.Uint64(FlagRPCGasCap, defaults.RPCConfig.RPCGasCap, "Gas cap of eth_call and eth_estimateGas (0 = infinite)")
+	f.Duration(FlagRPCEVMTimeout, defaults.RPCConfig.RPCEVMTimeout, "Timeout of eth_call (0 = infinite)")
+	f.Float64(FlagRPCTxFeeCap, defaults.RPCConfig.RPCTxFeeCap,
+		"Fee cap in ether of transactions sent over RPC (0 = no cap)")
+
+	f.Uint64(FlagHistoryRetention, defaults.HistoryConfig.Retention,
+		"Number of recent blocks whose blocks, receipts and transactions are kept (0 = archive)")
+
+	f.Int(FlagParallelWorkers, defaults.ExecutionConfig.ParallelWorkers,
+		"Number of workers executing the Ethereum transactions of a block in parallel (0 or 1 = disabled)")
+}
@@ -40,6 +40,7 @@ jq '.app_state["gov"]["deposit_params"]["min_deposit"][0]["denom"]="ablack"' "$G
 jq '.app_state["evm"]["params"]["evm_denom"]="ablack"' "$GENESIS" >"$TMP_GENESIS" && mv "$TMP_GENESIS" "$GENESIS";
 jq '.app_state["mint"]["params"]["mint_denom"]="ablack"' "$GENESIS" >"$TMP_GENESIS" && mv "$TMP_GENESIS" "$GENESIS";
 jq '.consensus["params"]["block"]["max_gas"]="30000000"' "$GENESIS" >"$TMP_GENESIS" && mv "$TMP_GENESIS" "$GENESIS";
+jq '.consensus["params"]["abci"]["vote_extensions_enable_height"]="1"' "$GENESIS" >"$TMP_GENESIS" && mv "$TMP_GENESIS" "$GENESIS";
 
 jinxd config set client keyring-backend $KEYRING --home "$HOMEDIR"
 
@@ -21,6 +21,8 @@
 package lib
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"pkg.berachain.dev/jinx/eth/common"
@@ -38,6 +40,19 @@ func EthAddressFromBech32(bech32Str string) common.Address {
 	return AccAddressToEthAddress(addrBech32)
 }
 
+// EthAddressFromString converts a hex or Bech32 string to an Ethereum `Address`.
+// Note: Do NOT use for val or cons address.
+func EthAddressFromString(addrStr string) (common.Address, error) {
+	if common.IsHexAddress(addrStr) {
+		return common.HexToAddress(addrStr), nil
+	}
+	accAddr, err := sdk.AccAddressFromBech32(addrStr)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("%s is neither a hex nor a bech32 address", addrStr)
+	}
+	return AccAddressToEthAddress(accAddr), nil
+}
+
 // Bech32FromEthAddress converts Ethereum `Address` to a Bech32 string.
 // Note: Do NOT use for val or cons address.
 func Bech32FromEthAddress(ethAddr common.Address) string {
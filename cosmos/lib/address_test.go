@@ -57,4 +57,13 @@ var _ = Describe("Address", func() {
 		Expect(cosmlib.EthAddressFromBech32(bech32)).To(Equal(addr))
 		Expect(cosmlib.Bech32FromEthAddress(addr)).To(Equal(bech32))
 	})
+
+	It("should convert from hex or bech32 strings", func() {
+		addr := common.HexToAddress("0xCd8c4Cb0C7f93a2B74B3e522a1C7BE35bE1Fbc73")
+
+		Expect(cosmlib.EthAddressFromString(addr.Hex())).To(Equal(addr))
+		Expect(cosmlib.EthAddressFromString("cosmos1ekxyevx8lyazka9nu532r3a7xklpl0rnjrc2a9")).To(Equal(addr))
+		_, err := cosmlib.EthAddressFromString("osmo")
+		Expect(err).To(HaveOccurred())
+	})
 })
@@ -25,6 +25,8 @@ import (
 	"os"
 	"path/filepath"
 
+	abci "github.com/cometbft/cometbft/abci/types"
+
 	dbm "github.com/cosmos/cosmos-db"
 
 	"cosmossdk.io/depinject"
@@ -62,6 +64,7 @@ import (
 	slashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 
+	evmconfig "pkg.berachain.dev/jinx/cosmos/config"
 	ethcryptocodec "pkg.berachain.dev/jinx/cosmos/crypto/codec"
 	erc20keeper "pkg.berachain.dev/jinx/cosmos/x/erc20/keeper"
 	evmante "pkg.berachain.dev/jinx/cosmos/x/evm/ante"
@@ -147,6 +150,16 @@ func NewJinxApp(
 				ethTxMempool,
 				// ADVANCED CONFIGURATION
 				PrecompilesToInject(app),
+				// supply the custom sign mode handlers
+				SignModeHandlersToInject(app),
+				//
+				// EVM
+				//
+				// For attaching the Cosmos events emitted by the block hooks of other modules and
+				// by Cosmos transactions to the EVM blocks as logs in synthetic receipts, add the event types to translate
+				// below. Only the events registered by a precompile can be translated.
+				//
+				// evmtypes.SyntheticEventTypes{banktypes.EventTypeCoinSpent, banktypes.EventTypeCoinReceived},
 				//
 				// AUTH
 				//
@@ -226,28 +239,51 @@ func NewJinxApp(
 
 	// TODO: MOVE EVM SETUP
 	// ----- BEGIN EVM SETUP ----------------------------------------------
-	// TODO: reenable offchain
-	// offchainKey := storetypes.NewKVStoreKey("offchain-evm")
-	// app.MountStore(offchainKey, storetypes.StoreTypeDB)
 	homePath, ok := appOpts.Get(flags.FlagHome).(string)
 	if !ok || homePath == "" {
 		homePath = DefaultNodeHome
 	}
+	jinxCfg, err := evmconfig.ReadJinxConfigFromAppOpts(appOpts, homePath)
+	if err != nil {
+		panic(err)
+	}
+	// serve the ERC20 token <> SDK coin pairs in the jinx JSON-RPC namespace.
+	app.EVMKeeper.SetERC20Keeper(app.ERC20Keeper)
 	// setup evm keeper and all of its plugins.
 	app.EVMKeeper.Setup(
 		nil,
 		app.CreateQueryContext,
-		// TODO: clean this up.
-		homePath+"/config/jinx.toml",
+		jinxCfg,
 		homePath+"/data/jinx",
 		logger,
 	)
-	opt := ante.HandlerOptions{
-		AccountKeeper:   app.AccountKeeper,
-		BankKeeper:      app.BankKeeper,
-		SignModeHandler: app.TxConfig().SignModeHandler(),
-		FeegrantKeeper:  nil,
-		SigGasConsumer:  evmante.SigVerificationGasConsumer,
+	// extend the votes of the validators with the EVM block they executed, the blocks attested by
+	// a quorum of vote extensions are served as the "safe" block tag. The proposers put the
+	// extended last commit at the start of their blocks, where it is verified by the validators.
+	app.SetExtendVoteHandler(app.EVMKeeper.ExtendVoteHandler())
+	app.SetVerifyVoteExtensionHandler(app.EVMKeeper.VerifyVoteExtensionHandler())
+	proposalHandler := baseapp.NewDefaultProposalHandler(ethTxMempool, app.App.BaseApp)
+	app.SetPrepareProposal(
+		app.EVMKeeper.PrepareProposalHandler(proposalHandler.PrepareProposalHandler()),
+	)
+	app.SetProcessProposal(app.EVMKeeper.ProcessProposalHandler(
+		app.StakingKeeper, proposalHandler.ProcessProposalHandler(),
+	))
+	// export and restore the historical EVM data in state sync snapshots.
+	if manager := app.SnapshotManager(); manager != nil {
+		if err = manager.RegisterExtensions(app.EVMKeeper.HistoricalSnapshotter()); err != nil {
+			panic(err)
+		}
+	}
+	opt := evmante.HandlerOptions{
+		HandlerOptions: ante.HandlerOptions{
+			AccountKeeper:   app.AccountKeeper,
+			BankKeeper:      app.BankKeeper,
+			SignModeHandler: app.TxConfig().SignModeHandler(),
+			FeegrantKeeper:  nil,
+			SigGasConsumer:  evmante.SigVerificationGasConsumer,
+		},
+		EVMKeeper: app.EVMKeeper,
 	}
 	ch, _ := evmante.NewAnteHandler(
 		opt,
@@ -255,6 +291,14 @@ func NewJinxApp(
 	app.SetAnteHandler(
 		ch,
 	)
+	// execute the ethereum transactions at the start of blocks in parallel, if enabled in the jinx
+	// config, which requires the transactions of the block, see FinalizeBlock.
+	app.EVMKeeper.SetTxHandlers(app.TxConfig().TxDecoder(), ch)
+	// attach the registered events of the messages of Cosmos transactions to the EVM block in
+	// synthetic receipts, and record the Cosmos transactions of the Ethereum transactions once
+	// they have succeeded. The collector wraps the circuit breaker of the app, which has none.
+	app.MsgServiceRouter().SetCircuit(app.EVMKeeper.MsgEventCollector(nil))
+	app.SetPostHandler(app.EVMKeeper.PostHandler())
 	ethcryptocodec.RegisterInterfaces(app.interfaceRegistry)
 
 	// ----- END EVM SETUP -------------------------------------------------
@@ -293,6 +337,36 @@ func NewJinxApp(
 	return app
 }
 
+// Close stops the services of the EVM and closes its node-local files, before closing the App.
+func (app *SimApp) Close() error {
+	if err := app.EVMKeeper.Close(); err != nil {
+		return err
+	}
+	return app.App.Close()
+}
+
+// FinalizeBlock hands the request of the block to the EVM keeper before finalizing it, so that its
+// begin blocker can execute the Ethereum transactions of the block in parallel and derive the
+// Jinx Ethereum block from the block hash and the decided last commit. The extended last commit
+// put at the start of the block is not delivered as a transaction, it is given an empty result
+// as CometBFT expects a result for every transaction of the block.
+func (app *SimApp) FinalizeBlock(req *abci.RequestFinalizeBlock) (*abci.ResponseFinalizeBlock, error) {
+	app.EVMKeeper.SetFinalizeBlockRequest(req)
+	txs, extCommit := evmkeeper.SplitExtendedCommit(req.Txs)
+	if extCommit == nil {
+		return app.App.FinalizeBlock(req)
+	}
+
+	nextReq := *req
+	nextReq.Txs = txs
+	resp, err := app.App.FinalizeBlock(&nextReq)
+	if err != nil {
+		return nil, err
+	}
+	resp.TxResults = append([]*abci.ExecTxResult{{}}, resp.TxResults...)
+	return resp, nil
+}
+
 // Name returns the name of the App.
 func (app *SimApp) Name() string { return app.BaseApp.Name() }
 
@@ -24,6 +24,7 @@ package cmd
 import (
 	"errors"
 	"io"
+	"math/big"
 	"os"
 
 	dbm "github.com/cosmos/cosmos-db"
@@ -60,13 +61,18 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/crisis"
 	genutilcli "github.com/cosmos/cosmos-sdk/x/genutil/client/cli"
 
+	evmconfig "pkg.berachain.dev/jinx/cosmos/config"
 	ethcryptocodec "pkg.berachain.dev/jinx/cosmos/crypto/codec"
 	"pkg.berachain.dev/jinx/cosmos/crypto/keyring"
 	"pkg.berachain.dev/jinx/cosmos/simapp"
 	evmante "pkg.berachain.dev/jinx/cosmos/x/evm/ante"
 	evmmepool "pkg.berachain.dev/jinx/cosmos/x/evm/plugins/txpool/mempool"
+	"pkg.berachain.dev/jinx/eth/params"
 )
 
+// flagEVMChainID is the chain ID of the EVM that the client ties EIP-712 signatures to.
+const flagEVMChainID = "evm-chain-id"
+
 // NewRootCmd creates a new root command for simd. It is called once in the main function.
 //
 
@@ -128,8 +134,16 @@ func NewRootCmd() *cobra.Command {
 				TextualCoinMetadataQueryFn: txmodule.NewGRPCCoinMetadataQueryFn(initClientCtx),
 			}
 
-			// Add a custom sign mode handler for ethereum transactions.
-			txConfigOpts.CustomSignModes = []signing.SignModeHandler{evmante.SignModeEthTxHandler{}}
+			// Add the custom sign mode handlers for ethereum and EIP-712 signed transactions. The
+			// client has no state to read the EVM chain ID from, so it is set by a flag.
+			evmChainID, err := cmd.Flags().GetUint64(flagEVMChainID)
+			if err != nil {
+				return err
+			}
+			txConfigOpts.CustomSignModes = []signing.SignModeHandler{
+				evmante.SignModeEthTxHandler{},
+				simapp.NewSignModeEIP712Handler(nil, new(big.Int).SetUint64(evmChainID)),
+			}
 			txConfigWithTextual, err := tx.NewTxConfigWithOptions(
 				codec.NewProtoCodec(interfaceRegistry),
 				txConfigOpts,
@@ -150,6 +164,9 @@ func NewRootCmd() *cobra.Command {
 		},
 	}
 
+	rootCmd.PersistentFlags().Uint64(
+		flagEVMChainID, params.DefaultEIP155ChainID, "Chain ID of the EVM that EIP-712 signatures are tied to",
+	)
 	initRootCmd(rootCmd, txConfig, interfaceRegistry, appCodec, moduleBasicManager)
 
 	if err := autoCliOpts.EnhanceRootCommand(rootCmd); err != nil {
@@ -260,6 +277,7 @@ func initRootCmd(
 
 func addModuleInitFlags(startCmd *cobra.Command) {
 	crisis.AddModuleInitFlags(startCmd)
+	evmconfig.AddJinxFlags(startCmd)
 }
 
 // genesisCommand builds genesis-related `simd genesis` command. Users may provide application specific commands as a parameter.
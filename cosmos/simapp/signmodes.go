@@ -0,0 +1,87 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Blackchain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package simapp
+
+import (
+	"context"
+	"errors"
+	"math/big"
+
+	txsigning "cosmossdk.io/x/tx/signing"
+	"cosmossdk.io/x/tx/signing/aminojson"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	evmante "pkg.berachain.dev/jinx/cosmos/x/evm/ante"
+	"pkg.berachain.dev/jinx/eth/params"
+)
+
+// SignModeHandlersToInject returns a function that provides the custom sign mode handlers of the
+// application: Ethereum transactions and EIP-712 signed Cosmos transactions.
+func SignModeHandlersToInject(app *SimApp) func() []txsigning.SignModeHandler {
+	return func() []txsigning.SignModeHandler {
+		return []txsigning.SignModeHandler{
+			evmante.SignModeEthTxHandler{},
+			NewSignModeEIP712Handler(
+				func(ctx sdk.Context) (*params.ChainConfig, error) {
+					return app.EVMKeeper.GetChainConfig(ctx)
+				},
+				params.DefaultChainConfig.ChainID,
+			),
+		}
+	}
+}
+
+// NewSignModeEIP712Handler returns the EIP-712 sign mode handler of the application. When the sign
+// bytes are requested with an SDK context, e.g. in the ante handler, the EVM chain ID is read from
+// the chain config in its state. Otherwise, e.g. on the client, or if `chainConfig` is nil, the
+// given (configured) EVM chain ID is used.
+func NewSignModeEIP712Handler(
+	chainConfig func(sdk.Context) (*params.ChainConfig, error), evmChainID *big.Int,
+) *evmante.SignModeEIP712Handler {
+	return evmante.NewSignModeEIP712Handler(
+		aminojson.SignModeHandlerOptions{},
+		func(ctx context.Context) (*big.Int, error) {
+			sdkCtx, ok := unwrapSDKContext(ctx)
+			if !ok || chainConfig == nil {
+				return evmChainID, nil
+			}
+			cfg, err := chainConfig(sdkCtx)
+			if err != nil {
+				return nil, err
+			}
+			if cfg == nil {
+				return nil, errors.New("evm chain config is not set")
+			}
+			return cfg.ChainID, nil
+		},
+	)
+}
+
+// unwrapSDKContext returns the SDK context of the given context, if it has one. Unlike
+// `sdk.UnwrapSDKContext`, it does not panic on plain contexts.
+func unwrapSDKContext(ctx context.Context) (sdk.Context, bool) {
+	if sdkCtx, ok := ctx.(sdk.Context); ok {
+		return sdkCtx, true
+	}
+	sdkCtx, ok := ctx.Value(sdk.SdkContextKey).(sdk.Context)
+	return sdkCtx, ok
+}
@@ -30,6 +30,8 @@ import (
 	gethrpc "github.com/ethereum/go-ethereum/rpc"
 
 	"pkg.berachain.dev/jinx/eth/common"
+	coretypes "pkg.berachain.dev/jinx/eth/core/types"
+	"pkg.berachain.dev/jinx/eth/crypto"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -72,6 +74,51 @@ var _ = Describe("Network", func() {
 		Expect(blockNumber).To(BeNumerically(">", 0))
 	})
 
+	It("should map the latest, finalized and safe block tags to the finality of the chain", func() {
+		finalizedNum := big.NewInt(gethrpc.FinalizedBlockNumber.Int64())
+		safeNum := big.NewInt(gethrpc.SafeBlockNumber.Int64())
+
+		// A height is safe once its commit has been seen in the next block.
+		var safe *coretypes.Header
+		Eventually(func() error {
+			var err error
+			safe, err = client.HeaderByNumber(ctx, safeNum)
+			return err
+		}, "10s").Should(Succeed())
+		finalized, err := client.HeaderByNumber(ctx, finalizedNum)
+		Expect(err).ToNot(HaveOccurred())
+		latest, err := client.HeaderByNumber(ctx, nil)
+		Expect(err).ToNot(HaveOccurred())
+
+		// safe <= finalized <= latest, as the chain only moves forward between the queries.
+		Expect(safe.Number.Uint64()).To(BeNumerically("<=", finalized.Number.Uint64()))
+		Expect(finalized.Number.Uint64()).To(BeNumerically("<=", latest.Number.Uint64()))
+
+		// The blocks of the tags are the canonical blocks at their height.
+		for _, tag := range []*big.Int{finalizedNum, safeNum} {
+			block, err := client.BlockByNumber(ctx, tag)
+			Expect(err).ToNot(HaveOccurred())
+			header, err := client.HeaderByNumber(ctx, block.Number())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(header.Hash()).To(Equal(block.Hash()))
+		}
+	})
+
+	It("should set the randomness of the finalized blocks as the mix hash", func() {
+		latest, err := client.HeaderByNumber(ctx, nil)
+		Expect(err).ToNot(HaveOccurred())
+		parent, err := client.HeaderByNumber(ctx, new(big.Int).Sub(latest.Number, big.NewInt(1)))
+		Expect(err).ToNot(HaveOccurred())
+
+		// the randomness can not be predicted from the height of the block.
+		for _, header := range []*coretypes.Header{latest, parent} {
+			Expect(header.MixDigest).ToNot(Equal(crypto.Keccak256Hash(
+				nil, nil, header.Number.FillBytes(make([]byte, 8)),
+			)))
+		}
+		Expect(latest.MixDigest).ToNot(Equal(parent.MixDigest))
+	})
+
 	It("should support eth_getBalance", func() {
 		// Get the balance of an account
 		balance, err := client.BalanceAt(ctx, tf.Address("alice"), nil)
@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Blackchain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package erc20
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+
+	erc20v1alpha1 "pkg.berachain.dev/jinx/cosmos/api/jinx/erc20/v1alpha1"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: erc20v1alpha1.QueryService_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "ERC20Pairs",
+					Use:       "pairs",
+					Short:     "Query all the registered ERC20 token <> SDK coin pairs",
+				},
+				{
+					RpcMethod:      "ERC20Pair",
+					Use:            "pair [denom-or-token]",
+					Short:          "Query the ERC20 token <> SDK coin pair of a denom or token address",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom_or_token"}},
+				},
+				{
+					RpcMethod:      "ERC20AddressForCoinDenom",
+					Use:            "token [denom]",
+					Short:          "Query the ERC20 token address of an SDK coin denom",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+				},
+				{
+					RpcMethod:      "CoinDenomForERC20Address",
+					Use:            "denom [token]",
+					Short:          "Query the SDK coin denom of an ERC20 token address",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "token"}},
+				},
+			},
+		},
+	}
+}
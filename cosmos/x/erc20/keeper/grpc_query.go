@@ -23,11 +23,15 @@ package keeper
 import (
 	"context"
 
+	"cosmossdk.io/store/prefix"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	cosmlib "pkg.berachain.dev/jinx/cosmos/lib"
 	"pkg.berachain.dev/jinx/cosmos/x/erc20/types"
 	"pkg.berachain.dev/jinx/eth/common"
+	errorslib "pkg.berachain.dev/jinx/lib/errors"
 )
 
 // Compile-time interface assertion.
@@ -63,3 +67,51 @@ func (k *Keeper) CoinDenomForERC20Address(
 		),
 	}, nil
 }
+
+// ERC20Pairs queries all the registered ERC20 token <> SDK coin denomination pairs.
+func (k *Keeper) ERC20Pairs(
+	ctx context.Context, req *types.ERC20PairsRequest,
+) (*types.ERC20PairsResponse, error) {
+	denomToAddress := prefix.NewStore(
+		sdk.UnwrapSDKContext(ctx).KVStore(k.storeKey), []byte{types.DenomToAddressKeyPrefix},
+	)
+
+	var pairs []*types.ERC20Pair
+	pageRes, err := query.Paginate(denomToAddress, req.Pagination, func(key, value []byte) error {
+		pairs = append(pairs, &types.ERC20Pair{
+			Denom: string(key),
+			Token: cosmlib.Bech32FromEthAddress(common.BytesToAddress(value)),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.ERC20PairsResponse{Pairs: pairs, Pagination: pageRes}, nil
+}
+
+// ERC20Pair queries the ERC20 token <> SDK coin denomination pair of a given SDK coin denomination
+// or ERC20 token address.
+func (k *Keeper) ERC20Pair(
+	ctx context.Context, req *types.ERC20PairRequest,
+) (*types.ERC20PairResponse, error) {
+	ds := k.DenomKVStore(sdk.UnwrapSDKContext(ctx))
+
+	// the pair is queried by token address if the given string is an address, and by denom
+	// otherwise.
+	var denom string
+	var token common.Address
+	if addr, err := cosmlib.EthAddressFromString(req.DenomOrToken); err == nil {
+		denom, token = ds.GetDenomForAddress(addr), addr
+	} else {
+		denom, token = req.DenomOrToken, ds.GetAddressForDenom(req.DenomOrToken)
+	}
+	if denom == "" || (token == common.Address{}) {
+		return nil, errorslib.Wrap(types.ErrPairNotFound, req.DenomOrToken)
+	}
+
+	return &types.ERC20PairResponse{
+		Pair: &types.ERC20Pair{Denom: denom, Token: cosmlib.Bech32FromEthAddress(token)},
+	}, nil
+}
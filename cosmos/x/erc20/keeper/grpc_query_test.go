@@ -102,4 +102,41 @@ var _ = Describe("GRPC Query Server", func() {
 		Expect(err).ToNot(HaveOccurred())
 		Expect(resp.Token).To(Equal(cosmlib.AddressToAccAddress(tokenAddr).String()))
 	})
+
+	It("should correctly handle pairs", func() {
+		denom := "osmo"
+		osmoAddr := common.BytesToAddress([]byte(denom))
+		tokenAddr := common.BytesToAddress([]byte("USDC"))
+
+		// check there are no pairs
+		_, err := qs.ERC20Pair(ctx, &types.ERC20PairRequest{DenomOrToken: denom})
+		Expect(err).To(MatchError(types.ErrPairNotFound))
+		pairsResp, err := qs.ERC20Pairs(ctx, &types.ERC20PairsRequest{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pairsResp.Pairs).To(BeEmpty())
+
+		// register the pairs
+		k.RegisterCoinERC20Pair(ctx, denom, osmoAddr)
+		tokenDenom := k.RegisterERC20CoinPair(ctx, tokenAddr)
+
+		// check the pairs by denom, hex address, and bech32 address
+		osmoPair := &types.ERC20Pair{Denom: denom, Token: cosmlib.Bech32FromEthAddress(osmoAddr)}
+		for _, denomOrToken := range []string{denom, osmoAddr.Hex(), osmoPair.Token} {
+			pairResp, err := qs.ERC20Pair(ctx, &types.ERC20PairRequest{DenomOrToken: denomOrToken})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pairResp.Pair).To(Equal(osmoPair))
+		}
+		_, err = qs.ERC20Pair(ctx, &types.ERC20PairRequest{
+			DenomOrToken: common.BytesToAddress([]byte("DAI")).Hex(),
+		})
+		Expect(err).To(MatchError(types.ErrPairNotFound))
+
+		// check all the pairs, ordered by denom
+		pairsResp, err = qs.ERC20Pairs(ctx, &types.ERC20PairsRequest{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pairsResp.Pairs).To(Equal([]*types.ERC20Pair{
+			{Denom: tokenDenom, Token: cosmlib.Bech32FromEthAddress(tokenAddr)},
+			osmoPair,
+		}))
+	})
 })
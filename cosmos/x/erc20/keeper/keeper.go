@@ -73,6 +73,18 @@ func (k *Keeper) RegisterCoinERC20Pair(ctx sdk.Context, denom string, token comm
 	k.DenomKVStore(ctx).SetAddressDenomPair(token, denom)
 }
 
+// DenomForAddress returns the SDK coin denomination paired with the given ERC20 token, or an
+// empty string if there is none.
+func (k *Keeper) DenomForAddress(ctx sdk.Context, token common.Address) string {
+	return k.DenomKVStore(ctx).GetDenomForAddress(token)
+}
+
+// AddressForDenom returns the ERC20 token paired with the given SDK coin denomination, or the zero
+// address if there is none.
+func (k *Keeper) AddressForDenom(ctx sdk.Context, denom string) common.Address {
+	return k.DenomKVStore(ctx).GetAddressForDenom(denom)
+}
+
 // Logger returns a module-specific logger.
 func (k *Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
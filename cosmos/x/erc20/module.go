@@ -21,10 +21,13 @@
 package erc20
 
 import (
+	"context"
+
 	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc"
 
+	"cosmossdk.io/client/v2/autocli"
 	"cosmossdk.io/core/appmodule"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -41,8 +44,9 @@ import (
 const ConsensusVersion = 1
 
 var (
-	_ appmodule.HasServices = AppModule{}
-	_ module.AppModuleBasic = AppModuleBasic{}
+	_ appmodule.HasServices    = AppModule{}
+	_ autocli.HasAutoCLIConfig = AppModule{}
+	_ module.AppModuleBasic    = AppModuleBasic{}
 )
 
 // ==============================================================================
@@ -67,21 +71,22 @@ func (b AppModuleBasic) RegisterInterfaces(_ cdctypes.InterfaceRegistry) {
 	// types.RegisterInterfaces(r)
 }
 
-// RegisterGRPCGatewayRoutes registers the gRPC Gateway routes for the evm module.
-func (AppModuleBasic) RegisterGRPCGatewayRoutes(_ client.Context, _ *gwruntime.ServeMux) {
-	// if err := types.RegisterQueryServiceHandlerClient(context.Background(), mux,
-	// types.NewQueryClient(clientCtx)); err != nil {
-	// 	panic(err)
-	// }
-	// evmrpc.RegisterJSONRPCServer(clientCtx, mux, app.EVMKeeper.GetRPCProvider()) maybe here?
+// RegisterGRPCGatewayRoutes registers the gRPC Gateway routes for the erc20 module.
+func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *gwruntime.ServeMux) {
+	if err := types.RegisterQueryServiceHandlerClient(
+		context.Background(), mux, types.NewQueryServiceClient(clientCtx),
+	); err != nil {
+		panic(err)
+	}
 }
 
-// GetTxCmd returns no root tx command for the evm module.
+// GetTxCmd returns no root tx command for the erc20 module.
 func (AppModuleBasic) GetTxCmd() *cobra.Command {
 	return nil
 }
 
-// GetQueryCmd returns the root query command for the evm module.
+// GetQueryCmd returns no root query command for the erc20 module, as the query commands are
+// generated by autocli.
 func (AppModuleBasic) GetQueryCmd() *cobra.Command {
 	return nil
 }
@@ -21,6 +21,7 @@
 package types
 
 import (
+	"errors"
 	fmt "fmt"
 
 	"pkg.berachain.dev/jinx/eth/common"
@@ -38,6 +39,10 @@ const (
 	lenJinxDenom = 50
 )
 
+// ErrPairNotFound is returned when no ERC20 token <> SDK coin denomination pair is registered for
+// a given denomination or token address.
+var ErrPairNotFound = errors.New("erc20 pair not found")
+
 // NewJinxDenomForAddress returns a new Jinx coin denomination for a given ERC20 originated
 // token address.
 func NewJinxDenomForAddress(token common.Address) string {
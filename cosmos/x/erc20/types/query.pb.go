@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -39,7 +40,7 @@ func (m *ERC20AddressForCoinDenomRequest) Reset()         { *m = ERC20AddressFor
 func (m *ERC20AddressForCoinDenomRequest) String() string { return proto.CompactTextString(m) }
 func (*ERC20AddressForCoinDenomRequest) ProtoMessage()    {}
 func (*ERC20AddressForCoinDenomRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ffd29a6c0ff3273b, []int{0}
+	return fileDescriptor_a1c2b0bf57a29700, []int{0}
 }
 func (m *ERC20AddressForCoinDenomRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -85,7 +86,7 @@ func (m *ERC20AddressForCoinDenomResponse) Reset()         { *m = ERC20AddressFo
 func (m *ERC20AddressForCoinDenomResponse) String() string { return proto.CompactTextString(m) }
 func (*ERC20AddressForCoinDenomResponse) ProtoMessage()    {}
 func (*ERC20AddressForCoinDenomResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ffd29a6c0ff3273b, []int{1}
+	return fileDescriptor_a1c2b0bf57a29700, []int{1}
 }
 func (m *ERC20AddressForCoinDenomResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -131,7 +132,7 @@ func (m *CoinDenomForERC20AddressRequest) Reset()         { *m = CoinDenomForERC
 func (m *CoinDenomForERC20AddressRequest) String() string { return proto.CompactTextString(m) }
 func (*CoinDenomForERC20AddressRequest) ProtoMessage()    {}
 func (*CoinDenomForERC20AddressRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ffd29a6c0ff3273b, []int{2}
+	return fileDescriptor_a1c2b0bf57a29700, []int{2}
 }
 func (m *CoinDenomForERC20AddressRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -177,7 +178,7 @@ func (m *CoinDenomForERC20AddressResponse) Reset()         { *m = CoinDenomForER
 func (m *CoinDenomForERC20AddressResponse) String() string { return proto.CompactTextString(m) }
 func (*CoinDenomForERC20AddressResponse) ProtoMessage()    {}
 func (*CoinDenomForERC20AddressResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ffd29a6c0ff3273b, []int{3}
+	return fileDescriptor_a1c2b0bf57a29700, []int{3}
 }
 func (m *CoinDenomForERC20AddressResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -213,43 +214,308 @@ func (m *CoinDenomForERC20AddressResponse) GetDenom() string {
 	return ""
 }
 
+// ERC20Pair is a registered ERC20 token <> SDK coin denomination pair.
+type ERC20Pair struct {
+	// denom is the SDK coin denomination of the pair.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// token is the bech32 address of the ERC20 token of the pair.
+	Token string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (m *ERC20Pair) Reset()         { *m = ERC20Pair{} }
+func (m *ERC20Pair) String() string { return proto.CompactTextString(m) }
+func (*ERC20Pair) ProtoMessage()    {}
+func (*ERC20Pair) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1c2b0bf57a29700, []int{4}
+}
+func (m *ERC20Pair) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ERC20Pair) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ERC20Pair.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ERC20Pair) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ERC20Pair.Merge(m, src)
+}
+func (m *ERC20Pair) XXX_Size() int {
+	return m.Size()
+}
+func (m *ERC20Pair) XXX_DiscardUnknown() {
+	xxx_messageInfo_ERC20Pair.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ERC20Pair proto.InternalMessageInfo
+
+func (m *ERC20Pair) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *ERC20Pair) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+// ERC20PairsRequest is the request type for the Query/ERC20Pairs RPC method.
+type ERC20PairsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *ERC20PairsRequest) Reset()         { *m = ERC20PairsRequest{} }
+func (m *ERC20PairsRequest) String() string { return proto.CompactTextString(m) }
+func (*ERC20PairsRequest) ProtoMessage()    {}
+func (*ERC20PairsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1c2b0bf57a29700, []int{5}
+}
+func (m *ERC20PairsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ERC20PairsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ERC20PairsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ERC20PairsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ERC20PairsRequest.Merge(m, src)
+}
+func (m *ERC20PairsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ERC20PairsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ERC20PairsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ERC20PairsRequest proto.InternalMessageInfo
+
+func (m *ERC20PairsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// ERC20PairsResponse is the response type for the Query/ERC20Pairs RPC method.
+type ERC20PairsResponse struct {
+	// pairs are the registered ERC20 token <> SDK coin denomination pairs, ordered by denomination.
+	Pairs []*ERC20Pair `protobuf:"bytes,1,rep,name=pairs,proto3" json:"pairs,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *ERC20PairsResponse) Reset()         { *m = ERC20PairsResponse{} }
+func (m *ERC20PairsResponse) String() string { return proto.CompactTextString(m) }
+func (*ERC20PairsResponse) ProtoMessage()    {}
+func (*ERC20PairsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1c2b0bf57a29700, []int{6}
+}
+func (m *ERC20PairsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ERC20PairsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ERC20PairsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ERC20PairsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ERC20PairsResponse.Merge(m, src)
+}
+func (m *ERC20PairsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ERC20PairsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ERC20PairsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ERC20PairsResponse proto.InternalMessageInfo
+
+func (m *ERC20PairsResponse) GetPairs() []*ERC20Pair {
+	if m != nil {
+		return m.Pairs
+	}
+	return nil
+}
+
+func (m *ERC20PairsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// ERC20PairRequest is the request type for the Query/ERC20Pair RPC method.
+type ERC20PairRequest struct {
+	// denom_or_token is the SDK coin denomination, or the hex or bech32 address of the ERC20 token,
+	// to query the pair for.
+	DenomOrToken string `protobuf:"bytes,1,opt,name=denom_or_token,json=denomOrToken,proto3" json:"denom_or_token,omitempty"`
+}
+
+func (m *ERC20PairRequest) Reset()         { *m = ERC20PairRequest{} }
+func (m *ERC20PairRequest) String() string { return proto.CompactTextString(m) }
+func (*ERC20PairRequest) ProtoMessage()    {}
+func (*ERC20PairRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1c2b0bf57a29700, []int{7}
+}
+func (m *ERC20PairRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ERC20PairRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ERC20PairRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ERC20PairRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ERC20PairRequest.Merge(m, src)
+}
+func (m *ERC20PairRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ERC20PairRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ERC20PairRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ERC20PairRequest proto.InternalMessageInfo
+
+func (m *ERC20PairRequest) GetDenomOrToken() string {
+	if m != nil {
+		return m.DenomOrToken
+	}
+	return ""
+}
+
+// ERC20PairResponse is the response type for the Query/ERC20Pair RPC method.
+type ERC20PairResponse struct {
+	// pair is the ERC20 token <> SDK coin denomination pair.
+	Pair *ERC20Pair `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair,omitempty"`
+}
+
+func (m *ERC20PairResponse) Reset()         { *m = ERC20PairResponse{} }
+func (m *ERC20PairResponse) String() string { return proto.CompactTextString(m) }
+func (*ERC20PairResponse) ProtoMessage()    {}
+func (*ERC20PairResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1c2b0bf57a29700, []int{8}
+}
+func (m *ERC20PairResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ERC20PairResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ERC20PairResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ERC20PairResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ERC20PairResponse.Merge(m, src)
+}
+func (m *ERC20PairResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ERC20PairResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ERC20PairResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ERC20PairResponse proto.InternalMessageInfo
+
+func (m *ERC20PairResponse) GetPair() *ERC20Pair {
+	if m != nil {
+		return m.Pair
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ERC20AddressForCoinDenomRequest)(nil), "jinx.erc20.v1alpha1.ERC20AddressForCoinDenomRequest")
 	proto.RegisterType((*ERC20AddressForCoinDenomResponse)(nil), "jinx.erc20.v1alpha1.ERC20AddressForCoinDenomResponse")
 	proto.RegisterType((*CoinDenomForERC20AddressRequest)(nil), "jinx.erc20.v1alpha1.CoinDenomForERC20AddressRequest")
 	proto.RegisterType((*CoinDenomForERC20AddressResponse)(nil), "jinx.erc20.v1alpha1.CoinDenomForERC20AddressResponse")
+	proto.RegisterType((*ERC20Pair)(nil), "jinx.erc20.v1alpha1.ERC20Pair")
+	proto.RegisterType((*ERC20PairsRequest)(nil), "jinx.erc20.v1alpha1.ERC20PairsRequest")
+	proto.RegisterType((*ERC20PairsResponse)(nil), "jinx.erc20.v1alpha1.ERC20PairsResponse")
+	proto.RegisterType((*ERC20PairRequest)(nil), "jinx.erc20.v1alpha1.ERC20PairRequest")
+	proto.RegisterType((*ERC20PairResponse)(nil), "jinx.erc20.v1alpha1.ERC20PairResponse")
 }
 
-func init() {
-	proto.RegisterFile("jinx/erc20/v1alpha1/query.proto", fileDescriptor_ffd29a6c0ff3273b)
-}
-
-var fileDescriptor_ffd29a6c0ff3273b = []byte{
-	// 381 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0xc1, 0x4e, 0xea, 0x40,
-	0x18, 0x85, 0xe9, 0xbd, 0xe1, 0x26, 0x77, 0xe2, 0xc6, 0x46, 0x0d, 0x12, 0x53, 0x48, 0x57, 0x26,
-	0xc6, 0x19, 0xc0, 0x05, 0x6c, 0x01, 0x25, 0xae, 0x34, 0xd6, 0x9d, 0x9b, 0x66, 0x68, 0x47, 0x68,
-	0x80, 0xf9, 0xcb, 0x4c, 0x21, 0xb2, 0xf5, 0x09, 0x4c, 0x7c, 0x01, 0x1f, 0xc2, 0x67, 0x30, 0x2e,
-	0x89, 0x6e, 0x5c, 0x1a, 0xf0, 0x41, 0x0c, 0x33, 0x85, 0x68, 0x42, 0xd3, 0xb0, 0xfc, 0xd3, 0x73,
-	0x4e, 0xcf, 0xf7, 0xcf, 0x0c, 0xb2, 0x43, 0xe8, 0x53, 0x11, 0x48, 0xc2, 0x84, 0x57, 0x29, 0x91,
-	0x71, 0x99, 0xf6, 0xc3, 0x2e, 0x2d, 0x93, 0xe1, 0x88, 0x89, 0x09, 0x0e, 0x05, 0x44, 0x60, 0xee,
-	0xc5, 0x1a, 0xac, 0x34, 0x78, 0xa9, 0xc9, 0xef, 0x7b, 0x20, 0x07, 0x20, 0x5d, 0xa5, 0x22, 0x7a,
-	0xd0, 0x96, 0xfc, 0x41, 0x07, 0xa0, 0xd3, 0x67, 0x84, 0x86, 0x01, 0xa1, 0x9c, 0x43, 0x44, 0xa3,
-	0x00, 0x78, 0xfc, 0xd5, 0xae, 0xa2, 0xc2, 0x99, 0xd3, 0xac, 0x94, 0xea, 0xbe, 0x2f, 0x98, 0x94,
-	0x2d, 0x10, 0x4d, 0x08, 0xf8, 0x29, 0xe3, 0x30, 0x70, 0xd8, 0x70, 0xc4, 0x64, 0x64, 0xee, 0xa0,
-	0xac, 0xbf, 0x98, 0x73, 0x46, 0xd1, 0x38, 0xfc, 0xef, 0xe8, 0xc1, 0xbe, 0x44, 0xc5, 0x64, 0xa3,
-	0x0c, 0x81, 0x4b, 0x66, 0x1e, 0xa1, 0x6c, 0x04, 0x3d, 0xc6, 0xb5, 0xb3, 0xb1, 0xfb, 0xf6, 0x7c,
-	0xbc, 0x1d, 0x77, 0xab, 0x7b, 0x5e, 0xec, 0x74, 0xb4, 0xc6, 0xbe, 0x40, 0x85, 0x55, 0x42, 0x0b,
-	0xc4, 0xcf, 0xf0, 0x65, 0x93, 0x8d, 0xf2, 0x6a, 0xa8, 0x98, 0x9c, 0x17, 0x17, 0x5c, 0x8b, 0x56,
-	0x79, 0xfa, 0x8b, 0xb6, 0xae, 0x16, 0x4b, 0xbf, 0x66, 0x62, 0x1c, 0x78, 0xcc, 0x7c, 0x31, 0x50,
-	0x2e, 0x09, 0xd6, 0xac, 0xe2, 0xf5, 0x67, 0x82, 0x53, 0xf6, 0x9a, 0xaf, 0x6d, 0x6e, 0xd4, 0xb5,
-	0xed, 0xea, 0xfd, 0xfb, 0xd7, 0xe3, 0x9f, 0xb2, 0x49, 0x48, 0xc2, 0x95, 0x51, 0xa3, 0x4b, 0x75,
-	0x84, 0x7b, 0x0b, 0xc2, 0x55, 0x64, 0x0a, 0x24, 0x69, 0x29, 0xc9, 0x20, 0x29, 0xc7, 0x92, 0x0c,
-	0x92, 0xb6, 0xff, 0x74, 0x10, 0x55, 0x5b, 0x01, 0xfc, 0x42, 0x6a, 0x9c, 0xbf, 0xce, 0x2c, 0x63,
-	0x3a, 0xb3, 0x8c, 0xcf, 0x99, 0x65, 0x3c, 0xcc, 0xad, 0xcc, 0x74, 0x6e, 0x65, 0x3e, 0xe6, 0x56,
-	0xe6, 0x06, 0x87, 0xbd, 0x0e, 0x6e, 0x33, 0x41, 0xbd, 0x2e, 0x0d, 0x38, 0xf6, 0xd9, 0x78, 0x95,
-	0xad, 0xaf, 0x0a, 0xb9, 0x8b, 0x7f, 0x12, 0x4d, 0x42, 0x26, 0xdb, 0xff, 0xd4, 0x3b, 0x38, 0xf9,
-	0x0e, 0x00, 0x00, 0xff, 0xff, 0xf1, 0xa9, 0x2d, 0x4d, 0x7e, 0x03, 0x00, 0x00,
+func init() { proto.RegisterFile("jinx/erc20/v1alpha1/query.proto", fileDescriptor_a1c2b0bf57a29700) }
+
+var fileDescriptor_a1c2b0bf57a29700 = []byte{
+	// 582 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x95, 0x3f, 0x6f, 0xd3, 0x40,
+	0x18, 0xc6, 0xe3, 0x40, 0x90, 0xfa, 0xb6, 0x42, 0xf4, 0x00, 0x29, 0x44, 0xc8, 0x09, 0x16, 0x24,
+	0x11, 0x85, 0x73, 0x63, 0x52, 0xd1, 0xb5, 0x2d, 0x4d, 0xb7, 0xb6, 0x18, 0x26, 0x18, 0xac, 0x8b,
+	0x73, 0xa4, 0xa6, 0xad, 0xcf, 0x3d, 0xbb, 0x11, 0x5d, 0x61, 0x60, 0x45, 0xea, 0xce, 0xa7, 0x60,
+	0x65, 0x67, 0xac, 0x60, 0x61, 0x44, 0x09, 0x1f, 0xa4, 0xf2, 0xdd, 0xc5, 0x71, 0xa4, 0xb8, 0x6e,
+	0xc6, 0xb3, 0x9f, 0xe7, 0xc9, 0xef, 0xfd, 0x73, 0x0e, 0x54, 0x3f, 0x7a, 0xfe, 0x27, 0x93, 0x72,
+	0xd7, 0x5a, 0x35, 0x07, 0x2d, 0x72, 0x14, 0x1c, 0x90, 0x96, 0x79, 0x72, 0x4a, 0xf9, 0x19, 0x0e,
+	0x38, 0x8b, 0x18, 0xba, 0x1b, 0x0b, 0xb0, 0x10, 0xe0, 0xb1, 0xa0, 0xf2, 0xd4, 0x65, 0xe1, 0x31,
+	0x0b, 0xcd, 0x2e, 0x09, 0xa9, 0x54, 0x9b, 0x83, 0x56, 0x97, 0x46, 0xa4, 0x65, 0x06, 0xa4, 0xef,
+	0xf9, 0x24, 0xf2, 0x98, 0x2f, 0x03, 0x2a, 0x0f, 0xa4, 0xd6, 0x11, 0x27, 0x53, 0x1e, 0xd4, 0xab,
+	0x87, 0x7d, 0xc6, 0xfa, 0x47, 0xd4, 0x24, 0x81, 0x67, 0x12, 0xdf, 0x67, 0x91, 0xf0, 0xa9, 0xb7,
+	0xc6, 0x4b, 0xa8, 0x6e, 0xdb, 0x5b, 0xd6, 0xea, 0x46, 0xaf, 0xc7, 0x69, 0x18, 0x76, 0x18, 0xdf,
+	0x62, 0x9e, 0xff, 0x8a, 0xfa, 0xec, 0xd8, 0xa6, 0x27, 0xa7, 0x34, 0x8c, 0xd0, 0x3d, 0x28, 0xf5,
+	0xe2, 0x73, 0x59, 0xab, 0x69, 0xcd, 0x05, 0x5b, 0x1e, 0x8c, 0x3d, 0xa8, 0x65, 0x1b, 0xc3, 0x80,
+	0xf9, 0x21, 0x45, 0x2b, 0x50, 0x8a, 0xd8, 0x21, 0xf5, 0xa5, 0x73, 0xf3, 0xfe, 0xef, 0x1f, 0xcf,
+	0x97, 0x15, 0xdb, 0x86, 0xeb, 0x2a, 0xa7, 0x2d, 0x35, 0xc6, 0x2e, 0x54, 0x93, 0x84, 0x0e, 0xe3,
+	0xe9, 0xf0, 0x31, 0xc9, 0x5c, 0x79, 0xeb, 0x50, 0xcb, 0xce, 0x53, 0x80, 0xb3, 0x4b, 0xdb, 0x85,
+	0x05, 0xa1, 0xde, 0x27, 0x1e, 0x9f, 0x2d, 0x99, 0x90, 0x14, 0xaf, 0x41, 0xf2, 0x1e, 0x96, 0x93,
+	0xbc, 0xa4, 0x96, 0x0e, 0xc0, 0x64, 0x8a, 0x22, 0x7c, 0xd1, 0xaa, 0x63, 0x95, 0x11, 0x8f, 0x1c,
+	0xcb, 0x05, 0x51, 0x23, 0xc7, 0xfb, 0xa4, 0x4f, 0x95, 0xd7, 0x4e, 0x39, 0x8d, 0x73, 0x0d, 0x50,
+	0x3a, 0x5d, 0x55, 0xd6, 0x86, 0x52, 0x10, 0x3f, 0x28, 0x6b, 0xb5, 0x1b, 0xcd, 0x45, 0x4b, 0xc7,
+	0x33, 0x36, 0x0c, 0x27, 0x3e, 0x5b, 0x8a, 0xd1, 0xce, 0x14, 0x54, 0x51, 0x40, 0x35, 0x72, 0xa1,
+	0xe4, 0x4f, 0x4e, 0x51, 0xad, 0xc3, 0x9d, 0x49, 0xb8, 0xaa, 0xf8, 0x31, 0xdc, 0x16, 0xcd, 0x73,
+	0x18, 0x77, 0x52, 0x63, 0xb4, 0x97, 0xc4, 0xd3, 0x3d, 0xfe, 0x56, 0x34, 0x6b, 0x27, 0xd5, 0xac,
+	0xa4, 0x1a, 0x0b, 0x6e, 0xc6, 0x80, 0xaa, 0x4d, 0x79, 0xc5, 0x08, 0xad, 0xf5, 0xbd, 0x04, 0x4b,
+	0xaf, 0x63, 0xda, 0x37, 0x94, 0x0f, 0x3c, 0x97, 0xa2, 0x9f, 0x1a, 0x94, 0xb3, 0x56, 0x16, 0xb5,
+	0xb3, 0x33, 0xb3, 0xaf, 0x46, 0x65, 0x6d, 0x4e, 0x97, 0x2c, 0xc7, 0x68, 0x7f, 0xfe, 0xf3, 0xff,
+	0xbc, 0x88, 0xd1, 0x33, 0x73, 0xd6, 0x87, 0x41, 0x1c, 0x1d, 0x22, 0xfd, 0xce, 0x07, 0xc6, 0x1d,
+	0xb9, 0x73, 0x31, 0x7f, 0xd6, 0x46, 0x67, 0xf0, 0xe7, 0x5c, 0xa8, 0xca, 0xda, 0x9c, 0xae, 0x6b,
+	0xf1, 0xcb, 0x21, 0xc7, 0xdc, 0x53, 0x95, 0xa0, 0xaf, 0x1a, 0xc0, 0x64, 0x53, 0x51, 0xfd, 0xea,
+	0x29, 0x26, 0x8c, 0x8d, 0x5c, 0x9d, 0xa2, 0x6a, 0x0a, 0x2a, 0x03, 0xd5, 0xae, 0xe8, 0xaa, 0x5c,
+	0xf3, 0x2f, 0x5a, 0xfa, 0x86, 0x3f, 0xc9, 0x59, 0x27, 0xc5, 0x51, 0xcf, 0x93, 0x29, 0x8c, 0x86,
+	0xc0, 0x78, 0x84, 0xaa, 0x39, 0x18, 0x9b, 0xdb, 0xbf, 0x86, 0xba, 0x76, 0x31, 0xd4, 0xb5, 0x7f,
+	0x43, 0x5d, 0xfb, 0x36, 0xd2, 0x0b, 0x17, 0x23, 0xbd, 0xf0, 0x77, 0xa4, 0x17, 0xde, 0xad, 0x04,
+	0x87, 0x7d, 0xdc, 0xa5, 0x9c, 0xb8, 0x07, 0xc4, 0xf3, 0x71, 0x8f, 0x0e, 0x64, 0x96, 0xfa, 0x43,
+	0x18, 0x87, 0x46, 0x67, 0x01, 0x0d, 0xbb, 0xb7, 0xc4, 0x87, 0xfc, 0xc5, 0xe5, 0x00, 0x2b, 0x9b,
+	0x73, 0xc6, 0x65, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -268,6 +534,11 @@ type QueryServiceClient interface {
 	ERC20AddressForCoinDenom(ctx context.Context, in *ERC20AddressForCoinDenomRequest, opts ...grpc.CallOption) (*ERC20AddressForCoinDenomResponse, error)
 	// CoinDenomForERC20Address queries the SDK coin denomination for a given ERC20 token address.
 	CoinDenomForERC20Address(ctx context.Context, in *CoinDenomForERC20AddressRequest, opts ...grpc.CallOption) (*CoinDenomForERC20AddressResponse, error)
+	// ERC20Pairs queries all the registered ERC20 token <> SDK coin denomination pairs.
+	ERC20Pairs(ctx context.Context, in *ERC20PairsRequest, opts ...grpc.CallOption) (*ERC20PairsResponse, error)
+	// ERC20Pair queries the ERC20 token <> SDK coin denomination pair of a given SDK coin
+	// denomination or ERC20 token address.
+	ERC20Pair(ctx context.Context, in *ERC20PairRequest, opts ...grpc.CallOption) (*ERC20PairResponse, error)
 }
 
 type queryServiceClient struct {
@@ -296,12 +567,35 @@ func (c *queryServiceClient) CoinDenomForERC20Address(ctx context.Context, in *C
 	return out, nil
 }
 
+func (c *queryServiceClient) ERC20Pairs(ctx context.Context, in *ERC20PairsRequest, opts ...grpc.CallOption) (*ERC20PairsResponse, error) {
+	out := new(ERC20PairsResponse)
+	err := c.cc.Invoke(ctx, "/jinx.erc20.v1alpha1.QueryService/ERC20Pairs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) ERC20Pair(ctx context.Context, in *ERC20PairRequest, opts ...grpc.CallOption) (*ERC20PairResponse, error) {
+	out := new(ERC20PairResponse)
+	err := c.cc.Invoke(ctx, "/jinx.erc20.v1alpha1.QueryService/ERC20Pair", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServiceServer is the server API for QueryService service.
 type QueryServiceServer interface {
 	// ERC20AddressForCoinDenom queries the ERC20 token address for a given SDK coin denomination.
 	ERC20AddressForCoinDenom(context.Context, *ERC20AddressForCoinDenomRequest) (*ERC20AddressForCoinDenomResponse, error)
 	// CoinDenomForERC20Address queries the SDK coin denomination for a given ERC20 token address.
 	CoinDenomForERC20Address(context.Context, *CoinDenomForERC20AddressRequest) (*CoinDenomForERC20AddressResponse, error)
+	// ERC20Pairs queries all the registered ERC20 token <> SDK coin denomination pairs.
+	ERC20Pairs(context.Context, *ERC20PairsRequest) (*ERC20PairsResponse, error)
+	// ERC20Pair queries the ERC20 token <> SDK coin denomination pair of a given SDK coin
+	// denomination or ERC20 token address.
+	ERC20Pair(context.Context, *ERC20PairRequest) (*ERC20PairResponse, error)
 }
 
 // UnimplementedQueryServiceServer can be embedded to have forward compatible implementations.
@@ -314,6 +608,12 @@ func (*UnimplementedQueryServiceServer) ERC20AddressForCoinDenom(ctx context.Con
 func (*UnimplementedQueryServiceServer) CoinDenomForERC20Address(ctx context.Context, req *CoinDenomForERC20AddressRequest) (*CoinDenomForERC20AddressResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CoinDenomForERC20Address not implemented")
 }
+func (*UnimplementedQueryServiceServer) ERC20Pairs(ctx context.Context, req *ERC20PairsRequest) (*ERC20PairsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ERC20Pairs not implemented")
+}
+func (*UnimplementedQueryServiceServer) ERC20Pair(ctx context.Context, req *ERC20PairRequest) (*ERC20PairResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ERC20Pair not implemented")
+}
 
 func RegisterQueryServiceServer(s grpc1.Server, srv QueryServiceServer) {
 	s.RegisterService(&_QueryService_serviceDesc, srv)
@@ -355,6 +655,42 @@ func _QueryService_CoinDenomForERC20Address_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _QueryService_ERC20Pairs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ERC20PairsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).ERC20Pairs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jinx.erc20.v1alpha1.QueryService/ERC20Pairs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).ERC20Pairs(ctx, req.(*ERC20PairsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_ERC20Pair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ERC20PairRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).ERC20Pair(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jinx.erc20.v1alpha1.QueryService/ERC20Pair",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).ERC20Pair(ctx, req.(*ERC20PairRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _QueryService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "jinx.erc20.v1alpha1.QueryService",
 	HandlerType: (*QueryServiceServer)(nil),
@@ -367,6 +703,14 @@ var _QueryService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CoinDenomForERC20Address",
 			Handler:    _QueryService_CoinDenomForERC20Address_Handler,
 		},
+		{
+			MethodName: "ERC20Pairs",
+			Handler:    _QueryService_ERC20Pairs_Handler,
+		},
+		{
+			MethodName: "ERC20Pair",
+			Handler:    _QueryService_ERC20Pair_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "jinx/erc20/v1alpha1/query.proto",
@@ -492,76 +836,583 @@ func (m *CoinDenomForERC20AddressResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *ERC20AddressForCoinDenomRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *ERC20Pair) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ERC20AddressForCoinDenomResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Token)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *ERC20Pair) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CoinDenomForERC20AddressRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ERC20Pair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Token)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *CoinDenomForERC20AddressResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ERC20PairsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ERC20PairsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ERC20PairsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ERC20PairsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ERC20PairsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ERC20PairsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Pairs) > 0 {
+		for iNdEx := len(m.Pairs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pairs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ERC20PairRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ERC20PairRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ERC20PairRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DenomOrToken) > 0 {
+		i -= len(m.DenomOrToken)
+		copy(dAtA[i:], m.DenomOrToken)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DenomOrToken)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ERC20PairResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ERC20PairResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ERC20PairResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pair != nil {
+		{
+			size, err := m.Pair.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ERC20AddressForCoinDenomRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ERC20AddressForCoinDenomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *CoinDenomForERC20AddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *CoinDenomForERC20AddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ERC20Pair) Size() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
 	l = len(m.Denom)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	return n
-}
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ERC20PairsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ERC20PairsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pairs) > 0 {
+		for _, e := range m.Pairs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ERC20PairRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DenomOrToken)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ERC20PairResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pair != nil {
+		l = m.Pair.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ERC20AddressForCoinDenomRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ERC20AddressForCoinDenomRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ERC20AddressForCoinDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ERC20AddressForCoinDenomResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ERC20AddressForCoinDenomResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ERC20AddressForCoinDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CoinDenomForERC20AddressRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CoinDenomForERC20AddressRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CoinDenomForERC20AddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *ERC20AddressForCoinDenomRequest) Unmarshal(dAtA []byte) error {
+func (m *CoinDenomForERC20AddressResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -584,10 +1435,10 @@ func (m *ERC20AddressForCoinDenomRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ERC20AddressForCoinDenomRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CoinDenomForERC20AddressResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ERC20AddressForCoinDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CoinDenomForERC20AddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -643,7 +1494,7 @@ func (m *ERC20AddressForCoinDenomRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ERC20AddressForCoinDenomResponse) Unmarshal(dAtA []byte) error {
+func (m *ERC20Pair) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -666,13 +1517,45 @@ func (m *ERC20AddressForCoinDenomResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ERC20AddressForCoinDenomResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ERC20Pair: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ERC20AddressForCoinDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ERC20Pair: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
 			}
@@ -725,7 +1608,7 @@ func (m *ERC20AddressForCoinDenomResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CoinDenomForERC20AddressRequest) Unmarshal(dAtA []byte) error {
+func (m *ERC20PairsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -748,17 +1631,17 @@ func (m *CoinDenomForERC20AddressRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CoinDenomForERC20AddressRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ERC20PairsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CoinDenomForERC20AddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ERC20PairsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -768,23 +1651,27 @@ func (m *CoinDenomForERC20AddressRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Token = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -807,7 +1694,7 @@ func (m *CoinDenomForERC20AddressRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CoinDenomForERC20AddressResponse) Unmarshal(dAtA []byte) error {
+func (m *ERC20PairsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -830,15 +1717,135 @@ func (m *CoinDenomForERC20AddressResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CoinDenomForERC20AddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ERC20PairsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CoinDenomForERC20AddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ERC20PairsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pairs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pairs = append(m.Pairs, &ERC20Pair{})
+			if err := m.Pairs[len(m.Pairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ERC20PairRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ERC20PairRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ERC20PairRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomOrToken", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -866,7 +1873,93 @@ func (m *CoinDenomForERC20AddressResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.DenomOrToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ERC20PairResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ERC20PairResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ERC20PairResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pair == nil {
+				m.Pair = &ERC20Pair{}
+			}
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -30,10 +30,19 @@ import (
 	"pkg.berachain.dev/jinx/lib/errors"
 )
 
+// HandlerOptions are the options required for constructing the Jinx AnteHandler.
+type HandlerOptions struct {
+	ante.HandlerOptions
+
+	// EVMKeeper is used by the Ethereum decorators to validate Ethereum transactions.
+	EVMKeeper EVMKeeper
+}
+
 // NewAnteHandler returns an AnteHandler that checks and increments sequence
 // numbers, checks signatures & account numbers, and deducts fees from the first
-// signer.
-func NewAnteHandler(options ante.HandlerOptions) (sdk.AnteHandler, error) {
+// signer. Ethereum transactions skip the Cosmos fee, gas and signature decorators
+// and are validated by the Ethereum decorators instead.
+func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 	if options.AccountKeeper == nil {
 		return nil, errors.Wrap(sdkerrors.ErrLogic, "account keeper is required for ante builder")
 	}
@@ -46,10 +55,17 @@ func NewAnteHandler(options ante.HandlerOptions) (sdk.AnteHandler, error) {
 		return nil, errors.Wrap(sdkerrors.ErrLogic, "sign mode handler is required for ante builder")
 	}
 
+	if options.EVMKeeper == nil {
+		return nil, errors.Wrap(sdkerrors.ErrLogic, "evm keeper is required for ante builder")
+	}
+
 	anteDecorators := []sdk.AnteDecorator{
 		ante.NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
 		ante.NewExtensionOptionsDecorator(options.ExtensionOptionChecker),
 		ante.NewValidateBasicDecorator(),
+		// The Ethereum calls and creates of Cosmos accounts consume the sequence of the Cosmos
+		// transaction as their nonce, so there can only be one of them per sender.
+		NewHostAuthorizedEthMsgDecorator(),
 		ante.NewTxTimeoutHeightDecorator(),
 		ante.NewValidateMemoDecorator(options.AccountKeeper),
 		// EthTransactions can skip consuming transaction gas as it will be done
@@ -58,11 +74,20 @@ func NewAnteHandler(options ante.HandlerOptions) (sdk.AnteHandler, error) {
 			ante.NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
 		),
 		// EthTransaction can skip deduct fee transactions as they are done in the
-		// StateTransition. // TODO: check to make sure this doesn't cause spam.
+		// StateTransition. Instead, the EthGasDecorator and EthCanTransferDecorator
+		// ensure that the sender can pay for the transaction.
 		antelib.NewIgnoreDecorator[ante.DeductFeeDecorator, *types.WrappedEthereumTransaction](
 			ante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper,
 				options.FeegrantKeeper, options.TxFeeChecker),
 		),
+		// EthTransactions are signed over the Ethereum signing hash, so their signature and
+		// chain ID are verified by the EthSigVerificationDecorator, before anything else is
+		// checked against the state of the sender.
+		NewEthSigVerificationDecorator(options.EVMKeeper),
+		NewEthGasDecorator(options.EVMKeeper),
+		// EthTransactions executed ahead, in parallel at the start of the block, skip the checks
+		// against the state of their sender, which the state transition already made.
+		NewSkipExecutedDecorator(options.EVMKeeper, NewEthCanTransferDecorator(options.EVMKeeper)),
 		ante.NewSetPubKeyDecorator(options.AccountKeeper),
 		ante.NewValidateSigCountDecorator(options.AccountKeeper),
 		// In order to match ethereum gas consumption, we do not consume any gas when
@@ -70,22 +95,18 @@ func NewAnteHandler(options ante.HandlerOptions) (sdk.AnteHandler, error) {
 		antelib.NewIgnoreDecorator[ante.SigGasConsumeDecorator, *types.WrappedEthereumTransaction](
 			ante.NewSigGasConsumeDecorator(options.AccountKeeper, options.SigGasConsumer),
 		),
-		// EthTransaction can skip Signature Verification as we do this in the mempool.
-		// TODO: // check with Marko to make sure this is okay (ties into the one below)
+		// EthTransactions skip the Cosmos signature verification, see above.
 		antelib.NewIgnoreDecorator[ante.SigVerificationDecorator, *types.WrappedEthereumTransaction](
 			ante.NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler),
 		),
-		// EthTransactions are allowed to skip sequence verification as we do this in the
-		// state transition.
-		// NOTE: we may need to change this as it could cause issues if a client is intertwining
-		// Ethreum and Cosmos transactions within a close timeframe.
-		// By skipping this for Eth Transactions, the Account Seq of the sender does not get updated
-		// in checkState during checkTx, but only in DeliverTx, since we are upping in nonce during the
-		// actual execution of the block and not during the ante handler.
-		// TODO: // check with Marko to make sure this is okay.
+		// EthTransactions increment the nonce of the sender in the state transition. The
+		// EthIncrementNonceDecorator checks the nonce against the account sequence and only
+		// increments it in the CheckTx state, so that a client can send sequential Ethereum
+		// transactions (intertwined with Cosmos ones) before they are included in a block.
 		antelib.NewIgnoreDecorator[ante.IncrementSequenceDecorator, *types.WrappedEthereumTransaction](
 			ante.NewIncrementSequenceDecorator(options.AccountKeeper),
 		),
+		NewSkipExecutedDecorator(options.EVMKeeper, NewEthIncrementNonceDecorator(options.AccountKeeper)),
 	}
 	return sdk.ChainAnteDecorators(anteDecorators...), nil
 }
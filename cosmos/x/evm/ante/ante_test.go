@@ -0,0 +1,255 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Blackchain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package ante_test
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"math/big"
+	"testing"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+
+	"pkg.berachain.dev/jinx/cosmos/crypto/keys/ethsecp256k1"
+	testutil "pkg.berachain.dev/jinx/cosmos/testing/utils"
+	"pkg.berachain.dev/jinx/cosmos/x/evm/ante"
+	"pkg.berachain.dev/jinx/cosmos/x/evm/types"
+	"pkg.berachain.dev/jinx/eth/common"
+	coretypes "pkg.berachain.dev/jinx/eth/core/types"
+	"pkg.berachain.dev/jinx/eth/crypto"
+	"pkg.berachain.dev/jinx/eth/params"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestAnte(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "cosmos/x/evm/ante")
+}
+
+// txGas is the intrinsic gas of a plain value transfer.
+const txGas = 21000
+
+var _ = Describe("Ethereum Decorators", func() {
+	var (
+		ctx     sdk.Context
+		ak      authkeeper.AccountKeeper
+		ek      *mockEVMKeeper
+		handler sdk.AnteHandler
+		key     *ecdsa.PrivateKey
+		pubKey  cryptotypes.PubKey
+		sender  common.Address
+		signer  coretypes.Signer
+	)
+
+	BeforeEach(func() {
+		ctx, ak, _, _ = testutil.SetupMinimalKeepers()
+		ctx = ctx.WithIsCheckTx(false)
+		ek = &mockEVMKeeper{
+			chainConfig: params.DefaultChainConfig,
+			baseFee:     big.NewInt(1000),
+			balances:    map[common.Address]*big.Int{},
+			executed:    map[common.Hash]bool{},
+		}
+		handler = sdk.ChainAnteDecorators(
+			ante.NewEthSigVerificationDecorator(ek),
+			ante.NewEthGasDecorator(ek),
+			ante.NewSkipExecutedDecorator(ek, ante.NewEthCanTransferDecorator(ek)),
+			ante.NewSkipExecutedDecorator(ek, ante.NewEthIncrementNonceDecorator(ak)),
+		)
+
+		privKey, err := ethsecp256k1.GenPrivKey()
+		Expect(err).ToNot(HaveOccurred())
+		key, err = privKey.ToECDSA()
+		Expect(err).ToNot(HaveOccurred())
+		pubKey = privKey.PubKey()
+		sender = crypto.PubkeyToAddress(key.PublicKey)
+		signer = coretypes.LatestSignerForChainID(params.DefaultChainConfig.ChainID)
+
+		ek.balances[sender] = big.NewInt(1e18)
+		acc := ak.NewAccountWithAddress(ctx, sender.Bytes())
+		Expect(acc.SetSequence(1)).To(Succeed())
+		ak.SetAccount(ctx, acc)
+	})
+
+	signTx := func(s coretypes.Signer, nonce uint64, gasFeeCap int64) *coretypes.Transaction {
+		return coretypes.MustSignNewTx(key, s, &coretypes.DynamicFeeTx{
+			ChainID:   s.ChainID(),
+			Nonce:     nonce,
+			To:        &common.Address{1},
+			Gas:       txGas,
+			GasFeeCap: big.NewInt(gasFeeCap),
+			GasTipCap: big.NewInt(1),
+			Value:     big.NewInt(1),
+		})
+	}
+
+	It("should accept a valid transaction", func() {
+		_, err := handler(ctx, newEthSdkTx(signTx(signer, 1, 1000), pubKey), false)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should reject a transaction with a bad signature", func() {
+		tx, err := signTx(signer, 1, 1000).WithSignature(signer, make([]byte, crypto.SignatureLength))
+		Expect(err).ToNot(HaveOccurred())
+		_, err = handler(ctx, newEthSdkTx(tx, pubKey), false)
+		Expect(errors.Is(err, sdkerrors.ErrUnauthorized)).To(BeTrue())
+	})
+
+	It("should reject a transaction signed by someone else than the tx signer", func() {
+		otherKey, err := ethsecp256k1.GenPrivKey()
+		Expect(err).ToNot(HaveOccurred())
+		_, err = handler(ctx, newEthSdkTx(signTx(signer, 1, 1000), otherKey.PubKey()), false)
+		Expect(errors.Is(err, sdkerrors.ErrUnauthorized)).To(BeTrue())
+	})
+
+	It("should reject a transaction signed for another chain", func() {
+		otherSigner := coretypes.LatestSignerForChainID(big.NewInt(1))
+		_, err := handler(ctx, newEthSdkTx(signTx(otherSigner, 1, 1000), pubKey), false)
+		Expect(errors.Is(err, sdkerrors.ErrInvalidChainID)).To(BeTrue())
+	})
+
+	It("should reject a transaction from a sender with insufficient balance", func() {
+		ek.balances[sender] = big.NewInt(int64(txGas) * 1000)
+		_, err := handler(ctx, newEthSdkTx(signTx(signer, 1, 1000), pubKey), false)
+		Expect(errors.Is(err, sdkerrors.ErrInsufficientFunds)).To(BeTrue())
+	})
+
+	It("should reject a transaction with a fee cap below the base fee", func() {
+		_, err := handler(ctx, newEthSdkTx(signTx(signer, 1, 999), pubKey), false)
+		Expect(errors.Is(err, sdkerrors.ErrInsufficientFee)).To(BeTrue())
+	})
+
+	It("should reject a transaction with a nonce mismatch", func() {
+		_, err := handler(ctx, newEthSdkTx(signTx(signer, 0, 1000), pubKey), false)
+		Expect(errors.Is(err, sdkerrors.ErrWrongSequence)).To(BeTrue())
+
+		_, err = handler(ctx, newEthSdkTx(signTx(signer, 2, 1000), pubKey), false)
+		Expect(errors.Is(err, sdkerrors.ErrWrongSequence)).To(BeTrue())
+
+		// Nonce gaps are only accepted as queued transactions during CheckTx.
+		_, err = handler(ctx.WithIsCheckTx(true), newEthSdkTx(signTx(signer, 2, 1000), pubKey), false)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should skip the sender checks of transactions executed with the block", func() {
+		tx := signTx(signer, 0, 1000)
+		ek.balances[sender] = new(big.Int)
+		ek.executed[tx.Hash()] = true
+		_, err := handler(ctx, newEthSdkTx(tx, pubKey), false)
+		Expect(err).ToNot(HaveOccurred())
+
+		// The signature and gas are still checked.
+		otherKey, err := ethsecp256k1.GenPrivKey()
+		Expect(err).ToNot(HaveOccurred())
+		_, err = handler(ctx, newEthSdkTx(tx, otherKey.PubKey()), false)
+		Expect(errors.Is(err, sdkerrors.ErrUnauthorized)).To(BeTrue())
+		tx = signTx(signer, 0, 999)
+		ek.executed[tx.Hash()] = true
+		_, err = handler(ctx, newEthSdkTx(tx, pubKey), false)
+		Expect(errors.Is(err, sdkerrors.ErrInsufficientFee)).To(BeTrue())
+	})
+
+	It("should return an error if the chain config cannot be read", func() {
+		ek.chainConfigErr = errors.New("invalid chain config")
+		_, err := handler(ctx, newEthSdkTx(signTx(signer, 1, 1000), pubKey), false)
+		Expect(errors.Is(err, sdkerrors.ErrLogic)).To(BeTrue())
+	})
+})
+
+var _ = Describe("Host Authorized Ethereum Messages", func() {
+	var (
+		handler = sdk.ChainAnteDecorators(ante.NewHostAuthorizedEthMsgDecorator())
+		alice   = sdk.AccAddress([]byte{0x1})
+		bob     = sdk.AccAddress([]byte{0x2})
+	)
+
+	newTx := func(msgs ...sdk.Msg) sdk.Tx {
+		builder := testutil.GetEncodingConfig().TxConfig.NewTxBuilder()
+		Expect(builder.SetMsgs(msgs...)).To(Succeed())
+		return builder.GetTx()
+	}
+	call := func(from sdk.AccAddress) sdk.Msg {
+		return types.NewMsgEthereumCall(from, common.Address{0x3}, nil, new(big.Int), txGas)
+	}
+	create := func(from sdk.AccAddress) sdk.Msg {
+		return types.NewMsgEthereumCreate(from, []byte{0x1}, new(big.Int), 100000)
+	}
+
+	It("should accept one message per sender", func() {
+		_, err := handler(sdk.Context{}, newTx(call(alice), create(bob)), false)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should reject several messages of the same sender", func() {
+		_, err := handler(sdk.Context{}, newTx(call(alice), call(alice)), false)
+		Expect(errors.Is(err, sdkerrors.ErrInvalidRequest)).To(BeTrue())
+		_, err = handler(sdk.Context{}, newTx(create(bob), call(alice), call(bob)), false)
+		Expect(errors.Is(err, sdkerrors.ErrInvalidRequest)).To(BeTrue())
+	})
+})
+
+// newEthSdkTx wraps the given Ethereum transaction in a Cosmos tx signed by the given public key.
+func newEthSdkTx(ethTx *coretypes.Transaction, pubKey cryptotypes.PubKey) sdk.Tx {
+	builder := testutil.GetEncodingConfig().TxConfig.NewTxBuilder()
+	Expect(builder.SetMsgs(types.NewFromTransaction(ethTx))).To(Succeed())
+	Expect(builder.SetSignatures(signingtypes.SignatureV2{
+		PubKey: pubKey,
+		Data: &signingtypes.SingleSignatureData{
+			SignMode: signingtypes.SignMode(int32(ante.SignMode_SIGN_MODE_ETHEREUM)),
+		},
+		Sequence: ethTx.Nonce(),
+	})).To(Succeed())
+	return builder.GetTx()
+}
+
+// mockEVMKeeper is an ante.EVMKeeper with a fixed chain config, base fee and balances.
+type mockEVMKeeper struct {
+	chainConfig    *params.ChainConfig
+	chainConfigErr error
+	baseFee        *big.Int
+	balances       map[common.Address]*big.Int
+	executed       map[common.Hash]bool
+}
+
+func (ek *mockEVMKeeper) GetChainConfig(sdk.Context) (*params.ChainConfig, error) {
+	return ek.chainConfig, ek.chainConfigErr
+}
+
+func (ek *mockEVMKeeper) GetBaseFee(sdk.Context) *big.Int {
+	return ek.baseFee
+}
+
+func (ek *mockEVMKeeper) GetBalance(_ sdk.Context, addr sdk.AccAddress) *big.Int {
+	if balance, ok := ek.balances[common.BytesToAddress(addr)]; ok {
+		return balance
+	}
+	return new(big.Int)
+}
+
+func (ek *mockEVMKeeper) IsExecuted(_ sdk.Context, txHash common.Hash) bool {
+	return ek.executed[txHash]
+}
@@ -0,0 +1,334 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Blackchain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package ante
+
+import (
+	"bytes"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+
+	"pkg.berachain.dev/jinx/cosmos/x/evm/types"
+	"pkg.berachain.dev/jinx/eth/common"
+	"pkg.berachain.dev/jinx/eth/core"
+	coretypes "pkg.berachain.dev/jinx/eth/core/types"
+	"pkg.berachain.dev/jinx/eth/params"
+	"pkg.berachain.dev/jinx/lib/errors"
+)
+
+// EVMKeeper defines the expected EVM keeper used by the Ethereum ante decorators.
+type EVMKeeper interface {
+	// GetChainConfig returns the chain config of the EVM, or an error if it cannot be decoded.
+	GetChainConfig(sdk.Context) (*params.ChainConfig, error)
+	// GetBaseFee returns the base fee that Ethereum transactions must pay, or nil if unknown.
+	GetBaseFee(sdk.Context) *big.Int
+	// GetBalance returns the EVM balance of the given account.
+	GetBalance(sdk.Context, sdk.AccAddress) *big.Int
+	// IsExecuted returns whether the given Ethereum transaction of the block being delivered was
+	// already executed, in parallel with the others at the start of the block.
+	IsExecuted(sdk.Context, common.Hash) bool
+}
+
+// EthSigVerificationDecorator verifies the signature of Ethereum transactions against the chain
+// ID of the EVM, and ensures that the recovered sender matches the signer of the Cosmos tx. It also
+// rejects EIP-4844 blob transactions, which are not supported.
+type EthSigVerificationDecorator struct {
+	ek EVMKeeper
+}
+
+// NewEthSigVerificationDecorator returns a new EthSigVerificationDecorator.
+func NewEthSigVerificationDecorator(ek EVMKeeper) EthSigVerificationDecorator {
+	return EthSigVerificationDecorator{ek: ek}
+}
+
+// AnteHandle implements sdk.AnteDecorator.
+func (esvd EthSigVerificationDecorator) AnteHandle(
+	ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler,
+) (sdk.Context, error) {
+	ethTx := types.GetAsEthTx(tx)
+	if ethTx == nil {
+		return next(ctx, tx, simulate)
+	}
+
+	// Blob transactions carry data that Jinx cannot make available, so they are rejected upfront.
+	if coretypes.IsBlobTx(ethTx) {
+		return ctx, errors.Wrap(sdkerrors.ErrInvalidRequest, coretypes.ErrBlobTxNotSupported.Error())
+	}
+
+	chainConfig, err := esvd.ek.GetChainConfig(ctx)
+	if err != nil {
+		return ctx, errors.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if chainConfig == nil {
+		return ctx, errors.Wrap(sdkerrors.ErrLogic, "chain config not found")
+	}
+
+	// Replay protected transactions must be signed for this chain.
+	if ethTx.Protected() && ethTx.ChainId().Cmp(chainConfig.ChainID) != 0 {
+		return ctx, errors.Wrapf(
+			sdkerrors.ErrInvalidChainID, "have %d want %d", ethTx.ChainId(), chainConfig.ChainID,
+		)
+	}
+
+	sender, err := coretypes.Sender(coretypes.LatestSignerForChainID(chainConfig.ChainID), ethTx)
+	if err != nil {
+		return ctx, errors.Wrapf(sdkerrors.ErrUnauthorized, "invalid ethereum signature: %v", err)
+	}
+
+	// The signer of the Cosmos tx must be the sender of the Ethereum tx, as the account it sets up
+	// (pubkey and sequence) is the one the Ethereum tx is executed for.
+	sigTx, ok := tx.(signing.SigVerifiableTx)
+	if !ok {
+		return ctx, errors.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type")
+	}
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return ctx, err
+	}
+	if len(sigs) != 1 || sigs[0].PubKey == nil ||
+		!bytes.Equal(sigs[0].PubKey.Address(), sender.Bytes()) {
+		return ctx, errors.Wrapf(
+			sdkerrors.ErrUnauthorized, "tx signer does not match ethereum sender %s", sender,
+		)
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// EthGasDecorator ensures that Ethereum transactions provide enough gas to cover their intrinsic
+// gas and that their fee cap covers the base fee of the block.
+type EthGasDecorator struct {
+	ek EVMKeeper
+}
+
+// NewEthGasDecorator returns a new EthGasDecorator.
+func NewEthGasDecorator(ek EVMKeeper) EthGasDecorator {
+	return EthGasDecorator{ek: ek}
+}
+
+// AnteHandle implements sdk.AnteDecorator.
+func (egd EthGasDecorator) AnteHandle(
+	ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler,
+) (sdk.Context, error) {
+	ethTx := types.GetAsEthTx(tx)
+	if ethTx == nil {
+		return next(ctx, tx, simulate)
+	}
+
+	chainConfig, err := egd.ek.GetChainConfig(ctx)
+	if err != nil {
+		return ctx, errors.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if chainConfig == nil {
+		return ctx, errors.Wrap(sdkerrors.ErrLogic, "chain config not found")
+	}
+
+	// Ensure the transaction has more gas than the bare minimum needed to cover its costs.
+	rules := chainConfig.Rules(big.NewInt(ctx.BlockHeight()), true, uint64(ctx.BlockTime().Unix()))
+	intrGas, err := core.IntrinsicGas(
+		ethTx.Data(), ethTx.AccessList(), ethTx.To() == nil,
+		rules.IsHomestead, rules.IsIstanbul, rules.IsShanghai,
+	)
+	if err != nil {
+		return ctx, errors.Wrap(sdkerrors.ErrOutOfGas, err.Error())
+	}
+	if ethTx.Gas() < intrGas {
+		return ctx, errors.Wrapf(
+			sdkerrors.ErrOutOfGas, "%v: have %d, want %d", core.ErrIntrinsicGas, ethTx.Gas(), intrGas,
+		)
+	}
+
+	// Ensure the transaction pays at least the base fee of the block.
+	if baseFee := egd.ek.GetBaseFee(ctx); baseFee != nil && ethTx.GasFeeCapIntCmp(baseFee) < 0 {
+		return ctx, errors.Wrapf(
+			sdkerrors.ErrInsufficientFee, "%v: gasFeeCap %s, baseFee %s",
+			core.ErrFeeCapTooLow, ethTx.GasFeeCap(), baseFee,
+		)
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// EthCanTransferDecorator ensures that the sender of an Ethereum transaction has enough balance
+// to pay for the maximum cost of the transaction, i.e. gas * gasFeeCap + value.
+type EthCanTransferDecorator struct {
+	ek EVMKeeper
+}
+
+// NewEthCanTransferDecorator returns a new EthCanTransferDecorator.
+func NewEthCanTransferDecorator(ek EVMKeeper) EthCanTransferDecorator {
+	return EthCanTransferDecorator{ek: ek}
+}
+
+// AnteHandle implements sdk.AnteDecorator.
+func (ectd EthCanTransferDecorator) AnteHandle(
+	ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler,
+) (sdk.Context, error) {
+	ethTx := types.GetAsEthTx(tx)
+	if ethTx == nil {
+		return next(ctx, tx, simulate)
+	}
+
+	sender := coretypes.GetSender(ethTx)
+	balance := ectd.ek.GetBalance(ctx, sender.Bytes())
+	maxCost := new(big.Int).Mul(new(big.Int).SetUint64(ethTx.Gas()), ethTx.GasFeeCap())
+	maxCost.Add(maxCost, ethTx.Value())
+	if balance.Cmp(maxCost) < 0 {
+		return ctx, errors.Wrapf(
+			sdkerrors.ErrInsufficientFunds, "%v: address %s have %s want %s",
+			core.ErrInsufficientFunds, sender, balance, maxCost,
+		)
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// EthIncrementNonceDecorator ensures that the nonce of an Ethereum transaction is not lower than
+// the sequence of the sender's account. During CheckTx, it increments the sequence of the account
+// when the nonce is the next one in line, so that sequential transactions from the same sender can
+// be accepted before they are included in a block. During DeliverTx, the sequence is incremented
+// by the state transition.
+type EthIncrementNonceDecorator struct {
+	ak ante.AccountKeeper
+}
+
+// NewEthIncrementNonceDecorator returns a new EthIncrementNonceDecorator.
+func NewEthIncrementNonceDecorator(ak ante.AccountKeeper) EthIncrementNonceDecorator {
+	return EthIncrementNonceDecorator{ak: ak}
+}
+
+// AnteHandle implements sdk.AnteDecorator.
+func (eind EthIncrementNonceDecorator) AnteHandle(
+	ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler,
+) (sdk.Context, error) {
+	ethTx := types.GetAsEthTx(tx)
+	if ethTx == nil {
+		return next(ctx, tx, simulate)
+	}
+
+	sender := coretypes.GetSender(ethTx)
+	acc := eind.ak.GetAccount(ctx, sender.Bytes())
+	if acc == nil {
+		// Accounts without a nonce can only send their first transaction.
+		if ethTx.Nonce() != 0 && !ctx.IsCheckTx() {
+			return ctx, nonceTooHighErr(sender, ethTx.Nonce(), 0)
+		}
+		return next(ctx, tx, simulate)
+	}
+
+	seq := acc.GetSequence()
+	if ethTx.Nonce() < seq {
+		return ctx, errors.Wrapf(
+			sdkerrors.ErrWrongSequence, "%v: address %s, tx: %d state: %d",
+			core.ErrNonceTooLow, sender, ethTx.Nonce(), seq,
+		)
+	}
+
+	// Transactions with a nonce gap are accepted (as queued) during CheckTx, but never in a block.
+	if ethTx.Nonce() > seq && !ctx.IsCheckTx() {
+		return ctx, nonceTooHighErr(sender, ethTx.Nonce(), seq)
+	}
+
+	// Increment the sequence in the CheckTx state only, so that the next transaction of the
+	// sender is checked against the nonce after this one.
+	if ctx.IsCheckTx() && !simulate && ethTx.Nonce() == seq {
+		if err := acc.SetSequence(seq + 1); err != nil {
+			return ctx, err
+		}
+		eind.ak.SetAccount(ctx, acc)
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// HostAuthorizedEthMsgDecorator rejects the Cosmos transactions that contain more than one
+// `MsgEthereumCall` or `MsgEthereumCreate` of the same sender. Their Ethereum transaction is
+// applied with the nonce of the sequence that the Cosmos transaction consumed, which is only
+// incremented once per transaction.
+type HostAuthorizedEthMsgDecorator struct{}
+
+// NewHostAuthorizedEthMsgDecorator returns a new HostAuthorizedEthMsgDecorator.
+func NewHostAuthorizedEthMsgDecorator() HostAuthorizedEthMsgDecorator {
+	return HostAuthorizedEthMsgDecorator{}
+}
+
+// AnteHandle implements sdk.AnteDecorator.
+func (hamd HostAuthorizedEthMsgDecorator) AnteHandle(
+	ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler,
+) (sdk.Context, error) {
+	senders := make(map[common.Address]struct{})
+	for _, msg := range tx.GetMsgs() {
+		var sender common.Address
+		switch msg := msg.(type) {
+		case *types.MsgEthereumCall:
+			sender = msg.GetSender()
+		case *types.MsgEthereumCreate:
+			sender = msg.GetSender()
+		default:
+			continue
+		}
+
+		if _, ok := senders[sender]; ok {
+			return ctx, errors.Wrapf(
+				sdkerrors.ErrInvalidRequest,
+				"only one ethereum call or create per sender is allowed in a transaction: %s", sender,
+			)
+		}
+		senders[sender] = struct{}{}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// SkipExecutedDecorator skips the given decorator for the Ethereum transactions that were already
+// executed while delivering the block, in parallel with the others at the start of the block. It
+// wraps the decorators that check the transactions against the state of their sender, which the
+// state transition already did against the state that they were executed on.
+type SkipExecutedDecorator struct {
+	ek        EVMKeeper
+	decorator sdk.AnteDecorator
+}
+
+// NewSkipExecutedDecorator returns a new SkipExecutedDecorator.
+func NewSkipExecutedDecorator(ek EVMKeeper, decorator sdk.AnteDecorator) SkipExecutedDecorator {
+	return SkipExecutedDecorator{ek: ek, decorator: decorator}
+}
+
+// AnteHandle implements sdk.AnteDecorator.
+func (sed SkipExecutedDecorator) AnteHandle(
+	ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler,
+) (sdk.Context, error) {
+	if ethTx := types.GetAsEthTx(tx); ethTx != nil && sed.ek.IsExecuted(ctx, ethTx.Hash()) {
+		return next(ctx, tx, simulate)
+	}
+	return sed.decorator.AnteHandle(ctx, tx, simulate, next)
+}
+
+// nonceTooHighErr returns the error for a transaction with a nonce gap.
+func nonceTooHighErr(sender common.Address, txNonce, seq uint64) error {
+	return errors.Wrapf(
+		sdkerrors.ErrWrongSequence, "%v: address %s, tx: %d state: %d",
+		core.ErrNonceTooHigh, sender, txNonce, seq,
+	)
+}
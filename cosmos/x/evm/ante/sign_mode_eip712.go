@@ -0,0 +1,201 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Blackchain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package ante
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+
+	signingv1beta1 "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
+	txsigning "cosmossdk.io/x/tx/signing"
+	"cosmossdk.io/x/tx/signing/aminojson"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+
+	errorslib "pkg.berachain.dev/jinx/lib/errors"
+)
+
+// SignMode_SIGN_MODE_EIP712 defines the sign mode for Cosmos transactions that are signed as
+// EIP-712 typed data, i.e. by an Ethereum wallet.
+//
+//nolint:revive,stylecheck // underscores used for sign modes.
+const SignMode_SIGN_MODE_EIP712 signingv1beta1.SignMode = 712
+
+const (
+	// eip712DomainName is the name of the EIP-712 domain of the Cosmos transactions.
+	eip712DomainName = "Jinx"
+	// eip712DomainVersion is the version of the EIP-712 domain of the Cosmos transactions.
+	eip712DomainVersion = "1"
+	// eip712PrimaryType is the EIP-712 type of the Cosmos transactions.
+	eip712PrimaryType = "Tx"
+)
+
+// eip712Types are the EIP-712 types of a Cosmos transaction. The fee and the messages are
+// represented by their (deterministic and human readable) amino JSON encoding.
+var eip712Types = apitypes.Types{
+	"EIP712Domain": {
+		{Name: "name", Type: "string"},
+		{Name: "version", Type: "string"},
+		{Name: "chainId", Type: "uint256"},
+	},
+	eip712PrimaryType: {
+		{Name: "account_number", Type: "string"},
+		{Name: "chain_id", Type: "string"},
+		{Name: "fee", Type: "string"},
+		{Name: "memo", Type: "string"},
+		{Name: "msgs", Type: "string[]"},
+		{Name: "sequence", Type: "string"},
+		{Name: "timeout_height", Type: "string"},
+	},
+}
+
+var _ txsigning.SignModeHandler = (*SignModeEIP712Handler)(nil)
+
+// SignModeEIP712Handler defines the sign mode for Cosmos transactions that are signed as EIP-712
+// typed data. The sign bytes are the EIP-712 hash of the typed data, so the signature can be
+// verified by an `ethsecp256k1.PubKey`.
+type SignModeEIP712Handler struct {
+	aminoJSON *aminojson.SignModeHandler
+
+	// evmChainID returns the chain ID of the EVM, which the EIP-712 domain is tied to.
+	evmChainID func(context.Context) (*big.Int, error)
+}
+
+// NewSignModeEIP712Handler returns a new `SignModeEIP712Handler`. The amino JSON options are used
+// to encode the transaction and `evmChainID` returns the EVM chain ID for the context the sign
+// bytes are requested in.
+func NewSignModeEIP712Handler(
+	opts aminojson.SignModeHandlerOptions, evmChainID func(context.Context) (*big.Int, error),
+) *SignModeEIP712Handler {
+	return &SignModeEIP712Handler{
+		aminoJSON:  aminojson.NewSignModeHandler(opts),
+		evmChainID: evmChainID,
+	}
+}
+
+// Mode implements txsigning.SignModeHandler.
+func (s *SignModeEIP712Handler) Mode() signingv1beta1.SignMode {
+	return SignMode_SIGN_MODE_EIP712
+}
+
+// GetSignBytes implements txsigning.SignModeHandler.
+func (s *SignModeEIP712Handler) GetSignBytes(
+	ctx context.Context, signerData txsigning.SignerData, txData txsigning.TxData,
+) ([]byte, error) {
+	signDoc, err := s.aminoJSON.GetSignBytes(ctx, signerData, txData)
+	if err != nil {
+		return nil, err
+	}
+	chainID, err := s.evmChainID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	typedData, err := NewEIP712TypedData(chainID, signDoc)
+	if err != nil {
+		return nil, err
+	}
+	sighash, _, err := apitypes.TypedDataAndHash(typedData)
+	return sighash, err
+}
+
+// aminoSignDoc is the amino JSON sign doc of a Cosmos transaction.
+type aminoSignDoc struct {
+	AccountNumber string            `json:"account_number"`
+	ChainID       string            `json:"chain_id"`
+	Fee           json.RawMessage   `json:"fee"`
+	Memo          string            `json:"memo"`
+	Msgs          []json.RawMessage `json:"msgs"`
+	Sequence      string            `json:"sequence"`
+	TimeoutHeight string            `json:"timeout_height"`
+	Tip           json.RawMessage   `json:"tip"`
+}
+
+// NewEIP712TypedData returns the EIP-712 typed data of a Cosmos transaction from its amino JSON
+// sign doc. The domain is tied to the given EVM chain ID.
+func NewEIP712TypedData(evmChainID *big.Int, aminoJSONSignDoc []byte) (apitypes.TypedData, error) {
+	if evmChainID == nil {
+		return apitypes.TypedData{}, errors.New("evm chain id is required for eip-712 typed data")
+	}
+
+	// Every field of the sign doc must be part of the typed data, otherwise it is not signed over.
+	var doc aminoSignDoc
+	decoder := json.NewDecoder(bytes.NewReader(aminoJSONSignDoc))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&doc); err != nil {
+		return apitypes.TypedData{}, errorslib.Wrap(err, "invalid amino json sign doc")
+	}
+	if len(doc.Tip) > 0 && !bytes.Equal(doc.Tip, []byte("null")) {
+		return apitypes.TypedData{}, errors.New("tips are not supported by eip-712 signing")
+	}
+
+	msgs := make([]interface{}, len(doc.Msgs))
+	for i, msg := range doc.Msgs {
+		msgs[i] = string(msg)
+	}
+
+	return apitypes.TypedData{
+		Types:       eip712Types,
+		PrimaryType: eip712PrimaryType,
+		Domain: apitypes.TypedDataDomain{
+			Name:    eip712DomainName,
+			Version: eip712DomainVersion,
+			ChainId: (*math.HexOrDecimal256)(evmChainID),
+		},
+		Message: apitypes.TypedDataMessage{
+			"account_number": doc.AccountNumber,
+			"chain_id":       doc.ChainID,
+			"fee":            string(doc.Fee),
+			"memo":           doc.Memo,
+			"msgs":           msgs,
+			"sequence":       doc.Sequence,
+			"timeout_height": doc.TimeoutHeight,
+		},
+	}, nil
+}
+
+// GetEIP712TypedData returns the EIP-712 typed data that must be signed by an Ethereum wallet
+// (i.e. with `eth_signTypedData_v4`) to sign the given transaction in `SIGN_MODE_EIP712`. The
+// resulting signature is set on the transaction with the EIP-712 sign mode.
+func GetEIP712TypedData(
+	ctx context.Context,
+	txConfig client.TxConfig,
+	evmChainID *big.Int,
+	signerData authsigning.SignerData,
+	tx sdk.Tx,
+) (apitypes.TypedData, error) {
+	signDoc, err := authsigning.GetSignBytesAdapter(
+		ctx, txConfig.SignModeHandler(), signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, signerData, tx,
+	)
+	if err != nil {
+		return apitypes.TypedData{}, err
+	}
+	return NewEIP712TypedData(evmChainID, signDoc)
+}
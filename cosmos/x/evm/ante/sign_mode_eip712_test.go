@@ -0,0 +1,204 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Blackchain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package ante_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+
+	txsigning "cosmossdk.io/x/tx/signing"
+	"cosmossdk.io/x/tx/signing/aminojson"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+
+	cryptocodec "pkg.berachain.dev/jinx/cosmos/crypto/codec"
+	"pkg.berachain.dev/jinx/cosmos/crypto/keys/ethsecp256k1"
+	testutil "pkg.berachain.dev/jinx/cosmos/testing/utils"
+	"pkg.berachain.dev/jinx/cosmos/x/evm/ante"
+	"pkg.berachain.dev/jinx/eth/params"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("EIP-712 Typed Data", func() {
+	signDoc := []byte(`{"account_number":"7","chain_id":"jinx-1","fee":{"amount":[{"amount":"10",` +
+		`"denom":"abera"}],"gas":"200000"},"memo":"hi","msgs":[{"type":"cosmos-sdk/MsgSend",` +
+		`"value":{"amount":[{"amount":"1","denom":"abera"}],"from_address":"a","to_address":"b"}}],` +
+		`"sequence":"3"}`)
+
+	It("should build the typed data from the amino json sign doc", func() {
+		typedData, err := ante.NewEIP712TypedData(big.NewInt(69420), signDoc)
+		Expect(err).ToNot(HaveOccurred())
+		Expect((*big.Int)(typedData.Domain.ChainId).Cmp(big.NewInt(69420))).To(BeZero())
+		Expect(typedData.Message["account_number"]).To(Equal("7"))
+		Expect(typedData.Message["chain_id"]).To(Equal("jinx-1"))
+		Expect(typedData.Message["fee"]).To(Equal(`{"amount":[{"amount":"10","denom":"abera"}],"gas":"200000"}`))
+		Expect(typedData.Message["msgs"]).To(HaveLen(1))
+		Expect(typedData.Message["sequence"]).To(Equal("3"))
+	})
+
+	It("should produce a sighash that is verified by an ethsecp256k1 key", func() {
+		typedData, err := ante.NewEIP712TypedData(big.NewInt(69420), signDoc)
+		Expect(err).ToNot(HaveOccurred())
+		sighash, _, err := apitypes.TypedDataAndHash(typedData)
+		Expect(err).ToNot(HaveOccurred())
+
+		privKey, err := ethsecp256k1.GenPrivKey()
+		Expect(err).ToNot(HaveOccurred())
+		sig, err := privKey.Sign(sighash)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(privKey.PubKey().VerifySignature(sighash, sig)).To(BeTrue())
+
+		// The domain is tied to the EVM chain ID.
+		otherTypedData, err := ante.NewEIP712TypedData(big.NewInt(1), signDoc)
+		Expect(err).ToNot(HaveOccurred())
+		otherSighash, _, err := apitypes.TypedDataAndHash(otherTypedData)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(privKey.PubKey().VerifySignature(otherSighash, sig)).To(BeFalse())
+	})
+
+	It("should reject sign docs that cannot be fully signed over", func() {
+		_, err := ante.NewEIP712TypedData(nil, signDoc)
+		Expect(err).To(HaveOccurred())
+
+		_, err = ante.NewEIP712TypedData(big.NewInt(1), []byte(`{"sequence":"3","unknown":"1"}`))
+		Expect(err).To(HaveOccurred())
+
+		_, err = ante.NewEIP712TypedData(big.NewInt(1), []byte(`{"sequence":"3","tip":{"tipper":"a"}}`))
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+var _ = Describe("EIP-712 Signed Transactions", func() {
+	var (
+		ctx         sdk.Context
+		ak          authkeeper.AccountKeeper
+		txConfig    client.TxConfig
+		anteHandler sdk.AnteHandler
+		privKey     *ethsecp256k1.PrivKey
+		addr        sdk.AccAddress
+	)
+
+	BeforeEach(func() {
+		var bk bankkeeper.BaseKeeper
+		ctx, ak, bk, _ = testutil.SetupMinimalKeepers()
+		ctx = ctx.WithChainID("jinx-1")
+
+		encCfg := testutil.GetEncodingConfig()
+		cryptocodec.RegisterInterfaces(encCfg.InterfaceRegistry)
+		var err error
+		txConfig, err = authtx.NewTxConfigWithOptions(encCfg.Codec, authtx.ConfigOptions{
+			EnabledSignModes: authtx.DefaultSignModes,
+			CustomSignModes: []txsigning.SignModeHandler{
+				ante.NewSignModeEIP712Handler(
+					aminojson.SignModeHandlerOptions{},
+					func(context.Context) (*big.Int, error) { return params.DefaultChainConfig.ChainID, nil },
+				),
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		anteHandler, err = ante.NewAnteHandler(ante.HandlerOptions{
+			HandlerOptions: authante.HandlerOptions{
+				AccountKeeper:   feeCollectorAccountKeeper{ak},
+				BankKeeper:      bk,
+				SignModeHandler: txConfig.SignModeHandler(),
+				SigGasConsumer:  ante.SigVerificationGasConsumer,
+			},
+			EVMKeeper: &mockEVMKeeper{chainConfig: params.DefaultChainConfig},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		privKey, err = ethsecp256k1.GenPrivKey()
+		Expect(err).ToNot(HaveOccurred())
+		addr = sdk.AccAddress(privKey.PubKey().Address())
+		ak.SetAccount(ctx, ak.NewAccountWithAddress(ctx, addr))
+	})
+
+	// signTx returns a bank send of the account signed in EIP-712 over the given EVM chain ID.
+	signTx := func(evmChainID *big.Int) sdk.Tx {
+		acc := ak.GetAccount(ctx, addr)
+		builder := txConfig.NewTxBuilder()
+		Expect(builder.SetMsgs(banktypes.NewMsgSend(addr, addr, sdk.NewCoins()))).To(Succeed())
+		builder.SetGasLimit(200000)
+
+		sig := signingtypes.SignatureV2{
+			PubKey: privKey.PubKey(),
+			Data: &signingtypes.SingleSignatureData{
+				SignMode: signingtypes.SignMode(ante.SignMode_SIGN_MODE_EIP712),
+			},
+			Sequence: acc.GetSequence(),
+		}
+		Expect(builder.SetSignatures(sig)).To(Succeed())
+
+		typedData, err := ante.GetEIP712TypedData(ctx, txConfig, evmChainID, authsigning.SignerData{
+			Address:       addr.String(),
+			ChainID:       ctx.ChainID(),
+			AccountNumber: acc.GetAccountNumber(),
+			Sequence:      acc.GetSequence(),
+			PubKey:        privKey.PubKey(),
+		}, builder.GetTx())
+		Expect(err).ToNot(HaveOccurred())
+		sighash, _, err := apitypes.TypedDataAndHash(typedData)
+		Expect(err).ToNot(HaveOccurred())
+		sig.Data.(*signingtypes.SingleSignatureData).Signature, err = privKey.Sign(sighash)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(builder.SetSignatures(sig)).To(Succeed())
+		return builder.GetTx()
+	}
+
+	It("should pass the full ante chain", func() {
+		_, err := anteHandler(ctx, signTx(params.DefaultChainConfig.ChainID), false)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ak.GetAccount(ctx, addr).GetSequence()).To(Equal(uint64(1)))
+		Expect(ak.GetAccount(ctx, addr).GetPubKey()).To(Equal(privKey.PubKey()))
+	})
+
+	It("should reject a signature over another EVM chain ID", func() {
+		_, err := anteHandler(ctx, signTx(big.NewInt(1)), false)
+		Expect(errors.Is(err, sdkerrors.ErrUnauthorized)).To(BeTrue())
+		Expect(ak.GetAccount(ctx, addr).GetSequence()).To(BeZero())
+	})
+})
+
+// feeCollectorAccountKeeper is an account keeper that knows the address of the fee collector,
+// which the minimal keepers do not set up.
+type feeCollectorAccountKeeper struct {
+	authkeeper.AccountKeeper
+}
+
+func (ak feeCollectorAccountKeeper) GetModuleAddress(name string) sdk.AccAddress {
+	return authtypes.NewModuleAddress(name)
+}
@@ -25,11 +25,14 @@ import (
 
 	storetypes "cosmossdk.io/store/types"
 
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	"pkg.berachain.dev/jinx/cosmos/crypto/keys/ethsecp256k1"
+	"pkg.berachain.dev/jinx/cosmos/x/evm/plugins/gas"
+	"pkg.berachain.dev/jinx/lib/errors"
 )
 
 const (
@@ -46,7 +49,12 @@ func SigVerificationGasConsumer(
 	// Then check to see if the pubkey is a secp256k1 pubkey
 	switch pubkey := sig.PubKey.(type) {
 	case *ethsecp256k1.PubKey:
-		meter.ConsumeGas(secp256k1GasCostEIP155, "ante verify: secp256k1")
+		// We return an error, instead of panicking, if the gas meter runs out of gas or overflows.
+		if err := gas.WrapMeter(meter).TryConsumeGas(
+			secp256k1GasCostEIP155, "ante verify: secp256k1",
+		); err != nil {
+			return errors.Wrap(sdkerrors.ErrOutOfGas, err.Error())
+		}
 		return nil
 	default:
 		// If we are using any other key type, we will use the default gas consumer.
@@ -0,0 +1,69 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Blackchain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package evm
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+
+	evmv1alpha1 "pkg.berachain.dev/jinx/cosmos/api/jinx/evm/v1alpha1"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: evmv1alpha1.QueryService_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod:      "Account",
+					Use:            "account [address]",
+					Short:          "Query the balance, nonce, and code hash of an Ethereum account",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod:      "Code",
+					Use:            "code [address]",
+					Short:          "Query the code of an Ethereum account",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod: "Storage",
+					Use:       "storage [address] [key]",
+					Short:     "Query the value of a storage slot of an Ethereum account",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "address"}, {ProtoField: "key"},
+					},
+				},
+				{
+					RpcMethod:      "Receipt",
+					Use:            "receipt [tx-hash]",
+					Short:          "Query the receipt of an Ethereum transaction",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "tx_hash"}},
+				},
+				{
+					RpcMethod: "Precompiles",
+					Use:       "precompiles",
+					Short:     "Query the stateful precompiles with the ABIs they serve",
+				},
+			},
+		},
+	}
+}
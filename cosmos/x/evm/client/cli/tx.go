@@ -0,0 +1,187 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Blackchain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cli
+
+import (
+	"math/big"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+
+	"pkg.berachain.dev/jinx/cosmos/x/evm/plugins/txpool"
+	"pkg.berachain.dev/jinx/cosmos/x/evm/types"
+	"pkg.berachain.dev/jinx/eth/common"
+	coretypes "pkg.berachain.dev/jinx/eth/core/types"
+	errorslib "pkg.berachain.dev/jinx/lib/errors"
+)
+
+const (
+	// FlagValue is the flag for the value, in the EVM denomination, sent with a call or deployment.
+	FlagValue = "value"
+	// FlagGasLimit is the flag for the EVM gas limit of a call or deployment.
+	FlagGasLimit = "gas-limit"
+)
+
+// GetTxCmd returns the root tx command for the evm module.
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Transactions commands for the evm module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		SendRawTxCmd(),
+		CallCmd(),
+		CreateCmd(),
+	)
+	return cmd
+}
+
+// SendRawTxCmd returns a command that broadcasts a signed Ethereum transaction.
+func SendRawTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send-raw [tx-hex]",
+		Short: "Broadcast a signed, RLP encoded Ethereum transaction",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			signedTx := new(coretypes.Transaction)
+			if err = signedTx.UnmarshalBinary(common.FromHex(args[0])); err != nil {
+				return errorslib.Wrap(err, "failed to decode transaction")
+			}
+
+			// Wrap the Ethereum transaction in a Cosmos transaction, like the txpool does for
+			// transactions sent over JSON-RPC.
+			txBytes, err := txpool.SerializeToBytes(clientCtx, signedTx)
+			if err != nil {
+				return errorslib.Wrap(err, "failed to serialize transaction")
+			}
+
+			res, err := clientCtx.BroadcastTx(txBytes)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CallCmd returns a command that calls an EVM contract from the Cosmos account of the sender.
+func CallCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "call [to] [data-hex]",
+		Short: "Call an EVM contract from a Cosmos account",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if !common.IsHexAddress(args[0]) {
+				return types.ErrInvalidRecipient
+			}
+			value, gasLimit, err := valueAndGasLimit(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgEthereumCall(
+				clientCtx.GetFromAddress(), common.HexToAddress(args[0]), common.FromHex(args[1]),
+				value, gasLimit,
+			)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addEVMFlags(cmd)
+	return cmd
+}
+
+// CreateCmd returns a command that deploys an EVM contract from the Cosmos account of the sender.
+func CreateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create [bytecode-hex]",
+		Short: "Deploy an EVM contract from a Cosmos account",
+		Long: "Deploy an EVM contract from a Cosmos account. The ABI encoded constructor " +
+			"arguments must be appended to the bytecode.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			value, gasLimit, err := valueAndGasLimit(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgEthereumCreate(
+				clientCtx.GetFromAddress(), common.FromHex(args[0]), value, gasLimit,
+			)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addEVMFlags(cmd)
+	return cmd
+}
+
+// addEVMFlags adds the flags of the EVM calls and deployments, along with the tx flags, to the
+// given command.
+func addEVMFlags(cmd *cobra.Command) {
+	cmd.Flags().String(FlagValue, "0", "The value, in the EVM denomination, to send")
+	cmd.Flags().Uint64(FlagGasLimit, 0, "The EVM gas limit")
+	_ = cmd.MarkFlagRequired(FlagGasLimit)
+	flags.AddTxFlagsToCmd(cmd)
+}
+
+// valueAndGasLimit returns the value and EVM gas limit set on the flags of the given command.
+func valueAndGasLimit(cmd *cobra.Command) (*big.Int, uint64, error) {
+	valueStr, err := cmd.Flags().GetString(FlagValue)
+	if err != nil {
+		return nil, 0, err
+	}
+	value, ok := new(big.Int).SetString(valueStr, 10) //nolint:gomnd // decimal.
+	if !ok {
+		return nil, 0, types.ErrInvalidValue
+	}
+
+	gasLimit, err := cmd.Flags().GetUint64(FlagGasLimit)
+	if err != nil {
+		return nil, 0, err
+	}
+	return value, gasLimit, nil
+}
@@ -21,6 +21,8 @@
 package evm
 
 import (
+	"sort"
+
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/depinject"
 	store "cosmossdk.io/store/types"
@@ -31,6 +33,7 @@ import (
 
 	modulev1alpha1 "pkg.berachain.dev/jinx/cosmos/api/jinx/evm/module/v1alpha1"
 	"pkg.berachain.dev/jinx/cosmos/x/evm/keeper"
+	"pkg.berachain.dev/jinx/cosmos/x/evm/types"
 	ethprecompile "pkg.berachain.dev/jinx/eth/core/precompile"
 )
 
@@ -38,6 +41,7 @@ import (
 func init() {
 	appmodule.Register(&modulev1alpha1.Module{},
 		appmodule.Provide(ProvideModule),
+		appmodule.Invoke(InvokeSetEvmHooks),
 	)
 }
 
@@ -51,6 +55,8 @@ type DepInjectInput struct {
 
 	Mempool           sdkmempool.Mempool
 	CustomPrecompiles func() *ethprecompile.Injector `optional:"true"`
+	// SyntheticEventTypes opts into synthetic receipts for the given Cosmos event types.
+	SyntheticEventTypes types.SyntheticEventTypes `optional:"true"`
 
 	AccountKeeper AccountKeeper
 	StakingKeeper StakingKeeper
@@ -86,7 +92,31 @@ func ProvideModule(in DepInjectInput) DepInjectOutput {
 		in.CustomPrecompiles,
 	)
 
+	if len(in.SyntheticEventTypes) > 0 {
+		k.EnableSyntheticReceipts(in.SyntheticEventTypes...)
+	}
+
 	m := NewAppModule(k, in.AccountKeeper)
 
 	return DepInjectOutput{Keeper: k, Module: m}
 }
+
+// InvokeSetEvmHooks sets the EVM hooks that are provided by other modules on the keeper. The hooks
+// are called in the alphabetical order of the names of the modules.
+func InvokeSetEvmHooks(k *keeper.Keeper, evmHooks map[string]types.EvmHooksWrapper) {
+	if k == nil || len(evmHooks) == 0 {
+		return
+	}
+
+	modNames := make([]string, 0, len(evmHooks))
+	for modName := range evmHooks {
+		modNames = append(modNames, modName)
+	}
+	sort.Strings(modNames)
+
+	var multiHooks types.MultiEvmHooks
+	for _, modName := range modNames {
+		multiHooks = append(multiHooks, evmHooks[modName])
+	}
+	k.SetHooks(multiHooks)
+}
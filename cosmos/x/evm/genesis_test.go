@@ -40,6 +40,7 @@ import (
 	evmmempool "pkg.berachain.dev/jinx/cosmos/x/evm/plugins/txpool/mempool"
 	"pkg.berachain.dev/jinx/eth/core"
 	ethprecompile "pkg.berachain.dev/jinx/eth/core/precompile"
+	"pkg.berachain.dev/jinx/eth/jinx"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -72,7 +73,7 @@ var _ = Describe("", func() {
 				return ethprecompile.NewPrecompiles([]ethprecompile.Registrable{sc}...)
 			},
 		)
-		k.Setup(storetypes.NewKVStoreKey("offchain-evm"), nil, "", GinkgoT().TempDir(), log.NewNopLogger())
+		k.Setup(storetypes.NewKVStoreKey("offchain-evm"), nil, jinx.DefaultConfig(), GinkgoT().TempDir(), log.NewNopLogger())
 
 		am = evm.NewAppModule(k, ak)
 	})
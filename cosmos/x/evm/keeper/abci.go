@@ -23,18 +23,79 @@ package keeper
 import (
 	"context"
 
+	abci "github.com/cometbft/cometbft/abci/types"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"pkg.berachain.dev/jinx/cosmos/x/evm/types"
+	"pkg.berachain.dev/jinx/eth/common"
 )
 
+// SetFinalizeBlockRequest hands the request of the block being finalized to the keeper. It must
+// be called before the begin blockers run, for the Ethereum transactions of the block to be
+// executed in parallel and for the Jinx Ethereum block to be derived from the block hash and the
+// decided last commit, which CometBFT only sends in the request. The extended last commit at the
+// start of the block, if any, is split from the transactions, see SplitExtendedCommit.
+func (k *Keeper) SetFinalizeBlockRequest(req *abci.RequestFinalizeBlock) {
+	k.blockTxs, k.extCommit = SplitExtendedCommit(req.Txs)
+	k.blockHash, k.lastCommit = req.Hash, req.DecidedLastCommit
+}
+
 func (k *Keeper) BeginBlocker(ctx context.Context) error {
 	sCtx := sdk.UnwrapSDKContext(ctx)
+	// Record the block attested by a quorum of the vote extensions of the last commit as safe.
+	k.trackSafeBlock(sCtx)
+	parentHash := k.parentBlockHash(sCtx)
 	// Prepare the Jinx Ethereum block.
-	k.lock = false
-	k.jinx.Prepare(ctx, uint64(sCtx.BlockHeight()))
+	k.prepare(sCtx)
+	// Store the hash of the previous CometBFT block as the beacon root of the block (EIP-4788).
+	if err := k.jinx.ProcessBeaconRoot(ctx, parentHash); err != nil {
+		return err
+	}
+	// Attach the events emitted by the begin blockers that ran before.
+	k.processSyntheticEvents(ctx, beginBlockSource, sCtx.EventManager().Events())
+	// Execute the Ethereum transactions at the start of the block in parallel, if enabled.
+	k.executeBlockTransactions(sCtx)
 	return nil
 }
 
 func (k *Keeper) EndBlock(ctx context.Context) error {
+	// Attach the events emitted by the end blockers that ran before.
+	k.processSyntheticEvents(
+		ctx, endBlockSource, sdk.UnwrapSDKContext(ctx).EventManager().Events(),
+	)
 	// Finalize the Jinx Ethereum block.
+	k.prepared, k.executed = false, nil
+	k.blockHash, k.lastCommit = nil, abci.CommitInfo{}
 	return k.jinx.Finalize(ctx)
 }
+
+// parentBlockHash returns the hash of the previous CometBFT block and records the hash of the block
+// being finalized for the next block. The header of the blocks delivered by FinalizeBlock does not
+// carry the ID of the previous block, so the hashes are taken from the requests of FinalizeBlock,
+// see SetFinalizeBlockRequest. The hash of the block before the first one finalized with a request
+// is unknown, and the zero hash is returned instead.
+func (k *Keeper) parentBlockHash(ctx sdk.Context) common.Hash {
+	store := ctx.KVStore(k.storeKey)
+	parentHash := common.BytesToHash(store.Get([]byte{types.CometBlockHashKey}))
+	if k.blockHash != nil {
+		store.Set([]byte{types.CometBlockHashKey}, k.blockHash)
+	}
+	return parentHash
+}
+
+// prepare prepares the Jinx Ethereum block, unless it has already been prepared by a system call
+// from a block hook that ran before the begin blocker of the EVM module.
+func (k *Keeper) prepare(ctx sdk.Context) {
+	if k.prepared {
+		return
+	}
+	k.lock = false
+	// The block hash and the decided last commit of the request are set on the context of the
+	// block, which the block plugin derives the randomness of the block from.
+	if k.blockHash != nil {
+		ctx = ctx.WithHeaderHash(k.blockHash).WithVoteInfos(k.lastCommit.Votes)
+	}
+	k.jinx.Prepare(ctx, uint64(ctx.BlockHeight()))
+	k.prepared = true
+}
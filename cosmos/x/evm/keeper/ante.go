@@ -0,0 +1,71 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Blackchain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ethereum/go-ethereum/consensus/misc"
+
+	"pkg.berachain.dev/jinx/cosmos/x/evm/plugins/configuration"
+	"pkg.berachain.dev/jinx/cosmos/x/evm/types"
+	coretypes "pkg.berachain.dev/jinx/eth/core/types"
+	"pkg.berachain.dev/jinx/eth/params"
+)
+
+// GetChainConfig returns the chain config that is active at the block of the given context. It
+// reads the store directly, so it is safe to use from the ante handler during CheckTx. It returns
+// an error if the stored chain config is corrupt.
+func (k *Keeper) GetChainConfig(ctx sdk.Context) (*params.ChainConfig, error) {
+	return configuration.ChainConfigAtHeight(ctx.KVStore(k.storeKey), uint64(ctx.BlockHeight()))
+}
+
+// SetChainConfig sets the chain config of the EVM, e.g. in an upgrade handler that schedules a
+// fork. The new chain config becomes active at the next block, while past blocks keep being
+// re-executed under the chain config that was active at their height.
+func (k *Keeper) SetChainConfig(ctx sdk.Context, chainConfig *params.ChainConfig) {
+	configuration.SetChainConfig(ctx.KVStore(k.storeKey), ctx.BlockHeight(), chainConfig)
+}
+
+// GetBaseFee returns the base fee that the Ethereum transactions of the block being built on top
+// of the given context must pay. It returns nil if there is no block header to derive it from.
+func (k *Keeper) GetBaseFee(ctx sdk.Context) *big.Int {
+	chainConfig, err := k.GetChainConfig(ctx)
+	if err != nil || chainConfig == nil {
+		return nil
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	headerBz := store.Get([]byte{types.HeaderKey})
+	if headerBz == nil {
+		headerBz = store.Get([]byte{types.GenesisHeaderKey})
+	}
+	if headerBz == nil {
+		return nil
+	}
+	parent, err := coretypes.UnmarshalHeader(headerBz)
+	if err != nil {
+		return nil
+	}
+	return misc.CalcBaseFee(chainConfig, parent)
+}
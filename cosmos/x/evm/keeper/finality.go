@@ -0,0 +1,284 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Blackchain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper
+
+import (
+	"bytes"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"pkg.berachain.dev/jinx/cosmos/x/evm/types"
+)
+
+// The Jinx EVM maps the block tags of the Ethereum JSON-RPC to the finality of CometBFT, which is
+// instant once a block is committed:
+//
+//   - "latest" is the last block executed by this node, which may not be committed yet.
+//   - "finalized" is the last block committed by this node.
+//   - "safe" is the last EVM block that more than 2/3 of the voting power attested to in the vote
+//     extensions of a commit. Every validator extends its precommits with the hash of the last EVM
+//     block it has executed, see ExtendVoteHandler. CometBFT asks for the extension of a height
+//     before the block of the height is finalized, so the precommits of height H attest to the
+//     EVM block H-1. The extended commit of height H is put at the start of block H+1 by its
+//     proposer and verified by the validators in ProcessProposal, and the attested block is
+//     recorded as safe in the state of block H+1. The safe block is thus two blocks behind the
+//     finalized block, which is the earliest a quorum of the validators can attest to it.
+//
+// Vote extensions are enabled by the ABCI consensus param VoteExtensionsEnableHeight. Until then
+// the commits carry no extensions, and the last committed height is served as the safe block.
+
+// extendedCommitPrefix prefixes the extended last commit put at the start of the proposals, which
+// tells it apart from the transactions of the block, as it is not a valid transaction encoding.
+var extendedCommitPrefix = []byte("jinx/extended_commit/")
+
+// SplitExtendedCommit returns the transactions of a block without the extended last commit put at
+// its start by PrepareProposalHandler, and the encoded commit, nil if the block has none. The
+// commit must not be delivered to the app as a transaction.
+func SplitExtendedCommit(txs [][]byte) ([][]byte, []byte) {
+	if len(txs) == 0 || !bytes.HasPrefix(txs[0], extendedCommitPrefix) {
+		return txs, nil
+	}
+	return txs[1:], txs[0][len(extendedCommitPrefix):]
+}
+
+// FinalizedBlockNumber returns the last height committed by this node.
+//
+// FinalizedBlockNumber implements core.FinalityHost.
+func (h *host) FinalizedBlockNumber() (uint64, bool) {
+	if h.qc == nil {
+		return 0, false
+	}
+	// The latest query context is at the last committed height, it errors before the first block
+	// is committed.
+	ctx, err := h.qc(0, false)
+	if err != nil || ctx.BlockHeight() <= 0 {
+		return 0, false
+	}
+	return uint64(ctx.BlockHeight()), true
+}
+
+// SafeBlockNumber returns the last EVM block attested by a quorum of vote extensions, as recorded
+// in the state of the last committed height.
+//
+// SafeBlockNumber implements core.FinalityHost.
+func (h *host) SafeBlockNumber() (uint64, bool) {
+	if h.qc == nil {
+		return 0, false
+	}
+	ctx, err := h.qc(0, false)
+	if err != nil {
+		return 0, false
+	}
+	bz := ctx.KVStore(h.storeKey).Get([]byte{types.SafeBlockNumberKey})
+	if bz == nil {
+		return 0, false
+	}
+	return sdk.BigEndianToUint64(bz), true
+}
+
+// ExtendVoteHandler returns the handler that extends the precommits of this validator with the
+// hash of the last EVM block it has executed. If the block cannot be read, the extension is left
+// empty, which the other validators accept without counting it as an attestation.
+func (k *Keeper) ExtendVoteHandler() sdk.ExtendVoteHandler {
+	return func(ctx sdk.Context, req *abci.RequestExtendVote) (*abci.ResponseExtendVote, error) {
+		ext, err := k.voteExtension(req.Height)
+		if err != nil {
+			k.Logger(ctx).Error("failed to extend vote", "height", req.Height, "err", err)
+			return &abci.ResponseExtendVote{}, nil
+		}
+		return &abci.ResponseExtendVote{VoteExtension: ext}, nil
+	}
+}
+
+// VerifyVoteExtensionHandler returns the handler that verifies the vote extensions of the other
+// validators. Like in ExtendVoteHandler, an empty extension is accepted, any other extension is
+// rejected unless it is the hash of the EVM block executed by this node before the height.
+func (k *Keeper) VerifyVoteExtensionHandler() sdk.VerifyVoteExtensionHandler {
+	return func(
+		_ sdk.Context, req *abci.RequestVerifyVoteExtension,
+	) (*abci.ResponseVerifyVoteExtension, error) {
+		if len(req.VoteExtension) == 0 {
+			return &abci.ResponseVerifyVoteExtension{
+				Status: abci.ResponseVerifyVoteExtension_ACCEPT,
+			}, nil
+		}
+		ext, err := k.voteExtension(req.Height)
+		if err != nil {
+			return nil, err
+		}
+		if !bytes.Equal(req.VoteExtension, ext) {
+			return &abci.ResponseVerifyVoteExtension{
+				Status: abci.ResponseVerifyVoteExtension_REJECT,
+			}, nil
+		}
+		return &abci.ResponseVerifyVoteExtension{
+			Status: abci.ResponseVerifyVoteExtension_ACCEPT,
+		}, nil
+	}
+}
+
+// voteExtension returns the extension of the precommits at the given height: the hash of the EVM
+// block before the height, which is the last one executed when the block of the height is voted.
+func (k *Keeper) voteExtension(height int64) ([]byte, error) {
+	header, err := k.host.GetBlockPlugin().GetHeaderByNumber(uint64(height - 1))
+	if err != nil {
+		return nil, err
+	}
+	return header.Hash().Bytes(), nil
+}
+
+// PrepareProposalHandler returns the handler that puts the extended last commit of this node at
+// the start of the proposals, once vote extensions are enabled, followed by the transactions
+// selected by the given handler.
+func (k *Keeper) PrepareProposalHandler(
+	next sdk.PrepareProposalHandler,
+) sdk.PrepareProposalHandler {
+	return func(
+		ctx sdk.Context, req *abci.RequestPrepareProposal,
+	) (*abci.ResponsePrepareProposal, error) {
+		if !voteExtensionsEnabled(ctx, req.Height-1) {
+			return next(ctx, req)
+		}
+
+		bz, err := req.LocalLastCommit.Marshal()
+		if err != nil {
+			return nil, err
+		}
+		extCommitBz := append(append([]byte{}, extendedCommitPrefix...), bz...)
+		// the extended commit counts towards the size of the transactions of the proposal.
+		nextReq := *req
+		nextReq.MaxTxBytes -= int64(len(extCommitBz))
+		resp, err := next(ctx, &nextReq)
+		if err != nil {
+			return nil, err
+		}
+		resp.Txs = append([][]byte{extCommitBz}, resp.Txs...)
+		return resp, nil
+	}
+}
+
+// ProcessProposalHandler returns the handler that rejects the proposals that do not start with a
+// valid extended last commit once vote extensions are enabled, as validated by
+// `baseapp.ValidateVoteExtensions` with the signing keys of the given validator store, and the
+// proposals that start with one before. The other transactions of the proposal are processed by
+// the given handler.
+func (k *Keeper) ProcessProposalHandler(
+	valStore baseapp.ValidatorStore, next sdk.ProcessProposalHandler,
+) sdk.ProcessProposalHandler {
+	return func(
+		ctx sdk.Context, req *abci.RequestProcessProposal,
+	) (*abci.ResponseProcessProposal, error) {
+		reject := &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}
+		txs, extCommitBz := SplitExtendedCommit(req.Txs)
+		if !voteExtensionsEnabled(ctx, req.Height-1) {
+			if extCommitBz != nil {
+				return reject, nil
+			}
+			return next(ctx, req)
+		}
+
+		if extCommitBz == nil {
+			return reject, nil
+		}
+		var extCommit abci.ExtendedCommitInfo
+		if err := extCommit.Unmarshal(extCommitBz); err != nil {
+			return reject, nil //nolint:nilerr // the proposal is invalid.
+		}
+		if err := baseapp.ValidateVoteExtensions(
+			ctx, valStore, req.Height, ctx.ChainID(), extCommit,
+		); err != nil {
+			k.Logger(ctx).Info("rejecting proposal with invalid vote extensions", "err", err)
+			return reject, nil
+		}
+
+		nextReq := *req
+		nextReq.Txs = txs
+		return next(ctx, &nextReq)
+	}
+}
+
+// trackSafeBlock records the EVM block attested by the vote extensions of the last commit as
+// safe, if more than 2/3 of the voting power attested to it. The extended last commit is the one
+// put at the start of the block, which has been verified by ProcessProposal and split from the
+// transactions of the block by SetFinalizeBlockRequest. Without vote extensions, the block being
+// finalized is recorded as safe, which it is once committed.
+func (k *Keeper) trackSafeBlock(ctx sdk.Context) {
+	extCommitBz := k.extCommit
+	k.extCommit = nil
+	height := ctx.BlockHeight()
+	if !voteExtensionsEnabled(ctx, height-1) {
+		k.setSafeBlockNumber(ctx, uint64(height))
+		return
+	}
+	if extCommitBz == nil {
+		return
+	}
+
+	var extCommit abci.ExtendedCommitInfo
+	if err := extCommit.Unmarshal(extCommitBz); err != nil {
+		return
+	}
+	// the extensions of the last commit attest to the EVM block before the last height.
+	ext, err := k.voteExtension(height - 1)
+	if err != nil {
+		k.Logger(ctx).Error("failed to get the attested block", "err", err)
+		return
+	}
+	if hasQuorum(extCommit, ext) {
+		k.setSafeBlockNumber(ctx, uint64(height-2))
+	}
+}
+
+// setSafeBlockNumber records the given EVM block as the last safe block in the state, so that it
+// is served from the last committed height and kept across restarts. It is skipped if a later
+// block is already safe, as when vote extensions get enabled.
+func (k *Keeper) setSafeBlockNumber(ctx sdk.Context, number uint64) {
+	store := ctx.KVStore(k.storeKey)
+	if bz := store.Get([]byte{types.SafeBlockNumberKey}); bz != nil &&
+		sdk.BigEndianToUint64(bz) >= number {
+		return
+	}
+	store.Set([]byte{types.SafeBlockNumberKey}, sdk.Uint64ToBigEndian(number))
+}
+
+// hasQuorum returns whether more than 2/3 of the voting power of the given commit extended its
+// precommits with the given extension.
+func hasQuorum(extCommit abci.ExtendedCommitInfo, ext []byte) bool {
+	var total, attested int64
+	for _, vote := range extCommit.Votes {
+		total += vote.Validator.Power
+		if vote.BlockIdFlag == cmtproto.BlockIDFlagCommit && bytes.Equal(vote.VoteExtension, ext) {
+			attested += vote.Validator.Power
+		}
+	}
+	return total > 0 && 3*attested > 2*total
+}
+
+// voteExtensionsEnabled returns whether the precommits of the given height carry vote extensions.
+func voteExtensionsEnabled(ctx sdk.Context, height int64) bool {
+	cp := ctx.ConsensusParams()
+	return cp.Abci != nil && cp.Abci.VoteExtensionsEnableHeight > 0 &&
+		height >= cp.Abci.VoteExtensionsEnableHeight
+}
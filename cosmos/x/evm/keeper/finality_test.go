@@ -0,0 +1,69 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Blackchain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper
+
+import (
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"pkg.berachain.dev/jinx/eth/common"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Vote extension quorum", func() {
+	hash := common.Hash{0x1}.Bytes()
+
+	// extCommit returns an extended commit of the votes with the given powers, extensions and
+	// block ID flags.
+	extCommit := func(
+		powers []int64, exts [][]byte, flags []cmtproto.BlockIDFlag,
+	) abci.ExtendedCommitInfo {
+		info := abci.ExtendedCommitInfo{}
+		for i, power := range powers {
+			info.Votes = append(info.Votes, abci.ExtendedVoteInfo{
+				Validator:     abci.Validator{Address: []byte{byte(i)}, Power: power},
+				VoteExtension: exts[i],
+				BlockIdFlag:   flags[i],
+			})
+		}
+		return info
+	}
+	commit := cmtproto.BlockIDFlagCommit
+
+	It("should require more than 2/3 of the voting power to attest", func() {
+		Expect(hasQuorum(extCommit(
+			[]int64{3, 1}, [][]byte{hash, nil}, []cmtproto.BlockIDFlag{commit, commit},
+		), hash)).To(BeTrue())
+		Expect(hasQuorum(extCommit(
+			[]int64{2, 1}, [][]byte{hash, common.Hash{0x2}.Bytes()}, []cmtproto.BlockIDFlag{commit, commit},
+		), hash)).To(BeFalse())
+		Expect(hasQuorum(abci.ExtendedCommitInfo{}, hash)).To(BeFalse())
+	})
+
+	It("should only count the extensions of precommits for the block", func() {
+		Expect(hasQuorum(extCommit(
+			[]int64{3, 1}, [][]byte{hash, hash},
+			[]cmtproto.BlockIDFlag{cmtproto.BlockIDFlagNil, commit},
+		), hash)).To(BeFalse())
+	})
+})
@@ -0,0 +1,174 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Blackchain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	cosmlib "pkg.berachain.dev/jinx/cosmos/lib"
+	"pkg.berachain.dev/jinx/cosmos/x/evm/plugins/precompile"
+	"pkg.berachain.dev/jinx/cosmos/x/evm/plugins/state"
+	"pkg.berachain.dev/jinx/cosmos/x/evm/types"
+	"pkg.berachain.dev/jinx/eth/common"
+	"pkg.berachain.dev/jinx/eth/core"
+	ethprecompile "pkg.berachain.dev/jinx/eth/core/precompile"
+	coretypes "pkg.berachain.dev/jinx/eth/core/types"
+	errorslib "pkg.berachain.dev/jinx/lib/errors"
+)
+
+// Compile-time interface assertion.
+var _ types.QueryServiceServer = (*Keeper)(nil)
+
+// Account queries the balance, nonce, and code hash of an Ethereum account.
+func (k *Keeper) Account(
+	ctx context.Context, req *types.AccountRequest,
+) (*types.AccountResponse, error) {
+	addr, err := cosmlib.EthAddressFromString(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	sp := k.queryStatePlugin(ctx)
+	return &types.AccountResponse{
+		Balance:  sp.GetBalance(addr).String(),
+		Nonce:    sp.GetNonce(addr),
+		CodeHash: sp.GetCodeHash(addr).Hex(),
+	}, nil
+}
+
+// Code queries the code of an Ethereum account.
+func (k *Keeper) Code(
+	ctx context.Context, req *types.CodeRequest,
+) (*types.CodeResponse, error) {
+	addr, err := cosmlib.EthAddressFromString(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.CodeResponse{Code: k.queryStatePlugin(ctx).GetCode(addr)}, nil
+}
+
+// Storage queries the value of a storage slot of an Ethereum account.
+func (k *Keeper) Storage(
+	ctx context.Context, req *types.StorageRequest,
+) (*types.StorageResponse, error) {
+	addr, err := cosmlib.EthAddressFromString(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.StorageResponse{
+		Value: k.queryStatePlugin(ctx).GetState(addr, common.HexToHash(req.Key)).Hex(),
+	}, nil
+}
+
+// Receipt queries the receipt of an Ethereum transaction from the historical data of the node.
+func (k *Keeper) Receipt(
+	_ context.Context, req *types.ReceiptRequest,
+) (*types.ReceiptResponse, error) {
+	txHash := common.HexToHash(req.TxHash)
+	hp := k.host.GetHistoricalPlugin()
+	tle, err := hp.GetTransactionByHash(txHash)
+	if err != nil {
+		return nil, err
+	}
+	receipts, err := hp.GetReceiptsByHash(tle.BlockHash)
+	if err != nil {
+		return nil, err
+	}
+	if tle.TxIndex >= uint64(len(receipts)) {
+		return nil, errorslib.Wrapf(core.ErrTxNotFound, "no receipt for tx %s", txHash.Hex())
+	}
+
+	return receiptResponse(receipts[tle.TxIndex]), nil
+}
+
+// Precompiles queries the stateful precompiles that are active at the queried block, with the
+// ABIs they serve.
+func (k *Keeper) Precompiles(
+	ctx context.Context, _ *types.PrecompilesRequest,
+) (*types.PrecompilesResponse, error) {
+	rules, err := chainRules(sdk.UnwrapSDKContext(ctx), k.storeKey)
+	if err != nil {
+		return nil, err
+	}
+	infos, err := k.host.GetPrecompilePlugin().(precompile.Plugin).GetPrecompileInfos(rules)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &types.PrecompilesResponse{Precompiles: make([]*types.Precompile, len(infos))}
+	for i, info := range infos {
+		resp.Precompiles[i] = &types.Precompile{
+			Address:         info.Address.Hex(),
+			Name:            info.Name,
+			Abi:             string(info.ABI),
+			MethodSelectors: signaturesResponse(info.MethodSelectors),
+			EventTopics:     signaturesResponse(info.EventTopics),
+		}
+	}
+	return resp, nil
+}
+
+// queryStatePlugin returns a new state plugin that reads the state of the given query context,
+// so that queries do not use the state plugin of the block being processed.
+func (k *Keeper) queryStatePlugin(ctx context.Context) state.Plugin {
+	sp := state.NewPlugin(k.ak, k.storeKey, k.host.GetPrecompileLogFactory())
+	sp.Reset(sdk.UnwrapSDKContext(ctx))
+	return sp
+}
+
+// receiptResponse converts an Ethereum receipt to the response of the Query/Receipt RPC method.
+func receiptResponse(receipt *coretypes.Receipt) *types.ReceiptResponse {
+	resp := &types.ReceiptResponse{
+		TxHash:            receipt.TxHash.Hex(),
+		BlockHash:         receipt.BlockHash.Hex(),
+		BlockNumber:       receipt.BlockNumber.Uint64(),
+		TransactionIndex:  uint64(receipt.TransactionIndex),
+		Status:            receipt.Status,
+		GasUsed:           receipt.GasUsed,
+		CumulativeGasUsed: receipt.CumulativeGasUsed,
+		Logs:              make([]*types.Log, len(receipt.Logs)),
+	}
+	if (receipt.ContractAddress != common.Address{}) {
+		resp.ContractAddress = receipt.ContractAddress.Hex()
+	}
+	for i, log := range receipt.Logs {
+		topics := make([]string, len(log.Topics))
+		for j, topic := range log.Topics {
+			topics[j] = topic.Hex()
+		}
+		resp.Logs[i] = &types.Log{Address: log.Address.Hex(), Topics: topics, Data: log.Data}
+	}
+	return resp
+}
+
+// signaturesResponse converts the signatures of a precompile to the ones of the response of the
+// Query/Precompiles RPC method.
+func signaturesResponse(sigs []ethprecompile.Signature) []*types.Signature {
+	resp := make([]*types.Signature, len(sigs))
+	for i, sig := range sigs {
+		resp[i] = &types.Signature{Signature: sig.Signature, Id: sig.ID.String()}
+	}
+	return resp
+}
@@ -21,10 +21,15 @@
 package keeper
 
 import (
+	"context"
+
 	storetypes "cosmossdk.io/store/types"
 
+	dbm "github.com/cosmos/cosmos-db"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	"pkg.berachain.dev/jinx/cosmos/x/evm/plugins"
 	"pkg.berachain.dev/jinx/cosmos/x/evm/plugins/block"
@@ -34,24 +39,37 @@ import (
 	"pkg.berachain.dev/jinx/cosmos/x/evm/plugins/precompile"
 	"pkg.berachain.dev/jinx/cosmos/x/evm/plugins/precompile/log"
 	"pkg.berachain.dev/jinx/cosmos/x/evm/plugins/state"
+	"pkg.berachain.dev/jinx/cosmos/x/evm/plugins/state/events"
 	"pkg.berachain.dev/jinx/cosmos/x/evm/plugins/txpool"
 	"pkg.berachain.dev/jinx/cosmos/x/evm/plugins/txpool/mempool"
+	"pkg.berachain.dev/jinx/cosmos/x/evm/store/snapmulti"
+	"pkg.berachain.dev/jinx/eth/common"
 	"pkg.berachain.dev/jinx/eth/core"
 	ethprecompile "pkg.berachain.dev/jinx/eth/core/precompile"
+	jinxapi "pkg.berachain.dev/jinx/eth/jinx/api"
+	"pkg.berachain.dev/jinx/lib/blockstm"
 	"pkg.berachain.dev/jinx/lib/utils"
 )
 
-// Compile-time interface assertion.
-var _ core.JinxHostChain = (*host)(nil)
+// Compile-time interface assertions.
+var (
+	_ core.JinxHostChain  = (*host)(nil)
+	_ core.ParallelHost   = (*host)(nil)
+	_ core.FinalityHost   = (*host)(nil)
+	_ jinxapi.JinxBackend = (*host)(nil)
+)
 
 // Host is the interface that must be implemented by the host.
 // It includes core.JinxHostChain and functions that are called in other packages.
 type Host interface {
 	core.JinxHostChain
 	GetAllPlugins() []plugins.Base
+	GetPrecompileLogFactory() events.PrecompileLogFactory
+	SetERC20Keeper(ERC20Keeper)
 	Setup(
 		storetypes.StoreKey,
-		storetypes.StoreKey,
+		dbm.DB,
+		uint64,
 		state.AccountKeeper,
 		func(height int64, prove bool) (sdk.Context, error),
 	)
@@ -68,6 +86,19 @@ type host struct {
 	txp txpool.Plugin
 
 	pcs func() *ethprecompile.Injector
+	// plf builds Ethereum logs from the Cosmos events registered by the precompiles.
+	plf *log.Factory
+
+	// The store key and account keeper of the state plugin, and the versioned stores, used to
+	// build the state of the workers that execute transactions in parallel.
+	storeKey storetypes.StoreKey
+	ak       state.AccountKeeper
+	vs       *snapmulti.VersionedStores
+
+	// ek and qc are used to query the ERC20 token <> SDK coin pairs in the jinx JSON-RPC
+	// namespace, and qc to query the finality of the blocks.
+	ek ERC20Keeper
+	qc func(height int64, prove bool) (sdk.Context, error)
 }
 
 // Newhost creates new instances of the plugin host.
@@ -86,24 +117,31 @@ func NewHost(
 	h.gp = gas.NewPlugin()
 	h.txp = txpool.NewPlugin(utils.MustGetAs[*mempool.EthTxPool](ethTxMempool))
 	h.pcs = precompiles
+	h.storeKey = storeKey
+	h.vs = snapmulti.NewVersionedStores()
 
 	return h
 }
 
 // Setup sets up the precompile and state plugins with the given precompiles and keepers. It also
-// sets the query context function for the block and state plugins (to support historical queries).
+// sets the query context function for the block and state plugins (to support historical queries)
+// and the database and retention of the historical plugin.
 func (h *host) Setup(
 	storeKey storetypes.StoreKey,
-	_ storetypes.StoreKey,
+	historicalDB dbm.DB,
+	historyRetention uint64,
 	ak state.AccountKeeper,
 	qc func(height int64, prove bool) (sdk.Context, error),
 ) {
 	// Setup the state, precompile, historical, and txpool plugins
-	h.sp = state.NewPlugin(ak, storeKey, log.NewFactory(h.pcs().GetPrecompiles()))
+	h.plf = log.NewFactory(h.pcs().GetPrecompiles())
+	h.sp = state.NewPlugin(ak, storeKey, h.plf)
+	h.ak = ak
+	h.qc = qc
 	h.pp = precompile.NewPlugin(h.pcs().GetPrecompiles(), h.sp)
-	// TODO: re-enable historical plugin using ABCI listener.
-	h.hp = historical.NewPlugin(h.cp, h.bp, nil, storeKey)
+	h.hp = historical.NewPlugin(h.cp, h.bp, historicalDB, historyRetention)
 	h.txp.SetNonceRetriever(h.sp)
+	h.txp.SetLocalsNonceRetriever(&queryNonceRetriever{ak: ak, qc: qc})
 
 	// Set the query context function for the block and state plugins
 	h.sp.SetQueryContextFn(qc)
@@ -144,7 +182,90 @@ func (h *host) GetTxPoolPlugin() core.TxPoolPlugin {
 	return h.txp
 }
 
+// GetPrecompileLogFactory returns the factory that builds Ethereum logs from the Cosmos events
+// registered by the precompiles.
+func (h *host) GetPrecompileLogFactory() events.PrecompileLogFactory {
+	return h.plf
+}
+
+// NewWorker returns new state and precompile plugins for a worker that executes transactions in
+// parallel, with the gas configs of the precompile plugin.
+//
+// NewWorker implements core.ParallelHost.
+func (h *host) NewWorker() (core.StatePlugin, core.PrecompilePlugin) {
+	sp := state.NewPlugin(h.ak, h.storeKey, h.plf)
+	pp := precompile.NewPlugin(h.pp.GetPrecompiles(nil), sp)
+	pp.SetKVGasConfig(h.pp.KVGasConfig())
+	pp.SetTransientKVGasConfig(h.pp.TransientKVGasConfig())
+	return sp, pp
+}
+
+// ViewContext returns the context with a versioned view of its multistore, a new event manager and
+// an infinite gas meter, as the gas of the EVM is accounted for by the state transition. The
+// Cosmos events emitted by the transactions are emitted to the block by `EmitEvents`.
+//
+// ViewContext implements core.ParallelHost.
+func (h *host) ViewContext(ctx context.Context, view *blockstm.View) context.Context {
+	sCtx := sdk.UnwrapSDKContext(ctx)
+	return sCtx.
+		WithMultiStore(h.vs.NewStoreFrom(sCtx.MultiStore(), view)).
+		WithEventManager(sdk.NewEventManager()).
+		WithGasMeter(storetypes.NewInfiniteGasMeter())
+}
+
+// Commit writes the final state of the transactions executed in parallel to the multistore of the
+// context.
+//
+// Commit implements core.ParallelHost.
+func (h *host) Commit(ctx context.Context, writes []blockstm.KVPair) {
+	h.vs.Write(sdk.UnwrapSDKContext(ctx).MultiStore(), writes)
+}
+
+// EmitEvents emits the Cosmos events of a transaction executed in parallel to the event manager of
+// the context of the block.
+//
+// EmitEvents implements core.ParallelHost.
+func (h *host) EmitEvents(ctx, txCtx context.Context) {
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvents(
+		sdk.UnwrapSDKContext(txCtx).EventManager().Events(),
+	)
+}
+
+// BalanceKeys returns the prefix of the keys of the bank store that hold the balances of the given
+// account, in the multi-version memory of the views of `ViewContext`.
+//
+// BalanceKeys implements core.ParallelHost.
+func (h *host) BalanceKeys(addr common.Address) []string {
+	return []string{
+		snapmulti.VersionedKey(banktypes.StoreKey, banktypes.CreateAccountBalancesPrefix(addr[:])),
+	}
+}
+
 // GetAllPlugins returns all the plugins.
 func (h *host) GetAllPlugins() []plugins.Base {
 	return []plugins.Base{h.bp, h.cp, h.gp, h.hp, h.pp, h.sp, h.txp}
 }
+
+// queryNonceRetriever retrieves nonces from the last committed state, through the query context,
+// so that it can be used concurrently with block execution.
+type queryNonceRetriever struct {
+	ak state.AccountKeeper
+	qc func(height int64, prove bool) (sdk.Context, error)
+}
+
+// GetNonce returns the nonce of the given address in the last committed state, or 0 if the state
+// can not be queried yet.
+func (nr *queryNonceRetriever) GetNonce(addr common.Address) uint64 {
+	if nr.qc == nil {
+		return 0
+	}
+	ctx, err := nr.qc(0, false)
+	if err != nil {
+		return 0
+	}
+	acc := nr.ak.GetAccount(ctx, addr[:])
+	if acc == nil {
+		return 0
+	}
+	return acc.GetSequence()
+}
@@ -0,0 +1,202 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Blackchain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	cosmlib "pkg.berachain.dev/jinx/cosmos/lib"
+	"pkg.berachain.dev/jinx/cosmos/x/evm/plugins/configuration"
+	"pkg.berachain.dev/jinx/eth/common"
+	"pkg.berachain.dev/jinx/eth/common/hexutil"
+	"pkg.berachain.dev/jinx/eth/core"
+	ethprecompile "pkg.berachain.dev/jinx/eth/core/precompile"
+	jinxapi "pkg.berachain.dev/jinx/eth/jinx/api"
+	"pkg.berachain.dev/jinx/eth/params"
+	errorslib "pkg.berachain.dev/jinx/lib/errors"
+)
+
+// ErrNoERC20Keeper is returned when the ERC20 token <> SDK coin pairs are queried, but no ERC20
+// keeper is set.
+var ErrNoERC20Keeper = errors.New("no ERC20 keeper set in host chain")
+
+// ERC20Keeper defines the expected keeper of the ERC20 token <> SDK coin pairs, which are served
+// in the jinx JSON-RPC namespace.
+type ERC20Keeper interface {
+	DenomForAddress(ctx sdk.Context, token common.Address) string
+	AddressForDenom(ctx sdk.Context, denom string) common.Address
+}
+
+// SetERC20Keeper sets the keeper of the ERC20 token <> SDK coin pairs.
+func (h *host) SetERC20Keeper(ek ERC20Keeper) {
+	h.ek = ek
+}
+
+// Bech32Address implements jinxapi.JinxBackend.
+func (h *host) Bech32Address(addr common.Address) string {
+	return cosmlib.Bech32FromEthAddress(addr)
+}
+
+// HexAddress implements jinxapi.JinxBackend.
+func (h *host) HexAddress(addr string) (common.Address, error) {
+	return cosmlib.EthAddressFromString(addr)
+}
+
+// GetCosmosTransaction returns the Cosmos transaction that included the given Ethereum
+// transaction, whose hash is recorded by the historical plugin when the transaction is delivered.
+// Transactions whose Cosmos transaction was not recorded, like the ones of blocks stored before
+// the hashes were recorded, are not known.
+//
+// GetCosmosTransaction implements jinxapi.JinxBackend.
+func (h *host) GetCosmosTransaction(hash common.Hash) (*jinxapi.CosmosTransaction, error) {
+	tle, err := h.hp.GetTransactionByHash(hash)
+	if errors.Is(err, core.ErrTxNotFound) {
+		return nil, nil //nolint:nilnil // the transaction is not known.
+	} else if err != nil {
+		return nil, err
+	}
+
+	txHash, err := h.hp.GetCosmosTxHash(hash)
+	if err != nil {
+		return nil, errorslib.Wrapf(err, "failed to get the Cosmos transaction of %s", hash.Hex())
+	}
+	if txHash == nil {
+		return nil, nil //nolint:nilnil // the Cosmos transaction is not known.
+	}
+	return &jinxapi.CosmosTransaction{
+		Hash:   fmt.Sprintf("%X", txHash),
+		Height: hexutil.Uint64(tle.BlockNum),
+	}, nil
+}
+
+// GetDenomForToken implements jinxapi.JinxBackend.
+func (h *host) GetDenomForToken(token common.Address) (string, error) {
+	ctx, err := h.erc20QueryContext()
+	if err != nil {
+		return "", err
+	}
+	return h.ek.DenomForAddress(ctx, token), nil
+}
+
+// GetTokenForDenom implements jinxapi.JinxBackend.
+func (h *host) GetTokenForDenom(denom string) (common.Address, error) {
+	ctx, err := h.erc20QueryContext()
+	if err != nil {
+		return common.Address{}, err
+	}
+	return h.ek.AddressForDenom(ctx, denom), nil
+}
+
+// IsPrecompile returns whether the given address is one of the stateful precompiles of the host
+// chain that are active at the latest block.
+//
+// IsPrecompile implements jinxapi.JinxBackend.
+func (h *host) IsPrecompile(addr common.Address) bool {
+	rules, err := h.latestRules()
+	if err != nil {
+		return false
+	}
+	var registered bool
+	for _, pc := range h.pp.GetPrecompiles(rules) {
+		registered = registered || pc.RegistryKey() == addr
+	}
+	if !registered {
+		return false
+	}
+	for _, active := range h.pp.GetActive(rules) {
+		if active == addr {
+			return true
+		}
+	}
+	return false
+}
+
+// GetPrecompiles returns the descriptions of the stateful precompiles that are active at the
+// latest block.
+//
+// GetPrecompiles implements jinxapi.JinxBackend.
+func (h *host) GetPrecompiles() ([]*ethprecompile.Info, error) {
+	rules, err := h.latestRules()
+	if err != nil {
+		return nil, err
+	}
+	return h.pp.GetPrecompileInfos(rules)
+}
+
+// GetPrecompile implements jinxapi.JinxBackend.
+func (h *host) GetPrecompile(addr common.Address) (*ethprecompile.Info, error) {
+	infos, err := h.GetPrecompiles()
+	if err != nil {
+		return nil, err
+	}
+	for _, info := range infos {
+		if info.Address == addr {
+			return info, nil
+		}
+	}
+	return nil, nil //nolint:nilnil // there is no active stateful precompile at the address.
+}
+
+// latestRules returns the chain rules of the latest block.
+func (h *host) latestRules() (*params.Rules, error) {
+	ctx, err := h.latestQueryContext()
+	if err != nil {
+		return nil, err
+	}
+	return chainRules(ctx, h.storeKey)
+}
+
+// erc20QueryContext returns a query context at the latest height, to read the state of the ERC20
+// keeper.
+func (h *host) erc20QueryContext() (sdk.Context, error) {
+	if h.ek == nil {
+		return sdk.Context{}, ErrNoERC20Keeper
+	}
+	return h.latestQueryContext()
+}
+
+// latestQueryContext returns a query context at the latest height.
+func (h *host) latestQueryContext() (sdk.Context, error) {
+	if h.qc == nil {
+		return sdk.Context{}, errors.New("no query context function set in host chain")
+	}
+	return h.qc(0, false)
+}
+
+// chainRules returns the chain rules of the block of the given context, which the stateful
+// precompiles that are served must be active under.
+func chainRules(ctx sdk.Context, storeKey storetypes.StoreKey) (*params.Rules, error) {
+	height := uint64(ctx.BlockHeight())
+	chainConfig, err := configuration.ChainConfigAtHeight(ctx.KVStore(storeKey), height)
+	if err != nil {
+		return nil, err
+	} else if chainConfig == nil {
+		return nil, errors.New("no chain config stored")
+	}
+	rules := chainConfig.Rules(new(big.Int).SetUint64(height), true, uint64(ctx.BlockTime().Unix()))
+	return &rules, nil
+}
@@ -21,26 +21,46 @@
 package keeper
 
 import (
+	"errors"
 	"math/big"
+	"path/filepath"
 	"time"
 
 	"cosmossdk.io/log"
+	snapshot "cosmossdk.io/store/snapshots/types"
 	storetypes "cosmossdk.io/store/types"
 
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	dbm "github.com/cosmos/cosmos-db"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
 
 	cosmlib "pkg.berachain.dev/jinx/cosmos/lib"
 	"pkg.berachain.dev/jinx/cosmos/x/evm/plugins/block"
+	"pkg.berachain.dev/jinx/cosmos/x/evm/plugins/historical"
 	"pkg.berachain.dev/jinx/cosmos/x/evm/plugins/state"
 	"pkg.berachain.dev/jinx/cosmos/x/evm/plugins/txpool"
 	"pkg.berachain.dev/jinx/cosmos/x/evm/types"
+	"pkg.berachain.dev/jinx/eth/common"
+	"pkg.berachain.dev/jinx/eth/core"
 	ethprecompile "pkg.berachain.dev/jinx/eth/core/precompile"
 	ethlog "pkg.berachain.dev/jinx/eth/log"
 	"pkg.berachain.dev/jinx/eth/jinx"
 )
 
+const (
+	// localTxsJournal is the name of the file, in the Jinx data directory, that journals the
+	// transactions submitted through this node.
+	localTxsJournal = "transactions.rlp"
+
+	// historicalDBName is the name of the database, in the Jinx data directory, that stores the
+	// historical blocks, receipts, and transactions.
+	historicalDBName = "historical"
+)
+
 type Keeper struct {
 	// ak is the reference to the AccountKeeper.
 	ak state.AccountKeeper
@@ -52,6 +72,38 @@ type Keeper struct {
 	authority string
 	// The host contains various plugins that are are used to implement `core.JinxHostChain`.
 	host Host
+	// logger is the logger that was passed in during Setup.
+	logger log.Logger
+	// hooks are called after every successful Ethereum transaction, if set.
+	hooks types.EvmHooks
+	// syntheticEventTypes are the types of the Cosmos events emitted by block hooks and Cosmos
+	// transactions that are attached to the EVM block in synthetic receipts, none by default.
+	syntheticEventTypes map[string]struct{}
+	// msgEvents collects the events of the messages of the Cosmos transaction being delivered.
+	msgEvents MsgEventCollector
+	// ethTxs are the Ethereum transactions applied by the Cosmos transaction being delivered.
+	ethTxs deliveredEthTxs
+	// parallelWorkers is the number of workers that execute the transactions of a block in
+	// parallel. Parallel execution is disabled unless it is above one.
+	parallelWorkers int
+	// txDecoder and anteHandler are the ones of the app, which the transactions of a block are
+	// decoded and checked with before they are executed in parallel.
+	txDecoder   sdk.TxDecoder
+	anteHandler sdk.AnteHandler
+	// blockTxs, blockHash and lastCommit are the raw transactions, the hash and the decided last
+	// commit of the block being finalized, as handed by SetFinalizeBlockRequest.
+	blockTxs   [][]byte
+	blockHash  []byte
+	lastCommit abci.CommitInfo
+	// extCommit is the encoded extended last commit put at the start of the block being finalized,
+	// nil if there is none.
+	extCommit []byte
+	// executed are the execution results of the Ethereum transactions of the current block that
+	// were executed in parallel by the begin blocker, by transaction hash.
+	executed map[common.Hash]*core.ExecutionResult
+
+	// prepared is whether the Jinx Ethereum block of the current height has been prepared.
+	prepared bool
 
 	// temp syncing
 	lock bool
@@ -87,29 +139,39 @@ func NewKeeper(
 func (k *Keeper) Setup(
 	_ *storetypes.KVStoreKey,
 	qc func(height int64, prove bool) (sdk.Context, error),
-	jinxConfigPath string,
+	cfg *jinx.Config,
 	jinxDataDir string,
 	logger log.Logger,
 ) {
-	// Setup plugins in the Host
-	k.host.Setup(k.storeKey, nil, k.ak, qc)
+	k.logger = logger
+	if cfg.NodeConfig.DataDir == "" {
+		cfg.NodeConfig.DataDir = jinxDataDir
+	}
 
-	// Build the Jinx EVM Provider
-	cfg, err := jinx.LoadConfigFromFilePath(jinxConfigPath)
-	// TODO: fix properly
-	if err != nil || cfg.GPO == nil {
-		logger.Error("failed to load jinx config", "falling back to defaults")
-		cfg = jinx.DefaultConfig()
+	// Open the node-local database of the historical plugin, which is kept outside of the
+	// consensus state.
+	historicalDB, err := dbm.NewDB(historicalDBName, dbm.GoLevelDBBackend, cfg.NodeConfig.DataDir)
+	if err != nil {
+		panic(err)
 	}
 
-	// TODO: PARSE JINX.TOML CORRECT AGAIN
-	nodeCfg := jinx.DefaultGethNodeConfig()
-	nodeCfg.DataDir = jinxDataDir
-	node, err := jinx.NewGethNetworkingStack(nodeCfg)
+	// Setup plugins in the Host
+	k.host.Setup(k.storeKey, historicalDB, cfg.HistoryConfig.Retention, k.ak, qc)
+	k.host.GetHistoricalPlugin().(historical.Plugin).Start(logger)
+
+	// Build the Jinx EVM Provider
+	node, err := jinx.NewGethNetworkingStack(&cfg.NodeConfig)
 	if err != nil {
 		panic(err)
 	}
 
+	// Load the journal of the transactions submitted through this node.
+	if err = k.host.GetTxPoolPlugin().(txpool.Plugin).LoadJournal(
+		filepath.Join(cfg.NodeConfig.DataDir, localTxsJournal),
+	); err != nil {
+		logger.Error("failed to load local transactions journal", "err", err)
+	}
+
 	k.jinx = jinx.NewWithNetworkingStack(cfg, k.host, node, ethlog.FuncHandler(
 		func(r *ethlog.Record) error {
 			jinxGethLogger := logger.With("module", "jinx-geth")
@@ -124,6 +186,12 @@ func (k *Keeper) Setup(
 			return nil
 		}),
 	)
+
+	// Call the hooks of other modules after every successful Ethereum transaction.
+	k.jinx.SetPostTxHook(k.postTxProcessing)
+
+	// Execute the Ethereum transactions of blocks in parallel, if enabled.
+	k.SetParallelWorkers(cfg.ExecutionConfig.ParallelWorkers)
 }
 
 // Logger returns a module-specific logger.
@@ -148,9 +216,53 @@ func (k *Keeper) SetClientCtx(clientContext client.Context) {
 		if err := k.jinx.StartServices(); err != nil {
 			panic(err)
 		}
+
+		// Start replaying and rebroadcasting the local transactions.
+		k.host.GetTxPoolPlugin().(txpool.Plugin).Start(k.logger)
 	}()
 }
 
+// Close stops the background services of the EVM and closes their files and databases. It must be
+// called when the app is shut down.
+func (k *Keeper) Close() error {
+	return errors.Join(
+		k.host.GetTxPoolPlugin().(txpool.Plugin).Stop(),
+		k.host.GetHistoricalPlugin().(historical.Plugin).Stop(),
+	)
+}
+
+// SetParallelWorkers opts in to executing the Ethereum transactions of blocks, and the ones
+// processed with `ProcessTransactions`, in parallel on the given number of workers.
+func (k *Keeper) SetParallelWorkers(workers int) {
+	k.parallelWorkers = workers
+}
+
+// SetTxHandlers sets the transaction decoder and ante handler of the app, which are required to
+// execute the Ethereum transactions of blocks in parallel.
+func (k *Keeper) SetTxHandlers(txDecoder sdk.TxDecoder, anteHandler sdk.AnteHandler) {
+	k.txDecoder = txDecoder
+	k.anteHandler = anteHandler
+}
+
+// SetERC20Keeper sets the keeper of the ERC20 token <> SDK coin pairs, which are served in the
+// jinx JSON-RPC namespace.
+func (k *Keeper) SetERC20Keeper(ek ERC20Keeper) {
+	k.host.SetERC20Keeper(ek)
+}
+
+// SetRandomnessSource sets the source of the randomness of the EVM blocks, which contracts read
+// as PREVRANDAO. By default, it is derived from the last commit of CometBFT.
+func (k *Keeper) SetRandomnessSource(rs block.RandomnessSource) {
+	k.host.GetBlockPlugin().(block.Plugin).SetRandomnessSource(rs)
+}
+
+// HistoricalSnapshotter returns the snapshot extension that exports and restores the historical
+// blocks, receipts, and transactions. It must be registered with the snapshot manager of the app
+// after Setup, so that state synced nodes can serve the EVM history before the snapshot.
+func (k *Keeper) HistoricalSnapshotter() snapshot.ExtensionSnapshotter {
+	return k.host.GetHistoricalPlugin().(historical.Plugin)
+}
+
 // TODO: Remove these, because they're hacky af.
 // Required temporarily for BGT plugin.
 func (k *Keeper) GetBalance(ctx sdk.Context, addr sdk.AccAddress) *big.Int {
@@ -21,11 +21,23 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
+	"sync"
 
 	errorsmod "cosmossdk.io/errors"
 
+	"github.com/cometbft/cometbft/crypto/tmhash"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"pkg.berachain.dev/jinx/cosmos/x/evm/plugins/historical"
 	"pkg.berachain.dev/jinx/cosmos/x/evm/types"
+	"pkg.berachain.dev/jinx/eth/common"
+	"pkg.berachain.dev/jinx/eth/core"
+	coretypes "pkg.berachain.dev/jinx/eth/core/types"
+	"pkg.berachain.dev/jinx/eth/crypto"
 )
 
 // Compile-time check to ensure `Keeper` implements the `MsgServiceServer` interface.
@@ -36,21 +48,157 @@ var _ types.MsgServiceServer = &Keeper{}
 func (k *Keeper) EthTransaction(
 	ctx context.Context, msg *types.WrappedEthereumTransaction,
 ) (*types.WrappedEthereumTransactionResult, error) {
-	// Process the transaction and return the result.
-	result, err := k.ProcessTransaction(ctx, msg.AsTransaction())
-	if err != nil {
-		return nil, errorsmod.Wrapf(err, "failed to process transaction")
+	// Process the transaction, unless it was executed ahead with the block, and return the result.
+	tx := msg.AsTransaction()
+	result, executed := k.executedResult(ctx, tx)
+	if !executed {
+		var err error
+		if result, err = k.ProcessTransaction(ctx, tx); err != nil {
+			return nil, errorsmod.Wrapf(err, "failed to process transaction")
+		}
 	}
+	k.ethTxs.add(ctx, tx.Hash())
 
 	// Build the response.
-	vmErr := ""
-	if result.Err != nil {
-		vmErr = result.Err.Error()
+	return &types.WrappedEthereumTransactionResult{
+		GasUsed:    result.UsedGas,
+		VmError:    vmError(result),
+		ReturnData: result.ReturnData,
+	}, nil
+}
+
+// EthereumCall implements the MsgServiceServer interface. It applies the call of a Cosmos account
+// to an EVM contract as an Ethereum transaction, whose nonce is the sequence consumed by the Cosmos
+// transaction.
+func (k *Keeper) EthereumCall(
+	ctx context.Context, msg *types.MsgEthereumCall,
+) (*types.MsgEthereumCallResponse, error) {
+	from := msg.GetSender()
+	nonce, err := k.authorizedNonce(ctx, from)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to get nonce of %s", msg.From)
 	}
 
-	return &types.WrappedEthereumTransactionResult{
+	// Process the transaction and return the result.
+	tx := msg.AsTransaction(nonce)
+	result, err := k.ProcessTransactionFrom(ctx, tx, from)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to process call")
+	}
+	k.ethTxs.add(ctx, tx.Hash())
+
+	return &types.MsgEthereumCallResponse{
+		TxHash:     tx.Hash().Hex(),
 		GasUsed:    result.UsedGas,
-		VmError:    vmErr,
+		VmError:    vmError(result),
 		ReturnData: result.ReturnData,
 	}, nil
 }
+
+// EthereumCreate implements the MsgServiceServer interface. It applies the contract deployment of
+// a Cosmos account as an Ethereum transaction, whose nonce is the sequence consumed by the Cosmos
+// transaction.
+func (k *Keeper) EthereumCreate(
+	ctx context.Context, msg *types.MsgEthereumCreate,
+) (*types.MsgEthereumCreateResponse, error) {
+	from := msg.GetSender()
+	nonce, err := k.authorizedNonce(ctx, from)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to get nonce of %s", msg.From)
+	}
+
+	// Process the transaction and return the result.
+	tx := msg.AsTransaction(nonce)
+	result, err := k.ProcessTransactionFrom(ctx, tx, from)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to process deployment")
+	}
+	k.ethTxs.add(ctx, tx.Hash())
+
+	return &types.MsgEthereumCreateResponse{
+		TxHash:          tx.Hash().Hex(),
+		ContractAddress: crypto.CreateAddress(from, nonce).Hex(),
+		GasUsed:         result.UsedGas,
+		VmError:         vmError(result),
+	}, nil
+}
+
+// authorizedNonce returns the nonce of the Ethereum transaction of a message of the given sender,
+// which is the sequence that the ante handler consumed for the Cosmos transaction, as the sender
+// is its signer. The sequence is rolled back to it, as the state transition consumes the nonce
+// again, so that a single nonce is used by both transactions.
+func (k *Keeper) authorizedNonce(ctx context.Context, sender common.Address) (uint64, error) {
+	acc := k.ak.GetAccount(ctx, sender.Bytes())
+	if acc == nil || acc.GetSequence() == 0 {
+		return 0, errorsmod.Wrapf(
+			sdkerrors.ErrWrongSequence, "sequence of %s was not consumed by the transaction", sender,
+		)
+	}
+
+	nonce := acc.GetSequence() - 1
+	if err := acc.SetSequence(nonce); err != nil {
+		return 0, err
+	}
+	k.ak.SetAccount(ctx, acc)
+	return nonce, nil
+}
+
+// recordCosmosTx records the Cosmos transaction of the given context, which has succeeded, as the
+// one that included the Ethereum transactions applied by its messages, so that it can be looked up
+// in the jinx JSON-RPC namespace. It is called by the PostHandler, as the messages are reverted if
+// a later message of the transaction fails.
+func (k *Keeper) recordCosmosTx(ctx sdk.Context) {
+	hashes := k.ethTxs.take(ctx.TxBytes())
+	if len(hashes) == 0 {
+		return
+	}
+	cosmosTxHash := tmhash.Sum(ctx.TxBytes())
+	for _, hash := range hashes {
+		k.host.GetHistoricalPlugin().(historical.Plugin).RecordCosmosTxHash(hash, cosmosTxHash)
+	}
+}
+
+// deliveredEthTxs are the hashes of the Ethereum transactions applied by the messages of the
+// Cosmos transaction being delivered, until it has succeeded or failed.
+type deliveredEthTxs struct {
+	mu sync.Mutex
+	// txBytes are the bytes of the Cosmos transaction of the hashes.
+	txBytes []byte
+	hashes  []common.Hash
+}
+
+// add adds the hash of an Ethereum transaction applied by a message of the Cosmos transaction of
+// the given context, unless it is checked.
+func (d *deliveredEthTxs) add(ctx context.Context, hash common.Hash) {
+	sCtx := sdk.UnwrapSDKContext(ctx)
+	if sCtx.IsCheckTx() || len(sCtx.TxBytes()) == 0 {
+		return
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if !bytes.Equal(d.txBytes, sCtx.TxBytes()) {
+		d.txBytes, d.hashes = sCtx.TxBytes(), nil
+	}
+	d.hashes = append(d.hashes, hash)
+}
+
+// take returns the hashes added for the Cosmos transaction with the given bytes and resets them.
+func (d *deliveredEthTxs) take(txBytes []byte) []common.Hash {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	addedTxBytes, hashes := d.txBytes, d.hashes
+	d.txBytes, d.hashes = nil, nil
+	if !bytes.Equal(txBytes, addedTxBytes) {
+		return nil
+	}
+	return hashes
+}
+
+// vmError returns the error message of the given execution result, if any.
+func vmError(result *core.ExecutionResult) string {
+	if result.Err != nil {
+		return result.Err.Error()
+	}
+	return ""
+}
@@ -23,8 +23,12 @@ package keeper
 import (
 	"context"
 
+	storetypes "cosmossdk.io/store/types"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"pkg.berachain.dev/jinx/cosmos/x/evm/types"
+	"pkg.berachain.dev/jinx/eth/common"
 	"pkg.berachain.dev/jinx/eth/core"
 	coretypes "pkg.berachain.dev/jinx/eth/core/types"
 )
@@ -63,3 +67,172 @@ func (k *Keeper) ProcessTransaction(ctx context.Context, tx *coretypes.Transacti
 	// Return the execution result.
 	return execResult, err
 }
+
+// ProcessTransactionFrom is called during the DeliverTx processing of the ABCI lifecycle for the
+// Ethereum transactions that Cosmos accounts authorized with Cosmos messages. The gas used by the
+// state transition is charged on top of the gas used by the Cosmos transaction so far.
+func (k *Keeper) ProcessTransactionFrom(
+	ctx context.Context, tx *coretypes.Transaction, from common.Address,
+) (*core.ExecutionResult, error) {
+	sCtx := sdk.UnwrapSDKContext(ctx)
+
+	// Process the transaction and return the EVM's execution result.
+	execResult, err := k.jinx.ProcessTransactionFrom(ctx, tx, from)
+	if err != nil {
+		return nil, err
+	}
+
+	if execResult.Err != nil {
+		k.Logger(sCtx).Error(
+			"evm execution",
+			"tx_hash", tx.Hash(),
+			"from", from,
+			"error", execResult.Err,
+			"gas_consumed", sCtx.GasMeter().GasConsumed())
+	} else {
+		k.Logger(sCtx).Debug(
+			"evm execution",
+			"tx_hash", tx.Hash(),
+			"from", from,
+			"gas_consumed", sCtx.GasMeter().GasConsumed(),
+		)
+	}
+
+	return execResult, nil
+}
+
+// ProcessTransactions processes the given Ethereum transactions of the current block in order, for
+// host chains that deliver the transactions of a block at once instead of one by one. If parallel
+// execution is enabled with `SetParallelWorkers`, they are executed in parallel with the same
+// outcome, unless they cannot be, in which case they are processed one by one. It returns the
+// execution result of every transaction, or the error that rejected it, in which case its state
+// changes are discarded. The gas used by the transactions is consumed from the block gas meter.
+func (k *Keeper) ProcessTransactions(
+	ctx context.Context, txs coretypes.Transactions,
+) ([]*core.ExecutionResult, []error) {
+	sCtx := sdk.UnwrapSDKContext(ctx)
+	results := make([]*core.ExecutionResult, len(txs))
+	errs := make([]error, len(txs))
+	if k.parallelWorkers > 1 {
+		var err error
+		if results, err = k.jinx.ProcessTransactionsParallel(ctx, txs, k.parallelWorkers); err == nil {
+			for _, result := range results {
+				sCtx.BlockGasMeter().ConsumeGas(result.UsedGas, "evm transaction")
+			}
+			return results, errs
+		}
+		k.Logger(sCtx).Info("processing evm transactions one by one", "reason", err)
+		results = make([]*core.ExecutionResult, len(txs))
+	}
+
+	for i, tx := range txs {
+		// Like in a Cosmos transaction, the transaction runs on a cache of the state with a gas
+		// meter limited to its gas, and its state changes are only written if it succeeds.
+		cacheCtx, write := sCtx.WithGasMeter(storetypes.NewGasMeter(tx.Gas())).CacheContext()
+		if results[i], errs[i] = k.ProcessTransaction(cacheCtx, tx); errs[i] == nil {
+			write()
+		}
+		sCtx.BlockGasMeter().ConsumeGas(cacheCtx.GasMeter().GasConsumedToLimit(), "evm transaction")
+	}
+	return results, errs
+}
+
+// executeBlockTransactions executes the Ethereum transactions at the start of the block in
+// parallel, if enabled, once the begin blockers ran. Their execution results are then returned
+// by `EthTransaction` when they are delivered, and the ante handler skips their nonce and balance
+// checks, which the state transition already made. The other transactions of the block, and all of
+// them if they cannot be executed in parallel, are processed one by one as they are delivered.
+//
+// As the transactions are delivered after the state of the whole run is written, only the ones
+// that are a Cosmos transaction with a single `WrappedEthereumTransaction`, and that the ante
+// handler accepts without these checks, are executed ahead. The ante handler runs on the
+// transactions in order, on a cache of the state that keeps the writes of the ante handler on the
+// transactions before, the way it does when they are delivered. None of the remaining checks depend
+// on the state written by the Ethereum transactions, so their Cosmos transactions have the same
+// result as when they are processed one by one.
+func (k *Keeper) executeBlockTransactions(ctx sdk.Context) {
+	txs := k.blockTxs
+	k.blockTxs, k.executed = nil, nil
+	if k.parallelWorkers <= 1 || k.txDecoder == nil || k.anteHandler == nil {
+		return
+	}
+
+	// Collect the Ethereum transactions at the start of the block.
+	k.executed = make(map[common.Hash]*core.ExecutionResult)
+	var ethTxs coretypes.Transactions
+	anteCtx, _ := ctx.CacheContext()
+	for _, txBytes := range txs {
+		tx, err := k.txDecoder(txBytes)
+		if err != nil || len(tx.GetMsgs()) != 1 {
+			break
+		}
+		ethTx := types.GetAsEthTx(tx)
+		if ethTx == nil {
+			break
+		}
+		if _, found := k.executed[ethTx.Hash()]; found {
+			break
+		}
+		k.executed[ethTx.Hash()] = nil
+		if !k.anteAccepts(anteCtx.WithTxBytes(txBytes), tx) {
+			delete(k.executed, ethTx.Hash())
+			break
+		}
+		ethTxs = append(ethTxs, ethTx)
+	}
+	if len(ethTxs) < 2 { //nolint:gomnd // a single transaction is not worth it.
+		k.executed = nil
+		return
+	}
+
+	results, err := k.jinx.ProcessTransactionsParallel(ctx, ethTxs, k.parallelWorkers)
+	if err != nil {
+		k.Logger(ctx).Info("processing evm transactions one by one", "reason", err)
+		k.executed = nil
+		return
+	}
+	for i, ethTx := range ethTxs {
+		k.executed[ethTx.Hash()] = results[i]
+	}
+}
+
+// anteAccepts returns whether the ante handler accepts the given transaction, which is the next
+// one of the block on the state of the given context. Like in the baseapp, the transaction is
+// rejected if the ante handler panics.
+func (k *Keeper) anteAccepts(ctx sdk.Context, tx sdk.Tx) (ok bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			ok = false
+		}
+	}()
+	_, err := k.anteHandler(ctx, tx, false)
+	return err == nil
+}
+
+// IsExecuted returns whether the given Ethereum transaction of the current block was executed
+// ahead, in parallel, while delivering the block.
+func (k *Keeper) IsExecuted(ctx sdk.Context, txHash common.Hash) bool {
+	if ctx.IsCheckTx() || k.executed == nil {
+		return false
+	}
+	_, found := k.executed[txHash]
+	return found
+}
+
+// executedResult returns the execution result of the given Ethereum transaction, if it was
+// executed ahead and has not been delivered yet, and consumes the gas it used from the gas meter
+// of the context, the way `ProcessTransaction` does.
+func (k *Keeper) executedResult(
+	ctx context.Context, tx *coretypes.Transaction,
+) (*core.ExecutionResult, bool) {
+	sCtx := sdk.UnwrapSDKContext(ctx)
+	if !k.IsExecuted(sCtx, tx.Hash()) {
+		return nil, false
+	}
+	result := k.executed[tx.Hash()]
+	delete(k.executed, tx.Hash())
+	sCtx.GasMeter().RefundGas(sCtx.GasMeter().GasConsumed(),
+		"reset gas meter prior to ethereum state transition")
+	sCtx.GasMeter().ConsumeGas(result.UsedGas, "evm transaction")
+	return result, true
+}
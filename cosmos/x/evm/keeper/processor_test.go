@@ -21,31 +21,55 @@
 package keeper_test
 
 import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"math/big"
 	"os"
+	"time"
 
 	"cosmossdk.io/log"
 	storetypes "cosmossdk.io/store/types"
 
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
+	bankbindings "pkg.berachain.dev/jinx/contracts/bindings/cosmos/precompile/bank"
 	bindings "pkg.berachain.dev/jinx/contracts/bindings/testing"
+	cosmlib "pkg.berachain.dev/jinx/cosmos/lib"
+	"pkg.berachain.dev/jinx/cosmos/precompile/bank"
 	"pkg.berachain.dev/jinx/cosmos/precompile/staking"
 	testutil "pkg.berachain.dev/jinx/cosmos/testing/utils"
+	erc20keeper "pkg.berachain.dev/jinx/cosmos/x/erc20/keeper"
 	"pkg.berachain.dev/jinx/cosmos/x/evm/keeper"
 	"pkg.berachain.dev/jinx/cosmos/x/evm/plugins"
+	"pkg.berachain.dev/jinx/cosmos/x/evm/plugins/block"
+	"pkg.berachain.dev/jinx/cosmos/x/evm/plugins/configuration"
 	"pkg.berachain.dev/jinx/cosmos/x/evm/plugins/state"
 	evmmempool "pkg.berachain.dev/jinx/cosmos/x/evm/plugins/txpool/mempool"
+	"pkg.berachain.dev/jinx/cosmos/x/evm/types"
 	"pkg.berachain.dev/jinx/eth/accounts/abi"
 	"pkg.berachain.dev/jinx/eth/common"
 	"pkg.berachain.dev/jinx/eth/core"
 	ethprecompile "pkg.berachain.dev/jinx/eth/core/precompile"
 	coretypes "pkg.berachain.dev/jinx/eth/core/types"
 	"pkg.berachain.dev/jinx/eth/crypto"
+	"pkg.berachain.dev/jinx/eth/jinx"
+	jinxapi "pkg.berachain.dev/jinx/eth/jinx/api"
 	"pkg.berachain.dev/jinx/eth/params"
 	"pkg.berachain.dev/jinx/lib/utils"
 
@@ -66,15 +90,21 @@ var _ = Describe("Processor", func() {
 		k            *keeper.Keeper
 		ak           state.AccountKeeper
 		sk           stakingkeeper.Keeper
+		bk           bankkeeper.BaseKeeper
 		ctx          sdk.Context
 		sc           ethprecompile.StatefulImpl
 		key, _       = crypto.GenerateEthKey()
 		signer       = coretypes.LatestSignerForChainID(params.DefaultChainConfig.ChainID)
 		legacyTxData *coretypes.LegacyTx
 		valAddr      = common.Address{0x21}.Bytes()
+		// qc is the query context function of the keeper, none by default.
+		qc func(height int64, prove bool) (sdk.Context, error)
+		// withBank is whether the bank precompile is registered, which it is not by default.
+		withBank bool
 	)
 
-	BeforeEach(func() {
+	// setup builds a new keeper on new stores and begins block 1.
+	setup := func() {
 		err := os.RemoveAll("tmp/berachain")
 		Expect(err).ToNot(HaveOccurred())
 
@@ -86,14 +116,18 @@ var _ = Describe("Processor", func() {
 		}
 
 		// before chain, init genesis state
-		ctx, ak, _, sk = testutil.SetupMinimalKeepers()
+		ctx, ak, bk, sk = testutil.SetupMinimalKeepers()
 		k = keeper.NewKeeper(
 			ak, sk,
 			storetypes.NewKVStoreKey("evm"),
 			"authority",
 			evmmempool.NewJinxEthereumTxPool(),
 			func() *ethprecompile.Injector {
-				return ethprecompile.NewPrecompiles([]ethprecompile.Registrable{sc}...)
+				pcs := []ethprecompile.Registrable{sc}
+				if withBank {
+					pcs = append(pcs, bank.NewPrecompileContract(bankkeeper.NewMsgServerImpl(bk), bk))
+				}
+				return ethprecompile.NewPrecompiles(pcs...)
 			},
 		)
 		ctx = ctx.WithBlockHeight(0)
@@ -108,7 +142,7 @@ var _ = Describe("Processor", func() {
 		validator.Status = stakingtypes.Bonded
 		sk.SetValidator(ctx, validator)
 		sc = staking.NewPrecompileContract(&sk)
-		k.Setup(storetypes.NewKVStoreKey("offchain-evm"), nil, "", GinkgoT().TempDir(), log.NewNopLogger())
+		k.Setup(storetypes.NewKVStoreKey("offchain-evm"), qc, jinx.DefaultConfig(), GinkgoT().TempDir(), log.NewNopLogger())
 		_ = sk.SetParams(ctx, stakingtypes.DefaultParams())
 
 		// Set validator with consensus address.
@@ -127,7 +161,9 @@ var _ = Describe("Processor", func() {
 			WithBlockHeight(1)
 		err = k.BeginBlocker(ctx)
 		Expect(err).ToNot(HaveOccurred())
-	})
+	}
+
+	BeforeEach(setup)
 
 	Context("New Block", func() {
 		BeforeEach(func() {
@@ -191,5 +227,1127 @@ var _ = Describe("Processor", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(result.Err).ToNot(HaveOccurred())
 		})
+		It("should store the hash of the previous block as the beacon root", func() {
+			// activate Cancun from block 2.
+			chainConfig := *params.DefaultChainConfig
+			cancunTime := uint64(0)
+			chainConfig.CancunTime = &cancunTime
+			utils.MustGetAs[configuration.Plugin](k.GetHost().GetConfigurationPlugin()).
+				SetChainConfig(&chainConfig)
+			Expect(k.EndBlock(ctx)).To(Succeed())
+
+			// finalize blocks 2 and 3 with the requests sent by CometBFT.
+			ctx = ctx.WithBlockTime(time.Unix(1000, 0))
+			for height := int64(2); height <= 3; height++ {
+				k.SetFinalizeBlockRequest(&abci.RequestFinalizeBlock{
+					Height: height, Hash: []byte{byte(height)},
+				})
+				ctx = ctx.WithBlockHeight(height).WithBlockTime(ctx.BlockTime().Add(time.Second))
+				Expect(k.BeginBlocker(ctx)).To(Succeed())
+				Expect(k.EndBlock(ctx)).To(Succeed())
+			}
+
+			// the contract stores the root by timestamp in a ring buffer of 8191 slots.
+			slot := new(big.Int).SetInt64(ctx.BlockTime().Unix()%8191 + 8191)
+			storageResp, err := k.Storage(ctx, &types.StorageRequest{
+				Address: core.BeaconRootsAddress.Hex(), Key: common.BigToHash(slot).Hex(),
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(storageResp.Value).To(Equal(common.BytesToHash([]byte{0x2}).Hex()))
+		})
+
+		It("should query Ethereum accounts", func() {
+			addr := common.Address{0x1}
+			sp := k.GetHost().GetStatePlugin()
+			sp.Reset(ctx)
+			sp.CreateAccount(addr)
+			sp.AddBalance(addr, big.NewInt(100))
+			sp.SetNonce(addr, 2)
+			sp.SetCode(addr, []byte{0x1})
+			sp.SetState(addr, common.Hash{0x2}, common.Hash{0x3})
+			sp.Finalize()
+
+			// query by bech32 and hex addresses
+			accResp, err := k.Account(ctx, &types.AccountRequest{Address: cosmlib.Bech32FromEthAddress(addr)})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(accResp.Balance).To(Equal("100"))
+			Expect(accResp.Nonce).To(Equal(uint64(2)))
+			Expect(accResp.CodeHash).To(Equal(crypto.Keccak256Hash([]byte{0x1}).Hex()))
+			codeResp, err := k.Code(ctx, &types.CodeRequest{Address: addr.Hex()})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(codeResp.Code).To(Equal([]byte{0x1}))
+			storageResp, err := k.Storage(ctx, &types.StorageRequest{
+				Address: addr.Hex(), Key: common.Hash{0x2}.Hex(),
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(storageResp.Value).To(Equal(common.Hash{0x3}.Hex()))
+
+			// query invalid addresses and unknown transactions
+			_, err = k.Account(ctx, &types.AccountRequest{Address: "osmo"})
+			Expect(err).To(HaveOccurred())
+			_, err = k.Receipt(ctx, &types.ReceiptRequest{TxHash: common.Hash{0x4}.Hex()})
+			Expect(err).To(MatchError(core.ErrTxNotFound))
+		})
+
+		It("should process calls from Cosmos accounts", func() {
+			addr := crypto.PubkeyToAddress(key.PublicKey)
+			k.GetHost().GetStatePlugin().Reset(ctx)
+			k.GetHost().GetStatePlugin().CreateAccount(addr)
+			k.GetHost().GetStatePlugin().AddBalance(addr, big.NewInt(1000000000))
+			k.GetHost().GetStatePlugin().Finalize()
+
+			// consumeSequence increments the sequence of the signer, like the ante handler does
+			// for every Cosmos transaction.
+			consumeSequence := func() {
+				acc := ak.GetAccount(ctx, addr.Bytes())
+				Expect(acc.SetSequence(acc.GetSequence() + 1)).To(Succeed())
+				ak.SetAccount(ctx, acc)
+			}
+
+			// a message of a transaction that did not consume the sequence is rejected
+			_, err := k.EthereumCreate(ctx, types.NewMsgEthereumCreate(
+				addr.Bytes(), common.FromHex(bindings.SolmateERC20Bin), new(big.Int), 10000000,
+			))
+			Expect(err).To(HaveOccurred())
+
+			// deploy the contract
+			consumeSequence()
+			createMsg := types.NewMsgEthereumCreate(
+				addr.Bytes(), common.FromHex(bindings.SolmateERC20Bin), new(big.Int), 10000000,
+			)
+			createResp, err := k.EthereumCreate(ctx, createMsg)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(createResp.VmError).To(BeEmpty())
+			Expect(createResp.ContractAddress).To(Equal(crypto.CreateAddress(addr, 0).Hex()))
+			Expect(createResp.TxHash).To(Equal(createMsg.AsTransaction(0).Hash().Hex()))
+			Expect(ak.GetSequence(ctx, addr.Bytes())).To(Equal(uint64(1)))
+
+			// call the contract
+			var solmateABI abi.ABI
+			Expect(solmateABI.UnmarshalJSON([]byte(bindings.SolmateERC20ABI))).To(Succeed())
+			input, err := solmateABI.Pack("mint", common.BytesToAddress([]byte{0x88}), big.NewInt(8888888))
+			Expect(err).ToNot(HaveOccurred())
+			msg := types.NewMsgEthereumCall(
+				addr.Bytes(), crypto.CreateAddress(addr, 0), input, new(big.Int), 10000000,
+			)
+			consumeSequence()
+			callResp, err := k.EthereumCall(ctx, msg)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(callResp.VmError).To(BeEmpty())
+			Expect(callResp.GasUsed).ToNot(BeZero())
+			Expect(callResp.TxHash).To(Equal(msg.AsTransaction(1).Hash().Hex()))
+			Expect(ak.GetSequence(ctx, addr.Bytes())).To(Equal(uint64(2)))
+
+			// the sender and the contract address are served by the JSON-RPC
+			Expect(k.EndBlock(ctx)).To(Succeed())
+			hp := k.GetHost().GetHistoricalPlugin()
+			for _, txHash := range []string{createResp.TxHash, callResp.TxHash} {
+				tle, err := hp.GetTransactionByHash(common.HexToHash(txHash))
+				Expect(err).ToNot(HaveOccurred())
+				from, err := coretypes.Sender(signer, tle.Tx)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(from).To(Equal(addr))
+			}
+			block, err := hp.GetBlockByNumber(1)
+			Expect(err).ToNot(HaveOccurred())
+			receipts, err := hp.GetReceiptsByHash(block.Hash())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(receipts).To(HaveLen(2))
+			Expect(receipts[0].ContractAddress).To(Equal(crypto.CreateAddress(addr, 0)))
+			Expect(receipts[1].ContractAddress).To(Equal(common.Address{}))
+
+			// begin the next block, which is ended after the test.
+			ctx = ctx.WithBlockHeight(2)
+			Expect(k.BeginBlocker(ctx)).To(Succeed())
+		})
+
+		It("should call the hooks after a successful transaction", func() {
+			hooks := &mockEvmHooks{}
+			k.SetHooks(types.NewMultiEvmHooks(hooks))
+
+			legacyTxData.Data = common.FromHex(bindings.SolmateERC20Bin)
+			legacyTxData.GasPrice = big.NewInt(10000000000)
+			tx := coretypes.MustSignNewTx(key, signer, legacyTxData)
+			addr, err := signer.Sender(tx)
+			Expect(err).ToNot(HaveOccurred())
+			k.GetHost().GetStatePlugin().Reset(ctx)
+			k.GetHost().GetStatePlugin().CreateAccount(addr)
+			k.GetHost().GetStatePlugin().AddBalance(addr, (&big.Int{}).Mul(big.NewInt(9000000000000000000), big.NewInt(999)))
+			k.GetHost().GetStatePlugin().Finalize()
+
+			result, err := k.ProcessTransaction(ctx, tx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Err).ToNot(HaveOccurred())
+			Expect(hooks.receipts).To(HaveLen(1))
+			Expect(hooks.receipts[0].TxHash).To(Equal(tx.Hash()))
+			Expect(hooks.receipts[0].ContractAddress).To(Equal(crypto.CreateAddress(addr, 0)))
+
+			// a failing hook rejects the transaction
+			hooks.err = errors.New("hook failed")
+			legacyTxData.Nonce++
+			tx = coretypes.MustSignNewTx(key, signer, legacyTxData)
+			_, err = k.ProcessTransaction(ctx, tx)
+			Expect(err).To(MatchError(ContainSubstring("hook failed")))
+			Expect(hooks.receipts).To(HaveLen(2))
+		})
+	})
+
+	Context("System calls", func() {
+		It("should deploy and call a contract and attach the logs to the block", func() {
+			ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+			var solmateABI abi.ABI
+			Expect(solmateABI.UnmarshalJSON([]byte(bindings.SolmateERC20ABI))).To(Succeed())
+
+			contractAddr, result, err := k.DeployContract(
+				ctx, solmateABI, common.FromHex(bindings.SolmateERC20Bin), nil, 10000000, true,
+			)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.UsedGas).ToNot(BeZero())
+			Expect(contractAddr).To(Equal(crypto.CreateAddress(keeper.SystemSender, 0)))
+
+			// a discarded call does not change the state
+			result, err = k.CallEVM(
+				ctx, solmateABI, contractAddr, nil, 10000000, false,
+				"mint", common.BytesToAddress([]byte{0x88}), big.NewInt(8888888),
+			)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.UsedGas).ToNot(BeZero())
+			result, err = k.CallEVM(ctx, solmateABI, contractAddr, nil, 10000000, false, "totalSupply")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(new(big.Int).SetBytes(result.ReturnData).Sign()).To(BeZero())
+
+			// a committed call changes the state and emits logs
+			_, err = k.CallEVM(
+				ctx, solmateABI, contractAddr, nil, 10000000, true,
+				"mint", common.BytesToAddress([]byte{0x88}), big.NewInt(8888888),
+			)
+			Expect(err).ToNot(HaveOccurred())
+			result, err = k.CallEVM(ctx, solmateABI, contractAddr, nil, 10000000, false, "totalSupply")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(new(big.Int).SetBytes(result.ReturnData)).To(Equal(big.NewInt(8888888)))
+
+			// a reverted call returns an error
+			_, err = k.CallEVMWithData(ctx, contractAddr, []byte{0x1}, nil, 10000000, true)
+			Expect(err).To(HaveOccurred())
+
+			Expect(k.EndBlock(ctx)).To(Succeed())
+			block, err := k.GetHost().GetHistoricalPlugin().GetBlockByNumber(1)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(block.Transactions()).To(HaveLen(1))
+			receipts, err := k.GetHost().GetHistoricalPlugin().GetReceiptsByHash(block.Hash())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(receipts).To(HaveLen(1))
+			Expect(receipts[0].Logs).ToNot(BeEmpty())
+			Expect(receipts[0].Logs[0].Address).To(Equal(contractAddr))
+		})
+		It("should not leak the journals of a discarded call into the next transactions", func() {
+			ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+			var solmateABI abi.ABI
+			Expect(solmateABI.UnmarshalJSON([]byte(bindings.SolmateERC20ABI))).To(Succeed())
+			contractAddr := crypto.CreateAddress(keeper.SystemSender, 0)
+
+			// a discarded deployment, at the same address, which self destructs (CALLER SELFDESTRUCT)
+			_, _, err := k.DeployContract(ctx, solmateABI, []byte{0x33, 0xff}, nil, 10000000, false)
+			Expect(err).ToNot(HaveOccurred())
+
+			// the deployed contract is not deleted by the suicide of the discarded deployment
+			deployedAddr, _, err := k.DeployContract(
+				ctx, solmateABI, common.FromHex(bindings.SolmateERC20Bin), nil, 10000000, true,
+			)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(deployedAddr).To(Equal(contractAddr))
+
+			// a transaction can call the contract
+			sender := crypto.PubkeyToAddress(key.PublicKey)
+			sp := k.GetHost().GetStatePlugin()
+			sp.Reset(ctx)
+			sp.CreateAccount(sender)
+			sp.AddBalance(sender, big.NewInt(1000000000000000000))
+			sp.Finalize()
+			input, err := solmateABI.Pack("mint", common.BytesToAddress([]byte{0x88}), big.NewInt(8888888))
+			Expect(err).ToNot(HaveOccurred())
+			result, err := k.ProcessTransaction(ctx, coretypes.MustSignNewTx(key, signer, &coretypes.LegacyTx{
+				To:       &contractAddr,
+				Gas:      1000000,
+				GasPrice: big.NewInt(10000000000),
+				Data:     input,
+			}))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Err).ToNot(HaveOccurred())
+
+			result, err = k.CallEVM(ctx, solmateABI, contractAddr, nil, 10000000, false, "totalSupply")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(new(big.Int).SetBytes(result.ReturnData)).To(Equal(big.NewInt(8888888)))
+			Expect(k.EndBlock(ctx)).To(Succeed())
+		})
+	})
+
+	Context("Synthetic receipts", func() {
+		It("should attach the registered events of block hooks to the block", func() {
+			k.EnableSyntheticReceipts(stakingtypes.EventTypeDelegate)
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+			ctx.EventManager().EmitEvents(sdk.Events{
+				sdk.NewEvent(
+					stakingtypes.EventTypeDelegate,
+					sdk.NewAttribute(stakingtypes.AttributeKeyValidator, sdk.ValAddress(valAddr).String()),
+					sdk.NewAttribute(sdk.AttributeKeyAmount, "10stake"),
+				),
+				sdk.NewEvent(stakingtypes.EventTypeUnbond),
+			})
+			Expect(k.EndBlock(ctx)).To(Succeed())
+
+			block, err := k.GetHost().GetHistoricalPlugin().GetBlockByNumber(1)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(block.Transactions()).To(HaveLen(1))
+			syntheticTx := block.Transactions()[0]
+			Expect(syntheticTx.Hash()).To(Equal(coretypes.NewSyntheticTx(1, []byte("end_block")).Hash()))
+
+			receipts, err := k.GetHost().GetHistoricalPlugin().GetReceiptsByHash(block.Hash())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(receipts).To(HaveLen(1))
+			Expect(receipts[0].Status).To(Equal(coretypes.ReceiptStatusSuccessful))
+			Expect(receipts[0].TxHash).To(Equal(syntheticTx.Hash()))
+			Expect(receipts[0].Logs).To(HaveLen(1))
+			Expect(receipts[0].Logs[0].Address).To(Equal(sc.RegistryKey()))
+			Expect(receipts[0].Logs[0].TxHash).To(Equal(syntheticTx.Hash()))
+		})
+
+		It("should attach the registered events of the messages of Cosmos transactions", func() {
+			k.EnableSyntheticReceipts(stakingtypes.EventTypeDelegate)
+			delegateEvent := sdk.NewEvent(
+				stakingtypes.EventTypeDelegate,
+				sdk.NewAttribute(stakingtypes.AttributeKeyValidator, sdk.ValAddress(valAddr).String()),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, "10stake"),
+			)
+			delegate := &stakingtypes.MsgDelegate{ValidatorAddress: sdk.ValAddress(valAddr).String()}
+			exec := authz.NewMsgExec(sdk.AccAddress(valAddr), []sdk.Msg{delegate})
+			txBuilder := testutil.GetEncodingConfig().TxConfig.NewTxBuilder()
+			Expect(txBuilder.SetMsgs(&exec, delegate)).To(Succeed())
+			tx := txBuilder.GetTx()
+
+			// execute a message through the router, which hands its context to the collector.
+			execute := func(txCtx sdk.Context, typeURL string, events int) sdk.Context {
+				msgCtx := txCtx.WithEventManager(sdk.NewEventManager())
+				allowed, err := k.MsgEventCollector(nil).IsAllowed(msgCtx, typeURL)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(allowed).To(BeTrue())
+				for i := 0; i < events; i++ {
+					msgCtx.EventManager().EmitEvent(delegateEvent)
+				}
+				return msgCtx
+			}
+			// deliver the transaction: the events of the delegation executed by the authz message
+			// are emitted again by it, and the delegation of the transaction emits two events.
+			deliver := func(txBytes []byte, success bool) {
+				txCtx := ctx.WithTxBytes(txBytes)
+				execCtx := execute(txCtx, sdk.MsgTypeURL(&exec), 0)
+				execute(execCtx, sdk.MsgTypeURL(delegate), 1)
+				execCtx.EventManager().EmitEvent(delegateEvent)
+				execute(txCtx, sdk.MsgTypeURL(delegate), 2)
+
+				_, err := k.PostHandler()(txCtx, tx, false, success)
+				Expect(err).ToNot(HaveOccurred())
+			}
+			deliver([]byte("failed tx"), false)
+			deliver([]byte("cosmos tx"), true)
+			Expect(k.EndBlock(ctx)).To(Succeed())
+
+			block, err := k.GetHost().GetHistoricalPlugin().GetBlockByNumber(1)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(block.Transactions()).To(HaveLen(1))
+			syntheticTx := block.Transactions()[0]
+			source := fmt.Sprintf("cosmos_tx/%X", tmhash.Sum([]byte("cosmos tx")))
+			Expect(syntheticTx.Hash()).To(Equal(coretypes.NewSyntheticTx(1, []byte(source)).Hash()))
+
+			receipts, err := k.GetHost().GetHistoricalPlugin().GetReceiptsByHash(block.Hash())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(receipts).To(HaveLen(1))
+			Expect(receipts[0].Logs).To(HaveLen(3))
+			Expect(receipts[0].Logs[0].Address).To(Equal(sc.RegistryKey()))
+		})
+
+		It("should defer to the circuit breaker of the app", func() {
+			k.EnableSyntheticReceipts(stakingtypes.EventTypeDelegate)
+			delegate := &stakingtypes.MsgDelegate{ValidatorAddress: sdk.ValAddress(valAddr).String()}
+			txBuilder := testutil.GetEncodingConfig().TxConfig.NewTxBuilder()
+			Expect(txBuilder.SetMsgs(delegate)).To(Succeed())
+			txCtx := ctx.WithTxBytes([]byte("cosmos tx"))
+
+			breaker := &mockCircuitBreaker{disallowed: sdk.MsgTypeURL(delegate)}
+			msgCtx := txCtx.WithEventManager(sdk.NewEventManager())
+			allowed, err := k.MsgEventCollector(breaker).IsAllowed(msgCtx, sdk.MsgTypeURL(delegate))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(allowed).To(BeFalse())
+			allowed, err = k.MsgEventCollector(breaker).IsAllowed(msgCtx, "/cosmos.bank.v1beta1.MsgSend")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(allowed).To(BeTrue())
+
+			// the collected messages are not the ones of the transaction, so none are attached.
+			_, err = k.PostHandler()(txCtx, txBuilder.GetTx(), false, true)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(k.EndBlock(ctx)).To(Succeed())
+
+			block, err := k.GetHost().GetHistoricalPlugin().GetBlockByNumber(1)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(block.Transactions()).To(BeEmpty())
+		})
+
+		It("should not attach the events of Cosmos transactions in CheckTx", func() {
+			k.EnableSyntheticReceipts(stakingtypes.EventTypeDelegate)
+			checkCtx := ctx.WithTxBytes([]byte("cosmos tx")).WithIsCheckTx(true)
+			msgCtx := checkCtx.WithEventManager(sdk.NewEventManager())
+			_, err := k.MsgEventCollector(nil).IsAllowed(
+				msgCtx, sdk.MsgTypeURL(&stakingtypes.MsgDelegate{}),
+			)
+			Expect(err).ToNot(HaveOccurred())
+			msgCtx.EventManager().EmitEvent(sdk.NewEvent(
+				stakingtypes.EventTypeDelegate,
+				sdk.NewAttribute(stakingtypes.AttributeKeyValidator, sdk.ValAddress(valAddr).String()),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, "10stake"),
+			))
+			txBuilder := testutil.GetEncodingConfig().TxConfig.NewTxBuilder()
+			Expect(txBuilder.SetMsgs(&stakingtypes.MsgDelegate{})).To(Succeed())
+			_, err = k.PostHandler()(checkCtx, txBuilder.GetTx(), false, true)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(k.EndBlock(ctx)).To(Succeed())
+
+			block, err := k.GetHost().GetHistoricalPlugin().GetBlockByNumber(1)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(block.Transactions()).To(BeEmpty())
+		})
+
+		It("should not attach any receipts if not enabled", func() {
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+			ctx.EventManager().EmitEvent(sdk.NewEvent(
+				stakingtypes.EventTypeDelegate,
+				sdk.NewAttribute(stakingtypes.AttributeKeyValidator, sdk.ValAddress(valAddr).String()),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, "10stake"),
+			))
+			Expect(k.EndBlock(ctx)).To(Succeed())
+
+			block, err := k.GetHost().GetHistoricalPlugin().GetBlockByNumber(1)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(block.Transactions()).To(BeEmpty())
+		})
+	})
+
+	Context("Parallel execution", func() {
+		It("should have the same outcome as sequential execution", func() {
+			ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+
+			// fund a few senders, which each send transfers to the next one and to a new account.
+			keys := make([]*ecdsa.PrivateKey, 4)
+			addrs := make([]common.Address, 0, 2*len(keys))
+			sp := k.GetHost().GetStatePlugin()
+			sp.Reset(ctx)
+			for i := range keys {
+				keys[i], _ = crypto.GenerateEthKey()
+				addrs = append(addrs, crypto.PubkeyToAddress(keys[i].PublicKey), common.Address{0x30, byte(i)})
+				sp.CreateAccount(addrs[2*i])
+				sp.AddBalance(addrs[2*i], big.NewInt(1000000000000000000))
+			}
+			sp.Finalize()
+			coinbase := common.BytesToAddress(valAddr)
+			addrs = append(addrs, coinbase)
+
+			var txs coretypes.Transactions
+			for nonce := uint64(0); nonce < 3; nonce++ {
+				for i, key := range keys {
+					next := crypto.PubkeyToAddress(keys[(i+1)%len(keys)].PublicKey)
+					for j, to := range []common.Address{next, {0x30, byte(i)}} {
+						to := to
+						txs = append(txs, coretypes.MustSignNewTx(key, signer, &coretypes.LegacyTx{
+							Nonce:    2*nonce + uint64(j),
+							To:       &to,
+							Value:    big.NewInt(1000),
+							Gas:      21000,
+							GasPrice: big.NewInt(10000000000),
+						}))
+					}
+				}
+			}
+			// a transaction with a used nonce is rejected, so they are processed one by one.
+			txs = append(txs, txs[0])
+
+			process := func(workers int) ([]uint64, []error, []*big.Int) {
+				cacheCtx, _ := ctx.CacheContext()
+				k.SetParallelWorkers(workers)
+				results, errs := k.ProcessTransactions(cacheCtx, txs)
+				gasUsed := make([]uint64, len(results))
+				for i, result := range results {
+					if result != nil {
+						gasUsed[i] = result.UsedGas
+					}
+				}
+				balances := make([]*big.Int, len(addrs))
+				sp.Reset(cacheCtx)
+				for i, addr := range addrs {
+					balances[i] = sp.GetBalance(addr)
+				}
+				return gasUsed, errs, balances
+			}
+
+			gasUsed, errs, balances := process(0)
+			Expect(errs[:len(txs)-1]).To(HaveEach(BeNil()))
+			Expect(errs[len(txs)-1]).To(HaveOccurred())
+			Expect(balances[len(balances)-1].Sign()).To(Equal(1))
+
+			parallelGasUsed, parallelErrs, parallelBalances := process(4)
+			Expect(parallelGasUsed).To(Equal(gasUsed))
+			Expect(parallelErrs[:len(txs)-1]).To(HaveEach(BeNil()))
+			Expect(parallelErrs[len(txs)-1]).To(HaveOccurred())
+			Expect(parallelBalances).To(Equal(balances))
+		})
+
+		It("should deliver blocks with the same receipts, logs and events as sequential execution", func() {
+			keys := make([]*ecdsa.PrivateKey, 4)
+			for i := range keys {
+				keys[i], _ = crypto.GenerateEthKey()
+			}
+
+			// deliver a block where every sender transfers tokens to the next one and ether to a new
+			// account, on a new keeper. It returns the receipts of the block, without its hash, which
+			// depends on the time of the block, and the results and events of the transactions.
+			deliverBlock := func(workers int) ([]byte, []byte, []*types.WrappedEthereumTransactionResult, []uint64) {
+				setup()
+				ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+				k.SetHooks(txEventHooks{})
+
+				// deploy the token and fund the senders in block 1.
+				var solmateABI abi.ABI
+				Expect(solmateABI.UnmarshalJSON([]byte(bindings.SolmateERC20ABI))).To(Succeed())
+				token, _, err := k.DeployContract(
+					ctx, solmateABI, common.FromHex(bindings.SolmateERC20Bin), nil, 10000000, true,
+				)
+				Expect(err).ToNot(HaveOccurred())
+				senders := make([]common.Address, len(keys))
+				sp := k.GetHost().GetStatePlugin()
+				sp.Reset(ctx)
+				for i, key := range keys {
+					senders[i] = crypto.PubkeyToAddress(key.PublicKey)
+					sp.CreateAccount(senders[i])
+					sp.AddBalance(senders[i], big.NewInt(1000000000000000000))
+				}
+				sp.Finalize()
+				for _, sender := range senders {
+					_, err = k.CallEVM(ctx, solmateABI, token, nil, 10000000, true, "mint", sender, big.NewInt(1000000))
+					Expect(err).ToNot(HaveOccurred())
+				}
+				Expect(k.EndBlock(ctx)).To(Succeed())
+
+				var txs coretypes.Transactions
+				for nonce := uint64(0); nonce < 3; nonce++ {
+					for i, key := range keys {
+						input, err := solmateABI.Pack("transfer", senders[(i+1)%len(senders)], big.NewInt(100))
+						Expect(err).ToNot(HaveOccurred())
+						to := common.Address{0x30, byte(i)}
+						txs = append(txs,
+							coretypes.MustSignNewTx(key, signer, &coretypes.LegacyTx{
+								Nonce:    2 * nonce,
+								To:       &token,
+								Gas:      100000,
+								GasPrice: big.NewInt(10000000000),
+								Data:     input,
+							}),
+							coretypes.MustSignNewTx(key, signer, &coretypes.LegacyTx{
+								Nonce:    2*nonce + 1,
+								To:       &to,
+								Value:    big.NewInt(1000),
+								Gas:      21000,
+								GasPrice: big.NewInt(10000000000),
+							}),
+						)
+					}
+				}
+
+				// hand the transactions of block 2 to the keeper, whose begin blocker executes them.
+				blockTxs := make([][]byte, len(txs))
+				sdkTxs := make(map[string]sdk.Tx, len(txs))
+				for i, tx := range txs {
+					blockTxs[i], err = tx.MarshalBinary()
+					Expect(err).ToNot(HaveOccurred())
+					txBuilder := testutil.GetEncodingConfig().TxConfig.NewTxBuilder()
+					Expect(txBuilder.SetMsgs(types.NewFromTransaction(tx))).To(Succeed())
+					sdkTxs[string(blockTxs[i])] = txBuilder.GetTx()
+				}
+				k.SetParallelWorkers(workers)
+				k.SetTxHandlers(
+					func(txBytes []byte) (sdk.Tx, error) { return sdkTxs[string(txBytes)], nil },
+					func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil },
+				)
+				k.SetFinalizeBlockRequest(&abci.RequestFinalizeBlock{Txs: blockTxs, Height: 2})
+				ctx = ctx.WithBlockHeight(2).WithEventManager(sdk.NewEventManager())
+				Expect(k.BeginBlocker(ctx)).To(Succeed())
+				for _, tx := range txs {
+					Expect(k.IsExecuted(ctx, tx.Hash())).To(Equal(workers > 1))
+				}
+
+				// deliver the transactions of block 2.
+				results := make([]*types.WrappedEthereumTransactionResult, len(txs))
+				gasConsumed := make([]uint64, len(txs))
+				for i, tx := range txs {
+					txCtx := ctx.WithGasMeter(storetypes.NewGasMeter(tx.Gas()))
+					results[i], err = k.EthTransaction(txCtx, types.NewFromTransaction(tx))
+					Expect(err).ToNot(HaveOccurred())
+					Expect(results[i].VmError).To(BeEmpty())
+					gasConsumed[i] = txCtx.GasMeter().GasConsumed()
+				}
+				events, err := json.Marshal(ctx.EventManager().ABCIEvents())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(k.EndBlock(ctx)).To(Succeed())
+
+				block, err := k.GetHost().GetHistoricalPlugin().GetBlockByNumber(2)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(block.Transactions()).To(HaveLen(len(txs)))
+				receipts, err := k.GetHost().GetHistoricalPlugin().GetReceiptsByHash(block.Hash())
+				Expect(err).ToNot(HaveOccurred())
+				for _, receipt := range receipts {
+					receipt.BlockHash = common.Hash{}
+					for _, log := range receipt.Logs {
+						log.BlockHash = common.Hash{}
+					}
+				}
+				Expect(receipts[0].Logs).To(HaveLen(1))
+				bz, err := json.Marshal(receipts)
+				Expect(err).ToNot(HaveOccurred())
+				return bz, events, results, gasConsumed
+			}
+
+			receipts, events, results, gasConsumed := deliverBlock(0)
+			Expect(string(events)).To(ContainSubstring(txEventType))
+			parallelReceipts, parallelEvents, parallelResults, parallelGasConsumed := deliverBlock(4)
+			Expect(parallelReceipts).To(Equal(receipts))
+			Expect(parallelEvents).To(Equal(events))
+			Expect(parallelResults).To(Equal(results))
+			Expect(parallelGasConsumed).To(Equal(gasConsumed))
+		})
+
+		It("should check the transactions executed ahead with the ante handler in order", func() {
+			keys := make([]*ecdsa.PrivateKey, 4)
+			sp := k.GetHost().GetStatePlugin()
+			sp.Reset(ctx)
+			var txs coretypes.Transactions
+			for i := range keys {
+				keys[i], _ = crypto.GenerateEthKey()
+				sender := crypto.PubkeyToAddress(keys[i].PublicKey)
+				sp.CreateAccount(sender)
+				sp.AddBalance(sender, big.NewInt(1000000000000000000))
+				to := common.Address{0x30, byte(i)}
+				txs = append(txs, coretypes.MustSignNewTx(keys[i], signer, &coretypes.LegacyTx{
+					To:       &to,
+					Value:    big.NewInt(1000),
+					Gas:      21000,
+					GasPrice: big.NewInt(10000000000),
+				}))
+			}
+			sp.Finalize()
+			Expect(k.EndBlock(ctx)).To(Succeed())
+
+			blockTxs := make([][]byte, len(txs))
+			sdkTxs := make(map[string]sdk.Tx, len(txs))
+			for i, tx := range txs {
+				var err error
+				blockTxs[i], err = tx.MarshalBinary()
+				Expect(err).ToNot(HaveOccurred())
+				txBuilder := testutil.GetEncodingConfig().TxConfig.NewTxBuilder()
+				Expect(txBuilder.SetMsgs(types.NewFromTransaction(tx))).To(Succeed())
+				sdkTxs[string(blockTxs[i])] = txBuilder.GetTx()
+			}
+			// the ante handler accepts two transactions per block, which it counts in the state.
+			counterKey := []byte("ante-counter")
+			k.SetParallelWorkers(4)
+			k.SetTxHandlers(
+				func(txBytes []byte) (sdk.Tx, error) { return sdkTxs[string(txBytes)], nil },
+				func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+					store := ctx.KVStore(testutil.EvmKey)
+					counter := sdk.BigEndianToUint64(store.Get(counterKey))
+					if counter >= 2 {
+						return ctx, errors.New("too many transactions")
+					}
+					store.Set(counterKey, sdk.Uint64ToBigEndian(counter+1))
+					return ctx, nil
+				},
+			)
+			k.SetFinalizeBlockRequest(&abci.RequestFinalizeBlock{Txs: blockTxs, Height: 2})
+			ctx = ctx.WithBlockHeight(2).WithEventManager(sdk.NewEventManager())
+			Expect(k.BeginBlocker(ctx)).To(Succeed())
+			for i, tx := range txs {
+				Expect(k.IsExecuted(ctx, tx.Hash())).To(Equal(i < 2))
+			}
+			// the writes of the ante handler are left to the delivery of the transactions.
+			Expect(ctx.KVStore(testutil.EvmKey).Get(counterKey)).To(BeNil())
+		})
+
+		Context("with the bank precompile", func() {
+			BeforeEach(func() {
+				withBank = true
+			})
+
+			AfterEach(func() {
+				withBank = false
+			})
+
+			It("should process the transactions one by one if a precompile reads the coinbase", func() {
+				keys := make([]*ecdsa.PrivateKey, 4)
+				for i := range keys {
+					keys[i], _ = crypto.GenerateEthKey()
+				}
+				bankABI := abi.MustUnmarshalJSON(bankbindings.BankModuleMetaData.ABI)
+				bankAddr := cosmlib.AccAddressToEthAddress(authtypes.NewModuleAddress(banktypes.ModuleName))
+				coinbase := common.BytesToAddress(valAddr)
+
+				// deliver a block where every sender sends ether to a new account and reads the bank
+				// balance of the coinbase, on a new keeper. It returns the receipts of the block,
+				// without its hash, and whether the transactions were executed in parallel.
+				deliverBlock := func(workers int) ([]byte, bool) {
+					setup()
+					ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+					sp := k.GetHost().GetStatePlugin()
+					sp.Reset(ctx)
+					for _, key := range keys {
+						sender := crypto.PubkeyToAddress(key.PublicKey)
+						sp.CreateAccount(sender)
+						sp.AddBalance(sender, big.NewInt(1000000000000000000))
+					}
+					sp.Finalize()
+					Expect(k.EndBlock(ctx)).To(Succeed())
+
+					input, err := bankABI.Pack("getBalance", coinbase, "abera")
+					Expect(err).ToNot(HaveOccurred())
+					var txs coretypes.Transactions
+					for i, key := range keys {
+						to := common.Address{0x30, byte(i)}
+						txs = append(txs,
+							coretypes.MustSignNewTx(key, signer, &coretypes.LegacyTx{
+								Nonce:    0,
+								To:       &to,
+								Value:    big.NewInt(1000),
+								Gas:      21000,
+								GasPrice: big.NewInt(10000000000),
+							}),
+							coretypes.MustSignNewTx(key, signer, &coretypes.LegacyTx{
+								Nonce:    1,
+								To:       &bankAddr,
+								Gas:      100000,
+								GasPrice: big.NewInt(10000000000),
+								Data:     input,
+							}),
+						)
+					}
+
+					// hand the transactions of block 2 to the keeper, whose begin blocker executes them.
+					blockTxs := make([][]byte, len(txs))
+					sdkTxs := make(map[string]sdk.Tx, len(txs))
+					for i, tx := range txs {
+						blockTxs[i], err = tx.MarshalBinary()
+						Expect(err).ToNot(HaveOccurred())
+						txBuilder := testutil.GetEncodingConfig().TxConfig.NewTxBuilder()
+						Expect(txBuilder.SetMsgs(types.NewFromTransaction(tx))).To(Succeed())
+						sdkTxs[string(blockTxs[i])] = txBuilder.GetTx()
+					}
+					k.SetParallelWorkers(workers)
+					k.SetTxHandlers(
+						func(txBytes []byte) (sdk.Tx, error) { return sdkTxs[string(txBytes)], nil },
+						func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil },
+					)
+					k.SetFinalizeBlockRequest(&abci.RequestFinalizeBlock{Txs: blockTxs, Height: 2})
+					ctx = ctx.WithBlockHeight(2).WithEventManager(sdk.NewEventManager())
+					Expect(k.BeginBlocker(ctx)).To(Succeed())
+					executed := k.IsExecuted(ctx, txs[0].Hash())
+
+					// deliver the transactions of block 2.
+					for _, tx := range txs {
+						txCtx := ctx.WithGasMeter(storetypes.NewGasMeter(tx.Gas()))
+						result, err := k.EthTransaction(txCtx, types.NewFromTransaction(tx))
+						Expect(err).ToNot(HaveOccurred())
+						Expect(result.VmError).To(BeEmpty())
+					}
+					Expect(k.EndBlock(ctx)).To(Succeed())
+
+					block, err := k.GetHost().GetHistoricalPlugin().GetBlockByNumber(2)
+					Expect(err).ToNot(HaveOccurred())
+					receipts, err := k.GetHost().GetHistoricalPlugin().GetReceiptsByHash(block.Hash())
+					Expect(err).ToNot(HaveOccurred())
+					Expect(receipts).To(HaveLen(len(txs)))
+					for _, receipt := range receipts {
+						receipt.BlockHash = common.Hash{}
+					}
+					bz, err := json.Marshal(receipts)
+					Expect(err).ToNot(HaveOccurred())
+					return bz, executed
+				}
+
+				receipts, _ := deliverBlock(0)
+				parallelReceipts, executed := deliverBlock(4)
+				Expect(executed).To(BeFalse())
+				Expect(parallelReceipts).To(Equal(receipts))
+			})
+		})
+	})
+
+	Context("Block randomness", func() {
+		It("should set the randomness of the finalized block as the mix hash", func() {
+			Expect(k.EndBlock(ctx)).To(Succeed())
+
+			// finalize block 2 with the request sent by CometBFT.
+			lastCommit := abci.CommitInfo{Votes: []abci.VoteInfo{{
+				Validator:   abci.Validator{Address: valAddr, Power: 1},
+				BlockIdFlag: cmtproto.BlockIDFlagCommit,
+			}}}
+			k.SetFinalizeBlockRequest(&abci.RequestFinalizeBlock{
+				Height: 2, Hash: []byte{0x2}, DecidedLastCommit: lastCommit,
+			})
+			ctx = ctx.WithBlockHeight(2)
+			Expect(k.BeginBlocker(ctx)).To(Succeed())
+			Expect(k.EndBlock(ctx)).To(Succeed())
+
+			b, err := k.GetHost().GetHistoricalPlugin().GetBlockByNumber(2)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(b.MixDigest()).To(Equal(block.LastCommitRandomness(
+				ctx.WithHeaderHash([]byte{0x2}).WithVoteInfos(lastCommit.Votes),
+			)))
+			// the randomness is not only derived from the height.
+			Expect(b.MixDigest()).ToNot(Equal(block.LastCommitRandomness(ctx)))
+		})
+	})
+
+	Context("Jinx queries", func() {
+		BeforeEach(func() {
+			qc = func(int64, bool) (sdk.Context, error) { return ctx, nil }
+			setup()
+		})
+
+		AfterEach(func() {
+			qc = nil
+		})
+
+		It("should answer queries about the host chain", func() {
+			jb, ok := k.GetHost().(jinxapi.JinxBackend)
+			Expect(ok).To(BeTrue())
+
+			addr := common.Address{0x1}
+			bech32 := jb.Bech32Address(addr)
+			Expect(bech32).To(Equal(cosmlib.Bech32FromEthAddress(addr)))
+			hexAddr, err := jb.HexAddress(bech32)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(hexAddr).To(Equal(addr))
+			_, err = jb.HexAddress("not an address")
+			Expect(err).To(HaveOccurred())
+
+			Expect(jb.IsPrecompile(sc.RegistryKey())).To(BeTrue())
+			Expect(jb.IsPrecompile(addr)).To(BeFalse())
+
+			cosmosTx, err := jb.GetCosmosTransaction(common.Hash{0x1})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cosmosTx).To(BeNil())
+
+			_, err = jb.GetDenomForToken(addr)
+			Expect(err).To(MatchError(keeper.ErrNoERC20Keeper))
+		})
+
+		Context("with the ERC20 keeper", func() {
+			var (
+				ek *erc20keeper.Keeper
+				jb jinxapi.JinxBackend
+			)
+
+			BeforeEach(func() {
+				ek = erc20keeper.NewKeeper(
+					storetypes.NewKVStoreKey("erc20"), nil, authtypes.NewModuleAddress(govtypes.ModuleName),
+				)
+				k.SetERC20Keeper(ek)
+				jb = utils.MustGetAs[jinxapi.JinxBackend](k.GetHost())
+			})
+
+			It("should serve the Cosmos transactions of delivered Ethereum transactions", func() {
+				sender := crypto.PubkeyToAddress(key.PublicKey)
+				sp := k.GetHost().GetStatePlugin()
+				sp.Reset(ctx)
+				sp.CreateAccount(sender)
+				sp.AddBalance(sender, big.NewInt(1000000000000000000))
+				sp.Finalize()
+
+				// deliver a Cosmos transaction applying an Ethereum transaction, which is recorded by
+				// the post handler once the Cosmos transaction has succeeded.
+				deliver := func(nonce uint64, txBytes []byte, success bool) *coretypes.Transaction {
+					to := common.Address{0x30}
+					tx := coretypes.MustSignNewTx(key, signer, &coretypes.LegacyTx{
+						Nonce:    nonce,
+						To:       &to,
+						Value:    big.NewInt(1000),
+						Gas:      21000,
+						GasPrice: big.NewInt(10000000000),
+					})
+					txCtx := ctx.WithGasMeter(storetypes.NewGasMeter(tx.Gas())).WithTxBytes(txBytes)
+					msg := types.NewFromTransaction(tx)
+					_, err := k.EthTransaction(txCtx, msg)
+					Expect(err).ToNot(HaveOccurred())
+
+					txBuilder := testutil.GetEncodingConfig().TxConfig.NewTxBuilder()
+					Expect(txBuilder.SetMsgs(msg)).To(Succeed())
+					_, err = k.PostHandler()(txCtx, txBuilder.GetTx(), false, success)
+					Expect(err).ToNot(HaveOccurred())
+					return tx
+				}
+				tx := deliver(0, []byte("cosmos tx"), true)
+				failedTx := deliver(1, []byte("failed cosmos tx"), false)
+				Expect(k.EndBlock(ctx)).To(Succeed())
+
+				cosmosTx, err := jb.GetCosmosTransaction(tx.Hash())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(cosmosTx).ToNot(BeNil())
+				Expect(cosmosTx.Hash).To(Equal(fmt.Sprintf("%X", tmhash.Sum([]byte("cosmos tx")))))
+				Expect(cosmosTx.Height).To(BeEquivalentTo(1))
+
+				cosmosTx, err = jb.GetCosmosTransaction(failedTx.Hash())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(cosmosTx).To(BeNil())
+			})
+
+			It("should serve the ERC20 token <> SDK coin pairs", func() {
+				token := common.BytesToAddress([]byte("USDC"))
+				denom := ek.RegisterERC20CoinPair(ctx, token)
+				coinToken := common.BytesToAddress([]byte("stake"))
+				ek.RegisterCoinERC20Pair(ctx, "stake", coinToken)
+
+				gotDenom, err := jb.GetDenomForToken(token)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(gotDenom).To(Equal(denom))
+				gotToken, err := jb.GetTokenForDenom(denom)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(gotToken).To(Equal(token))
+
+				gotDenom, err = jb.GetDenomForToken(coinToken)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(gotDenom).To(Equal("stake"))
+				gotToken, err = jb.GetTokenForDenom("stake")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(gotToken).To(Equal(coinToken))
+
+				gotDenom, err = jb.GetDenomForToken(common.Address{0x1})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(gotDenom).To(BeEmpty())
+				gotToken, err = jb.GetTokenForDenom("unknown")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(gotToken).To(Equal(common.Address{}))
+			})
+		})
+
+		It("should describe the stateful precompiles with their ABI", func() {
+			resp, err := k.Precompiles(ctx, &types.PrecompilesRequest{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Precompiles).To(HaveLen(1))
+			Expect(resp.Precompiles[0].Address).To(Equal(sc.RegistryKey().Hex()))
+			Expect(resp.Precompiles[0].Name).To(Equal("staking"))
+			Expect(resp.Precompiles[0].MethodSelectors).To(HaveLen(len(sc.ABIMethods())))
+			Expect(resp.Precompiles[0].EventTopics).To(HaveLen(len(sc.ABIEvents())))
+
+			jb := utils.MustGetAs[jinxapi.JinxBackend](k.GetHost())
+			info, err := jb.GetPrecompile(sc.RegistryKey())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(info.Name).To(Equal("staking"))
+			Expect(string(info.ABI)).To(Equal(resp.Precompiles[0].Abi))
+			Expect(abi.MustUnmarshalJSON(string(info.ABI)).Methods).To(HaveLen(len(sc.ABIMethods())))
+
+			info, err = jb.GetPrecompile(common.Address{0x1})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(info).To(BeNil())
+		})
+	})
+
+	Context("Finality", func() {
+		var header1 *coretypes.Header
+
+		// withVoteExtensions enables vote extensions from the given height.
+		withVoteExtensions := func(ctx sdk.Context, height int64) sdk.Context {
+			return ctx.WithConsensusParams(cmtproto.ConsensusParams{
+				Abci: &cmtproto.ABCIParams{VoteExtensionsEnableHeight: height},
+			})
+		}
+
+		BeforeEach(func() {
+			qc = func(int64, bool) (sdk.Context, error) { return ctx, nil }
+			setup()
+
+			// block 1 is committed without vote extensions.
+			Expect(k.EndBlock(ctx)).To(Succeed())
+			ctx = ctx.WithBlockHeight(2)
+			Expect(k.BeginBlocker(ctx)).To(Succeed())
+			var err error
+			header1, err = k.GetHost().GetBlockPlugin().GetHeaderByNumber(1)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			Expect(k.EndBlock(ctx)).To(Succeed())
+			qc = nil
+		})
+
+		It("should extend and verify votes with the hash of the previous block", func() {
+			resp, err := k.ExtendVoteHandler()(ctx, &abci.RequestExtendVote{Height: 2})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.VoteExtension).To(Equal(header1.Hash().Bytes()))
+
+			verify := func(ext []byte) abci.ResponseVerifyVoteExtension_VerifyStatus {
+				resp, err := k.VerifyVoteExtensionHandler()(
+					ctx, &abci.RequestVerifyVoteExtension{Height: 2, VoteExtension: ext},
+				)
+				Expect(err).ToNot(HaveOccurred())
+				return resp.Status
+			}
+			Expect(verify(header1.Hash().Bytes())).To(Equal(abci.ResponseVerifyVoteExtension_ACCEPT))
+			// an empty extension abstains, as sent when the extension fails.
+			Expect(verify(nil)).To(Equal(abci.ResponseVerifyVoteExtension_ACCEPT))
+			Expect(verify(common.Hash{0x1}.Bytes())).To(Equal(abci.ResponseVerifyVoteExtension_REJECT))
+			Expect(verify([]byte{0x1})).To(Equal(abci.ResponseVerifyVoteExtension_REJECT))
+		})
+
+		It("should record the block being finalized as safe without vote extensions", func() {
+			safe, ok := utils.MustGetAs[core.FinalityHost](k.GetHost()).SafeBlockNumber()
+			Expect(ok).To(BeTrue())
+			Expect(safe).To(Equal(uint64(2)))
+		})
+
+		It("should record the block attested by a quorum of the last commit as safe", func() {
+			safe := func() uint64 {
+				safe, ok := utils.MustGetAs[core.FinalityHost](k.GetHost()).SafeBlockNumber()
+				Expect(ok).To(BeTrue())
+				return safe
+			}
+			// finalize a block of the given height, starting with the extended last commit,
+			// attesting to the block before the last height if attested.
+			finalize := func(height int64, attested bool) {
+				Expect(k.EndBlock(ctx)).To(Succeed())
+				ctx = withVoteExtensions(ctx.WithBlockHeight(height), 3)
+				header, err := k.GetHost().GetBlockPlugin().GetHeaderByNumber(uint64(height - 2))
+				Expect(err).ToNot(HaveOccurred())
+				vote := abci.ExtendedVoteInfo{
+					Validator:   abci.Validator{Address: []byte{0x1}, Power: 1},
+					BlockIdFlag: cmtproto.BlockIDFlagCommit,
+				}
+				if attested {
+					vote.VoteExtension = header.Hash().Bytes()
+				}
+				extCommit := abci.ExtendedCommitInfo{Votes: []abci.ExtendedVoteInfo{vote}}
+				bz, err := extCommit.Marshal()
+				Expect(err).ToNot(HaveOccurred())
+				req := &abci.RequestFinalizeBlock{
+					Height: height, Txs: [][]byte{append([]byte("jinx/extended_commit/"), bz...)},
+				}
+
+				txs, extCommitBz := keeper.SplitExtendedCommit(req.Txs)
+				Expect(txs).To(BeEmpty())
+				Expect(extCommitBz).To(Equal(bz))
+				k.SetFinalizeBlockRequest(req)
+				Expect(k.BeginBlocker(ctx)).To(Succeed())
+			}
+
+			// the last commit of block 3 carries no extensions.
+			finalize(3, true)
+			Expect(safe()).To(Equal(uint64(3)))
+			// the safe block does not go back once the extensions attest to the block 2.
+			finalize(4, true)
+			Expect(safe()).To(Equal(uint64(3)))
+			finalize(5, true)
+			Expect(safe()).To(Equal(uint64(3)))
+			finalize(6, true)
+			Expect(safe()).To(Equal(uint64(4)))
+			finalize(7, false)
+			Expect(safe()).To(Equal(uint64(4)))
+		})
+
+		It("should put the extended last commit at the start of proposals", func() {
+			next := func(
+				_ sdk.Context, req *abci.RequestPrepareProposal,
+			) (*abci.ResponsePrepareProposal, error) {
+				Expect(req.MaxTxBytes).To(BeNumerically("<=", 1000))
+				return &abci.ResponsePrepareProposal{Txs: req.Txs}, nil
+			}
+			extCommit := abci.ExtendedCommitInfo{Votes: []abci.ExtendedVoteInfo{{
+				Validator:     abci.Validator{Address: []byte{0x1}, Power: 1},
+				VoteExtension: header1.Hash().Bytes(),
+				BlockIdFlag:   cmtproto.BlockIDFlagCommit,
+			}}}
+			extCommitBz, err := extCommit.Marshal()
+			Expect(err).ToNot(HaveOccurred())
+			req := &abci.RequestPrepareProposal{
+				Height: 3, MaxTxBytes: 1000, Txs: [][]byte{{0x1}}, LocalLastCommit: extCommit,
+			}
+
+			// the last commit carries no extensions before the enable height.
+			resp, err := k.PrepareProposalHandler(next)(withVoteExtensions(ctx, 3), req)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Txs).To(Equal([][]byte{{0x1}}))
+
+			resp, err = k.PrepareProposalHandler(next)(withVoteExtensions(ctx, 2), req)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Txs).To(Equal([][]byte{
+				append([]byte("jinx/extended_commit/"), extCommitBz...), {0x1},
+			}))
+		})
+
+		It("should reject proposals without an extended last commit", func() {
+			accept := func(
+				sdk.Context, *abci.RequestProcessProposal,
+			) (*abci.ResponseProcessProposal, error) {
+				return &abci.ResponseProcessProposal{
+					Status: abci.ResponseProcessProposal_ACCEPT,
+				}, nil
+			}
+			process := func(ctx sdk.Context, txs [][]byte) abci.ResponseProcessProposal_ProposalStatus {
+				resp, err := k.ProcessProposalHandler(nil, accept)(
+					ctx, &abci.RequestProcessProposal{Height: 3, Txs: txs},
+				)
+				Expect(err).ToNot(HaveOccurred())
+				return resp.Status
+			}
+
+			Expect(process(withVoteExtensions(ctx, 3), nil)).
+				To(Equal(abci.ResponseProcessProposal_ACCEPT))
+			Expect(process(withVoteExtensions(ctx, 2), nil)).
+				To(Equal(abci.ResponseProcessProposal_REJECT))
+			Expect(process(withVoteExtensions(ctx, 2), [][]byte{[]byte("not a commit")})).
+				To(Equal(abci.ResponseProcessProposal_REJECT))
+			// an extended commit is rejected before vote extensions are enabled.
+			Expect(process(withVoteExtensions(ctx, 3), [][]byte{[]byte("jinx/extended_commit/")})).
+				To(Equal(abci.ResponseProcessProposal_REJECT))
+		})
 	})
 })
+
+// mockEvmHooks records the receipts it is called with and returns err.
+type mockEvmHooks struct {
+	receipts []*coretypes.Receipt
+	err      error
+}
+
+func (h *mockEvmHooks) PostTxProcessing(
+	_ context.Context, _ *coretypes.Transaction, receipt *coretypes.Receipt,
+) error {
+	h.receipts = append(h.receipts, receipt)
+	return h.err
+}
+
+// txEventType is the type of the Cosmos events emitted by txEventHooks.
+const txEventType = "evm_tx"
+
+// txEventHooks emits a Cosmos event with the hash of every successful Ethereum transaction.
+type txEventHooks struct{}
+
+func (txEventHooks) PostTxProcessing(
+	ctx context.Context, tx *coretypes.Transaction, _ *coretypes.Receipt,
+) error {
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(txEventType, sdk.NewAttribute("hash", tx.Hash().Hex())),
+	)
+	return nil
+}
+
+// mockCircuitBreaker disallows the messages of a type.
+type mockCircuitBreaker struct {
+	disallowed string
+}
+
+func (cb *mockCircuitBreaker) IsAllowed(_ context.Context, typeURL string) (bool, error) {
+	return typeURL != cb.disallowed, nil
+}
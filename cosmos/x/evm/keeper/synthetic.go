@@ -0,0 +1,240 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Blackchain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/cometbft/cometbft/crypto/tmhash"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	"pkg.berachain.dev/jinx/cosmos/x/evm/types"
+	coretypes "pkg.berachain.dev/jinx/eth/core/types"
+)
+
+const (
+	// beginBlockSource is the source of the synthetic logs of the events emitted by the begin
+	// blockers that run before the EVM module.
+	beginBlockSource = "begin_block"
+	// endBlockSource is the source of the synthetic logs of the events emitted by the end
+	// blockers that run before the EVM module.
+	endBlockSource = "end_block"
+	// cosmosTxSourcePrefix prefixes the hash of a Cosmos transaction in the source of the
+	// synthetic logs of the events emitted by its messages.
+	cosmosTxSourcePrefix = "cosmos_tx/"
+)
+
+// EnableSyntheticReceipts opts into translating the Cosmos events of the given types, emitted by
+// the block hooks of the modules that run before the EVM module and by the messages of Cosmos
+// transactions, into Ethereum logs. The logs are attached to the EVM block in synthetic receipts,
+// so that EVM indexers observe the balance changes made outside of EVM transactions. The events
+// of Cosmos transactions are only translated if the `MsgEventCollector` is set as the circuit
+// breaker of the message router, wrapping the one of the app if any, and the `PostHandler` is set
+// on the app.
+func (k *Keeper) EnableSyntheticReceipts(eventTypes ...string) {
+	k.syntheticEventTypes = make(map[string]struct{}, len(eventTypes))
+	for _, eventType := range eventTypes {
+		k.syntheticEventTypes[eventType] = struct{}{}
+	}
+}
+
+// PostHandler returns the post handler that records a successful Cosmos transaction as the one
+// that included the Ethereum transactions applied by its messages, and attaches the registered
+// events, emitted by its messages, to the EVM block in a synthetic receipt. The transactions with
+// EVM messages are not attached, as they already have receipts.
+func (k *Keeper) PostHandler() sdk.PostHandler {
+	return func(ctx sdk.Context, tx sdk.Tx, simulate, success bool) (sdk.Context, error) {
+		events, ok := k.msgEvents.take(ctx.TxBytes(), tx.GetMsgs())
+		if !success || simulate || ctx.IsCheckTx() {
+			k.ethTxs.take(ctx.TxBytes())
+			return ctx, nil
+		}
+		k.recordCosmosTx(ctx)
+		if len(k.syntheticEventTypes) == 0 {
+			return ctx, nil
+		}
+		for _, msg := range tx.GetMsgs() {
+			switch msg.(type) {
+			case *types.WrappedEthereumTransaction, *types.MsgEthereumCall, *types.MsgEthereumCreate:
+				return ctx, nil
+			}
+		}
+
+		source := fmt.Sprintf("%s%X", cosmosTxSourcePrefix, tmhash.Sum(ctx.TxBytes()))
+		if !ok {
+			// The messages executed by the messages of the transaction are not known, so the
+			// events of its messages cannot be told apart from the ones of the executed messages.
+			k.Logger(ctx).Error("failed to attribute the events of Cosmos tx", "source", source)
+			return ctx, nil
+		}
+		if len(events) > 0 {
+			k.processSyntheticEvents(ctx, source, events)
+		}
+		return ctx, nil
+	}
+}
+
+// CircuitBreaker is the circuit breaker of the message router of the app, which decides whether a
+// message is allowed to execute.
+type CircuitBreaker interface {
+	IsAllowed(ctx context.Context, typeURL string) (bool, error)
+}
+
+// MsgEventCollector returns the collector of the event managers of the messages of Cosmos
+// transactions, which must be set as the circuit breaker of the message router. It defers to the
+// given circuit breaker of the app, if not nil, so that the app keeps its circuit breaker.
+func (k *Keeper) MsgEventCollector(breaker CircuitBreaker) *MsgEventCollector {
+	k.msgEvents.breaker = breaker
+	return &k.msgEvents
+}
+
+// MsgEventCollector collects the event managers of the messages of the Cosmos transaction being
+// delivered. The message router hands the context of every message, with the event manager of the
+// message, to its circuit breaker before executing the message, which is the only hook into the
+// events of the messages before they are merged into the result of the transaction. It allows
+// the messages that the wrapped circuit breaker allows, or every message without one.
+type MsgEventCollector struct {
+	// breaker is the circuit breaker of the app, if any.
+	breaker CircuitBreaker
+
+	mu sync.Mutex
+	// txBytes are the bytes of the transaction of the collected messages.
+	txBytes []byte
+	// msgs are the collected messages, in the order of execution.
+	msgs []collectedMsg
+}
+
+// collectedMsg is the event manager of an executed message, along with its type URL.
+type collectedMsg struct {
+	typeURL string
+	em      *sdk.EventManager
+}
+
+// IsAllowed implements `baseapp.CircuitBreaker`. It collects the event manager of the message if
+// the circuit breaker of the app allows it.
+func (c *MsgEventCollector) IsAllowed(ctx context.Context, typeURL string) (bool, error) {
+	if c.breaker != nil {
+		if allowed, err := c.breaker.IsAllowed(ctx, typeURL); !allowed || err != nil {
+			return allowed, err
+		}
+	}
+
+	sCtx := sdk.UnwrapSDKContext(ctx)
+	if sCtx.IsCheckTx() {
+		return true, nil
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !bytes.Equal(c.txBytes, sCtx.TxBytes()) {
+		c.txBytes, c.msgs = sCtx.TxBytes(), nil
+	}
+	c.msgs = append(c.msgs, collectedMsg{typeURL, sCtx.EventManager()})
+	return true, nil
+}
+
+// take returns the events of the given messages of the transaction with the given bytes, in
+// order, and resets the collector. The router executes the messages executed by a message (e.g.
+// by `authz.MsgExec`) right after it, and their events are emitted again by the executing
+// message, so they are skipped. It returns false if the collected messages are not the given
+// messages and the ones they are known to execute, in order.
+func (c *MsgEventCollector) take(txBytes []byte, msgs []sdk.Msg) (sdk.Events, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	collectedTxBytes, collected := c.txBytes, c.msgs
+	c.txBytes, c.msgs = nil, nil
+	if !bytes.Equal(txBytes, collectedTxBytes) {
+		collected = nil
+	}
+
+	var events sdk.Events
+	for _, msg := range msgs {
+		executed, err := appendExecutedMsgs(nil, msg)
+		if err != nil || len(executed) > len(collected) {
+			return nil, false
+		}
+		for i, typeURL := range executed {
+			if collected[i].typeURL != typeURL {
+				return nil, false
+			}
+		}
+		events = append(events, collected[0].em.Events()...)
+		collected = collected[len(executed):]
+	}
+	return events, len(collected) == 0
+}
+
+// appendExecutedMsgs appends the type URLs of the given message and of the messages it executes
+// to the given type URLs, in the order the message router executes them.
+func appendExecutedMsgs(typeURLs []string, msg sdk.Msg) ([]string, error) {
+	typeURLs = append(typeURLs, sdk.MsgTypeURL(msg))
+	exec, ok := msg.(*authz.MsgExec)
+	if !ok {
+		return typeURLs, nil
+	}
+
+	nested, err := exec.GetMessages()
+	if err != nil {
+		return nil, err
+	}
+	for _, nestedMsg := range nested {
+		if typeURLs, err = appendExecutedMsgs(typeURLs, nestedMsg); err != nil {
+			return nil, err
+		}
+	}
+	return typeURLs, nil
+}
+
+// processSyntheticEvents translates the given events of the synthetic event types into Ethereum
+// logs and adds them to the current EVM block in a synthetic receipt from the given source.
+func (k *Keeper) processSyntheticEvents(ctx context.Context, source string, events sdk.Events) {
+	if len(k.syntheticEventTypes) == 0 {
+		return
+	}
+
+	sCtx := sdk.UnwrapSDKContext(ctx)
+	plf := k.host.GetPrecompileLogFactory()
+	logs := make([]*coretypes.Log, 0, len(events))
+	for i := range events {
+		if _, ok := k.syntheticEventTypes[events[i].Type]; !ok {
+			continue
+		}
+
+		// Events that cannot be translated are skipped, which is deterministic as it only depends
+		// on the registered Ethereum events and the attributes of the Cosmos event.
+		log, err := plf.Build(&events[i])
+		if err != nil {
+			k.Logger(sCtx).Error(
+				"failed to translate Cosmos event to synthetic log",
+				"source", source, "type", events[i].Type, "err", err,
+			)
+			continue
+		}
+		logs = append(logs, log)
+	}
+
+	k.jinx.ProcessSyntheticLogs(ctx, []byte(source), logs)
+}
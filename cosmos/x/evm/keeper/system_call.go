@@ -0,0 +1,107 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Blackchain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"pkg.berachain.dev/jinx/cosmos/x/evm/plugins/gas"
+	"pkg.berachain.dev/jinx/cosmos/x/evm/types"
+	"pkg.berachain.dev/jinx/eth/accounts/abi"
+	"pkg.berachain.dev/jinx/eth/common"
+	"pkg.berachain.dev/jinx/eth/core"
+	errorslib "pkg.berachain.dev/jinx/lib/errors"
+)
+
+// SystemSender is the sender of the system calls, which is the address of the EVM module account.
+var SystemSender = common.BytesToAddress(authtypes.NewModuleAddress(types.ModuleName))
+
+// CallEVM calls the given method of the contract at the given address from the system sender, with
+// the given value and gas limit, on top of the state of the given context. The state changes are
+// only kept if commit is true, in which case the logs of the call are added to the current EVM
+// block. It can be called by other keepers and by block hooks, but not from within the EVM.
+func (k *Keeper) CallEVM(
+	ctx sdk.Context, contract abi.ABI, address common.Address, value *big.Int, gasLimit uint64,
+	commit bool, method string, args ...any,
+) (*core.ExecutionResult, error) {
+	data, err := contract.Pack(method, args...)
+	if err != nil {
+		return nil, errorslib.Wrapf(err, "failed to pack method %s", method)
+	}
+	return k.CallEVMWithData(ctx, address, data, value, gasLimit, commit)
+}
+
+// CallEVMWithData calls the contract at the given address with the given data from the system
+// sender. It returns an error, along with the execution result, if the call reverted.
+func (k *Keeper) CallEVMWithData(
+	ctx sdk.Context, address common.Address, data []byte, value *big.Int, gasLimit uint64,
+	commit bool,
+) (*core.ExecutionResult, error) {
+	result, _, err := k.systemCall(ctx, &address, data, value, gasLimit, commit)
+	return result, err
+}
+
+// DeployContract deploys the contract with the given bytecode and constructor arguments from the
+// system sender. It returns the address of the contract and an error, along with the execution
+// result, if the deployment reverted.
+func (k *Keeper) DeployContract(
+	ctx sdk.Context, contract abi.ABI, bytecode []byte, value *big.Int, gasLimit uint64,
+	commit bool, args ...any,
+) (common.Address, *core.ExecutionResult, error) {
+	input, err := contract.Pack("", args...)
+	if err != nil {
+		return common.Address{}, nil, errorslib.Wrap(err, "failed to pack constructor arguments")
+	}
+	code := append(append([]byte{}, bytecode...), input...)
+	result, contractAddr, err := k.systemCall(ctx, nil, code, value, gasLimit, commit)
+	return contractAddr, result, err
+}
+
+// systemCall executes the system call in the current EVM block, which is prepared if the begin
+// blocker of the EVM module has not run yet, and consumes its gas from the context.
+func (k *Keeper) systemCall(
+	ctx sdk.Context, to *common.Address, data []byte, value *big.Int, gasLimit uint64, commit bool,
+) (*core.ExecutionResult, common.Address, error) {
+	k.prepare(ctx)
+
+	if value == nil {
+		value = new(big.Int)
+	}
+	result, contractAddr, err := k.jinx.ProcessSystemCall(
+		ctx, SystemSender, to, data, value, gasLimit, commit,
+	)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	if err = gas.WrapMeter(ctx.GasMeter()).TryConsumeGas(
+		result.UsedGas, "evm system call",
+	); err != nil {
+		return nil, common.Address{}, errorslib.Wrap(err, "failed to consume gas of evm system call")
+	}
+	if result.Err != nil {
+		return result, contractAddr, errorslib.Wrap(result.Err, "evm system call failed")
+	}
+	return result, contractAddr, nil
+}
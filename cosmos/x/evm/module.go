@@ -27,6 +27,7 @@ import (
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc"
 
+	"cosmossdk.io/client/v2/autocli"
 	"cosmossdk.io/core/appmodule"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -35,17 +36,19 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 
+	"pkg.berachain.dev/jinx/cosmos/x/evm/client/cli"
 	"pkg.berachain.dev/jinx/cosmos/x/evm/keeper"
 	"pkg.berachain.dev/jinx/cosmos/x/evm/types"
 )
 
 // ConsensusVersion defines the current x/evm module consensus version.
-const ConsensusVersion = 1
+const ConsensusVersion = 2
 
 var (
 	_ appmodule.HasServices     = AppModule{}
 	_ appmodule.HasBeginBlocker = AppModule{}
 	_ appmodule.HasEndBlocker   = AppModule{}
+	_ autocli.HasAutoCLIConfig  = AppModule{}
 	_ module.AppModule          = AppModule{}
 	_ module.AppModuleBasic     = AppModuleBasic{}
 )
@@ -73,14 +76,21 @@ func (b AppModuleBasic) RegisterInterfaces(r cdctypes.InterfaceRegistry) {
 }
 
 // RegisterGRPCGatewayRoutes registers the gRPC Gateway routes for the evm module.
-func (AppModuleBasic) RegisterGRPCGatewayRoutes(_ client.Context, _ *gwruntime.ServeMux) {}
+func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *gwruntime.ServeMux) {
+	if err := types.RegisterQueryServiceHandlerClient(
+		context.Background(), mux, types.NewQueryServiceClient(clientCtx),
+	); err != nil {
+		panic(err)
+	}
+}
 
-// GetTxCmd returns no root tx command for the evm module.
+// GetTxCmd returns the root tx command for the evm module.
 func (AppModuleBasic) GetTxCmd() *cobra.Command {
-	return nil
+	return cli.GetTxCmd()
 }
 
-// GetQueryCmd returns the root query command for the evm module.
+// GetQueryCmd returns no root query command for the evm module, as the query commands are
+// generated by autocli.
 func (AppModuleBasic) GetQueryCmd() *cobra.Command {
 	return nil
 }
@@ -120,6 +130,14 @@ func (am AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
 // RegisterServices registers module services.
 func (am AppModule) RegisterServices(registrar grpc.ServiceRegistrar) error {
 	types.RegisterMsgServiceServer(registrar, am.keeper)
+	types.RegisterQueryServiceServer(registrar, am.keeper)
+
+	if cfg, ok := registrar.(module.Configurator); ok {
+		m := keeper.NewMigrator(am.keeper)
+		if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -139,7 +139,9 @@ func (p *plugin) readHeaderBytes(number uint64) ([]byte, error) {
 	// TODO: ensure we aren't differing from geth / hiding errors here.
 	// TODO: the GTE may be hiding a larger issue with the timing of the NewHead channel stuff.
 	// Investigate and hopefully remove this GTE.
-	if number > uint64(p.ctx.BlockHeight()) {
+	// The plugin is not prepared before the first block, e.g. while a state sync snapshot is
+	// restored, in which case the header is read from the query context as is.
+	if p.ctx.MultiStore() != nil && number > uint64(p.ctx.BlockHeight()) {
 		// cannot retrieve future block header
 		number = uint64(p.ctx.BlockHeight())
 	}
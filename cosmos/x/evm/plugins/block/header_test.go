@@ -94,6 +94,16 @@ var _ = Describe("Header", func() {
 		Expect(header3.Hash()).To(Equal(header.Hash()))
 	})
 
+	It("should read headers before being prepared", func() {
+		// e.g. while a state sync snapshot is restored.
+		p = utils.MustGetAs[*plugin](NewPlugin(testutil.EvmKey, nil))
+		p.SetQueryContextFn(mockQueryContext)
+
+		header, err := p.GetHeaderByNumber(10)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(header.Hash()).To(Equal(generateHeaderAtHeight(10).Hash()))
+	})
+
 	It("should be able to prune headers", func() {
 		toAdd := int64(prevHeaderHashes + 5)
 		var deletedHash common.Hash
@@ -41,6 +41,8 @@ type Plugin interface {
 
 	// SetQueryContextFn sets the function used for querying historical block headers.
 	SetQueryContextFn(fn func(height int64, prove bool) (sdk.Context, error))
+	// SetRandomnessSource sets the source of the randomness of new blocks.
+	SetRandomnessSource(rs RandomnessSource)
 }
 
 type plugin struct {
@@ -52,12 +54,15 @@ type plugin struct {
 	getQueryContext func(height int64, prove bool) (sdk.Context, error)
 	// sk represents the cosmos staking keeper.
 	sk StakingKeeper
+	// rs is the source of the randomness of new blocks.
+	rs RandomnessSource
 }
 
 func NewPlugin(storekey storetypes.StoreKey, sk StakingKeeper) Plugin {
 	return &plugin{
 		storekey: storekey,
 		sk:       sk,
+		rs:       LastCommitRandomness,
 	}
 }
 
@@ -72,8 +77,8 @@ func (p *plugin) BaseFee() *big.Int {
 }
 
 // GetNewBlockMetadata returns the host chain block metadata for the given block height. It returns
-// the coinbase address, the timestamp of the block.
-func (p *plugin) GetNewBlockMetadata(number uint64) (common.Address, uint64) {
+// the coinbase address, the timestamp and the randomness of the block.
+func (p *plugin) GetNewBlockMetadata(number uint64) (common.Address, uint64, common.Hash) {
 	cometHeader := p.ctx.BlockHeader()
 	if uint64(cometHeader.Height) != number {
 		panic(fmt.Errorf("block height mismatch. got: %d, expected %d", cometHeader.Height, number))
@@ -83,7 +88,7 @@ func (p *plugin) GetNewBlockMetadata(number uint64) (common.Address, uint64) {
 	if !found {
 		panic(fmt.Errorf("validator not found: %s", cometHeader.ProposerAddress))
 	}
-	return common.BytesToAddress(val.GetOperator()), uint64(cometHeader.Time.UTC().Unix())
+	return common.BytesToAddress(val.GetOperator()), uint64(cometHeader.Time.UTC().Unix()), p.rs(p.ctx)
 }
 
 func (p *plugin) IsPlugin() {}
@@ -0,0 +1,58 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Blackchain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package block
+
+import (
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"pkg.berachain.dev/jinx/eth/common"
+	"pkg.berachain.dev/jinx/eth/crypto"
+)
+
+// RandomnessSource returns the randomness of the block of the given context, which is set as the
+// mix hash of the Ethereum block and exposed to the EVM as PREVRANDAO. It must be deterministic
+// across validators. A VRF or vote extension based source can be plugged in with
+// SetRandomnessSource.
+type RandomnessSource func(ctx sdk.Context) common.Hash
+
+// LastCommitRandomness is the default randomness source. It hashes the hash of the block, which
+// commits to the precommit signatures of the validators on the previous block, together with the
+// decided last commit and the height of the block. Both are only sent by CometBFT in the request
+// of FinalizeBlock, which sets them on the context of the block. It can not be predicted before
+// the block is proposed, although the proposer has limited influence on it through the
+// transactions and the precommits it includes in the block.
+func LastCommitRandomness(ctx sdk.Context) common.Hash {
+	lastCommit := abci.CommitInfo{Votes: ctx.VoteInfos()}
+	bz, err := lastCommit.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	return crypto.Keccak256Hash(
+		ctx.HeaderHash(), bz, sdk.Uint64ToBigEndian(uint64(ctx.BlockHeight())),
+	)
+}
+
+// SetRandomnessSource sets the randomness source of the plugin.
+func (p *plugin) SetRandomnessSource(rs RandomnessSource) {
+	p.rs = rs
+}
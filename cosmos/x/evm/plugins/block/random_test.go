@@ -0,0 +1,66 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Blackchain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package block
+
+import (
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	testutil "pkg.berachain.dev/jinx/cosmos/testing/utils"
+	"pkg.berachain.dev/jinx/eth/common"
+	"pkg.berachain.dev/jinx/lib/utils"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Randomness", func() {
+	var ctx sdk.Context
+
+	BeforeEach(func() {
+		ctx = testutil.NewContext().WithBlockHeight(10).WithHeaderHash([]byte{0x1}).
+			WithVoteInfos([]abci.VoteInfo{{
+				Validator:   abci.Validator{Address: []byte{0x2}, Power: 1},
+				BlockIdFlag: cmtproto.BlockIDFlagCommit,
+			}})
+	})
+
+	It("should derive the randomness from the block hash and the last commit", func() {
+		random := LastCommitRandomness(ctx)
+		Expect(random).ToNot(Equal(common.Hash{}))
+		Expect(LastCommitRandomness(ctx)).To(Equal(random))
+
+		Expect(LastCommitRandomness(ctx.WithHeaderHash([]byte{0x3}))).ToNot(Equal(random))
+		Expect(LastCommitRandomness(ctx.WithVoteInfos(nil))).ToNot(Equal(random))
+		Expect(LastCommitRandomness(ctx.WithBlockHeight(11))).ToNot(Equal(random))
+	})
+
+	It("should use the configured randomness source", func() {
+		_, _, _, sk := testutil.SetupMinimalKeepers()
+		p := utils.MustGetAs[*plugin](NewPlugin(testutil.EvmKey, sk))
+		Expect(p.rs(ctx)).To(Equal(LastCommitRandomness(ctx)))
+
+		p.SetRandomnessSource(func(sdk.Context) common.Hash { return common.Hash{0x4} })
+		Expect(p.rs(ctx)).To(Equal(common.Hash{0x4}))
+	})
+})
@@ -46,6 +46,8 @@ type Plugin interface {
 type plugin struct {
 	storeKey    storetypes.StoreKey
 	paramsStore storetypes.KVStore
+	// height is the height of the current block, at which the chain config may be changed.
+	height int64
 }
 
 // NewPlugin returns a new plugin instance.
@@ -59,6 +61,7 @@ func NewPlugin(storeKey storetypes.StoreKey) Plugin {
 func (p *plugin) Prepare(ctx context.Context) {
 	sCtx := sdk.UnwrapSDKContext(ctx)
 	p.paramsStore = sCtx.KVStore(p.storeKey)
+	p.height = sCtx.BlockHeight()
 }
 
 // FeeCollector implements the core.ConfigurationPlugin interface.
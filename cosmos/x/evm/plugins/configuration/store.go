@@ -22,29 +22,105 @@ package configuration
 
 import (
 	"encoding/json"
+	"math"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"pkg.berachain.dev/jinx/cosmos/x/evm/types"
 	"pkg.berachain.dev/jinx/eth/params"
+	"pkg.berachain.dev/jinx/lib/errors"
 )
 
 // GetChainConfig is used to get the genesis info of the Ethereum chain.
 func (p *plugin) ChainConfig() *params.ChainConfig {
-	bz := p.paramsStore.Get([]byte{types.ChainConfigPrefix})
-	if bz == nil {
-		return nil
+	chainConfig, err := unmarshalChainConfig(p.paramsStore.Get([]byte{types.ChainConfigPrefix}))
+	if err != nil {
+		panic(err)
 	}
-	var chainConfig params.ChainConfig
-	if err := json.Unmarshal(bz, &chainConfig); err != nil {
+	return chainConfig
+}
+
+// ChainConfigAtHeight implements core.ConfigurationPlugin.
+func (p *plugin) ChainConfigAtHeight(number uint64) *params.ChainConfig {
+	chainConfig, err := ChainConfigAtHeight(p.paramsStore, number)
+	if err != nil {
 		panic(err)
 	}
-	return &chainConfig
+	return chainConfig
 }
 
 // GetEthGenesis is used to get the genesis info of the Ethereum chain.
 func (p *plugin) SetChainConfig(chainConfig *params.ChainConfig) {
+	SetChainConfig(p.paramsStore, p.height, chainConfig)
+}
+
+// SetChainConfig stores the given chain config as the current one in the given store, during the
+// block at the given height. It also records it as a new version of the chain config, which becomes
+// active at the next block, so that the blocks before it keep being executed under their own rules.
+// On a chain that has a chain config but no recorded versions yet, the current chain config is
+// first recorded as the version of the blocks before.
+func SetChainConfig(store storetypes.KVStore, height int64, chainConfig *params.ChainConfig) {
 	bz, err := json.Marshal(chainConfig)
 	if err != nil {
 		panic(err)
 	}
-	p.paramsStore.Set([]byte{types.ChainConfigPrefix}, bz)
+	if current := store.Get([]byte{types.ChainConfigPrefix}); current != nil &&
+		firstValue(prefix.NewStore(store, []byte{types.ChainConfigHistoryPrefix}).Iterator(nil, nil)) == nil {
+		store.Set(chainConfigVersionKey(0), current)
+	}
+	store.Set([]byte{types.ChainConfigPrefix}, bz)
+	store.Set(chainConfigVersionKey(uint64(height+1)), bz)
+}
+
+// ChainConfigAtHeight returns the chain config that was active at the given block number, as stored
+// in the given store. Blocks before the first version, i.e. the genesis one, are served the first
+// version. Chains that have no recorded versions are served the current chain config. It returns an
+// error if the stored chain config cannot be decoded.
+func ChainConfigAtHeight(store storetypes.KVStore, number uint64) (*params.ChainConfig, error) {
+	versions := prefix.NewStore(store, []byte{types.ChainConfigHistoryPrefix})
+
+	// The latest version that became active at or before the given block number.
+	var end []byte
+	if number < math.MaxUint64 {
+		end = sdk.Uint64ToBigEndian(number + 1)
+	}
+	if bz := firstValue(versions.ReverseIterator(nil, end)); bz != nil {
+		return unmarshalChainConfig(bz)
+	}
+
+	// The first version, if the block is older than all versions.
+	if bz := firstValue(versions.Iterator(nil, nil)); bz != nil {
+		return unmarshalChainConfig(bz)
+	}
+	return unmarshalChainConfig(store.Get([]byte{types.ChainConfigPrefix}))
+}
+
+// chainConfigVersionKey returns the key of the chain config version that becomes active at the
+// given block number.
+func chainConfigVersionKey(number uint64) []byte {
+	return append([]byte{types.ChainConfigHistoryPrefix}, sdk.Uint64ToBigEndian(number)...)
+}
+
+// firstValue returns the value of the first entry of the given iterator, or nil if it is empty.
+func firstValue(it storetypes.Iterator) []byte {
+	defer it.Close()
+	if !it.Valid() {
+		return nil
+	}
+	return it.Value()
+}
+
+// unmarshalChainConfig returns the chain config encoded in the given bytes, or nil if there are none.
+func unmarshalChainConfig(bz []byte) (*params.ChainConfig, error) {
+	if bz == nil {
+		return nil, nil //nolint:nilnil // no chain config is stored.
+	}
+	var chainConfig params.ChainConfig
+	if err := json.Unmarshal(bz, &chainConfig); err != nil {
+		return nil, errors.Wrap(err, "invalid chain config")
+	}
+	return &chainConfig, nil
 }
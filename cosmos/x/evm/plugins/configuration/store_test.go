@@ -20,21 +20,97 @@
 
 package configuration
 
-// . "github.com/onsi/ginkgo/v2"
-// . "github.com/onsi/gomega"
-
-// var _ = Describe("Plugin", func() {
-// 	var (
-// 	// p   *plugin
-// 	// ctx sdk.Context
-// 	)
-
-// 	BeforeEach(func() {
-// 		// ctx = testutil.NewContext()
-// 		// storeKey := storetypes.NewKVStoreKey("evm")
-// 		// // p = &plugin{
-// 		// 	storeKey:    storeKey,
-// 		// 	paramsStore: ctx.KVStore(storeKey),
-// 		// }
-// 	})
-// })
+import (
+	"encoding/json"
+	"math"
+	"math/big"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	testutil "pkg.berachain.dev/jinx/cosmos/testing/utils"
+	"pkg.berachain.dev/jinx/cosmos/x/evm/types"
+	"pkg.berachain.dev/jinx/eth/params"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Store", func() {
+	var (
+		p       *plugin
+		ctx     sdk.Context
+		genesis *params.ChainConfig
+		forked  *params.ChainConfig
+	)
+
+	BeforeEach(func() {
+		ctx = testutil.NewContext()
+		p = &plugin{storeKey: storetypes.NewKVStoreKey("evm")}
+
+		genesis = params.DefaultChainConfig
+		forkTime := uint64(100)
+		cfg := *params.DefaultChainConfig
+		cfg.ChainID = big.NewInt(1)
+		cfg.PragueTime = &forkTime
+		forked = &cfg
+	})
+
+	It("should return nil if no chain config is stored", func() {
+		p.Prepare(ctx)
+		Expect(p.ChainConfigAtHeight(10)).To(BeNil())
+	})
+
+	It("should serve the chain config that was active at each height", func() {
+		p.Prepare(ctx.WithBlockHeight(0))
+		p.SetChainConfig(genesis)
+
+		// fork at block 10, active from block 11
+		p.Prepare(ctx.WithBlockHeight(10))
+		p.SetChainConfig(forked)
+
+		Expect(p.ChainConfig().ChainID).To(Equal(forked.ChainID))
+		for _, number := range []uint64{0, 1, 10} {
+			Expect(p.ChainConfigAtHeight(number).ChainID).To(Equal(genesis.ChainID))
+			Expect(p.ChainConfigAtHeight(number).PragueTime).To(BeNil())
+		}
+		for _, number := range []uint64{11, math.MaxUint64} {
+			Expect(p.ChainConfigAtHeight(number).ChainID).To(Equal(forked.ChainID))
+			Expect(p.ChainConfigAtHeight(number).PragueTime).To(Equal(forked.PragueTime))
+		}
+	})
+
+	It("should fall back to the current chain config without versions", func() {
+		p.Prepare(ctx)
+		p.paramsStore.Set([]byte{types.ChainConfigPrefix}, []byte(`{"chainId":2061}`))
+		Expect(p.ChainConfigAtHeight(5)).To(Equal(p.ChainConfig()))
+		Expect(p.ChainConfigAtHeight(5).ChainID).To(Equal(big.NewInt(2061)))
+	})
+
+	It("should keep the chain config of the blocks of a chain without versions on upgrade", func() {
+		// a chain that stored its chain config before the versions were recorded.
+		p.Prepare(ctx.WithBlockHeight(20))
+		bz, err := json.Marshal(genesis)
+		Expect(err).ToNot(HaveOccurred())
+		p.paramsStore.Set([]byte{types.ChainConfigPrefix}, bz)
+
+		// upgrade at block 20, active from block 21
+		p.SetChainConfig(forked)
+		Expect(p.ChainConfig().ChainID).To(Equal(forked.ChainID))
+		for _, number := range []uint64{0, 1, 20} {
+			Expect(p.ChainConfigAtHeight(number).ChainID).To(Equal(genesis.ChainID))
+			Expect(p.ChainConfigAtHeight(number).PragueTime).To(BeNil())
+		}
+		Expect(p.ChainConfigAtHeight(21).ChainID).To(Equal(forked.ChainID))
+		Expect(p.ChainConfigAtHeight(21).PragueTime).To(Equal(forked.PragueTime))
+	})
+
+	It("should return an error for a corrupt chain config", func() {
+		p.Prepare(ctx)
+		p.paramsStore.Set([]byte{types.ChainConfigPrefix}, []byte(`{"chainId":`))
+		_, err := ChainConfigAtHeight(p.paramsStore, 5)
+		Expect(err).To(HaveOccurred())
+		Expect(func() { p.ChainConfigAtHeight(5) }).To(Panic())
+	})
+})
@@ -0,0 +1,130 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Blackchain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package gas
+
+import (
+	"errors"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"pkg.berachain.dev/jinx/eth/core"
+	"pkg.berachain.dev/jinx/eth/core/vm"
+)
+
+// Compile-time interface assertion.
+var _ Meter = (*meter)(nil)
+
+// ErrRefundExceedsConsumed is returned when more gas is refunded than was consumed.
+var ErrRefundExceedsConsumed = errors.New("refund exceeds gas consumed")
+
+// Meter is a Cosmos SDK `GasMeter` that never panics. `TryConsumeGas` and `TryRefundGas` report
+// out of gas and overflows as errors, without changing the gas consumed. Since the Cosmos SDK
+// stores consume gas with `ConsumeGas`, which cannot return an error, `ConsumeGas` consumes the
+// gas remaining instead and records the error, which is then returned by `Err`.
+type Meter interface {
+	storetypes.GasMeter
+	// TryConsumeGas consumes the given amount of gas. It returns `vm.ErrOutOfGas` if the limit
+	// would be exceeded and `core.ErrGasUintOverflow` if the gas consumed would overflow.
+	TryConsumeGas(amount uint64, descriptor string) error
+	// TryRefundGas refunds the given amount of gas. It returns an error if the amount is greater
+	// than the gas consumed.
+	TryRefundGas(amount uint64, descriptor string) error
+	// Err returns the first error recorded by `ConsumeGas` or `RefundGas`, if any.
+	Err() error
+}
+
+// meter wraps a Cosmos SDK `GasMeter`, so that the gas consumed through either is the same.
+type meter struct {
+	storetypes.GasMeter
+	err error
+}
+
+// NewMeter returns a new Meter with the given limit.
+func NewMeter(limit uint64) Meter {
+	return &meter{GasMeter: storetypes.NewGasMeter(limit)}
+}
+
+// WrapMeter returns a Meter that consumes gas from the given Cosmos SDK `GasMeter`. The given
+// meter is returned if it is already a Meter.
+func WrapMeter(gm storetypes.GasMeter) Meter {
+	if m, ok := gm.(Meter); ok {
+		return m
+	}
+	return &meter{GasMeter: gm}
+}
+
+// TryConsumeGas implements Meter.
+func (m *meter) TryConsumeGas(amount uint64, descriptor string) error {
+	if newConsumed, overflow := addUint64Overflow(m.GasConsumed(), amount); overflow {
+		return core.ErrGasUintOverflow
+	} else if newConsumed > m.Limit() {
+		return vm.ErrOutOfGas
+	}
+
+	m.GasMeter.ConsumeGas(amount, descriptor)
+	return nil
+}
+
+// TryRefundGas implements Meter.
+func (m *meter) TryRefundGas(amount uint64, descriptor string) error {
+	if amount > m.GasConsumed() {
+		return ErrRefundExceedsConsumed
+	}
+
+	m.GasMeter.RefundGas(amount, descriptor)
+	return nil
+}
+
+// ConsumeGas consumes the given amount of gas. If the limit would be exceeded, or the gas consumed
+// would overflow, it consumes the gas remaining instead and records the error.
+//
+// ConsumeGas implements storetypes.GasMeter.
+func (m *meter) ConsumeGas(amount uint64, descriptor string) {
+	if err := m.TryConsumeGas(amount, descriptor); err != nil {
+		m.recordErr(err)
+		if consumed, limit := m.GasConsumed(), m.Limit(); consumed < limit {
+			m.GasMeter.ConsumeGas(limit-consumed, descriptor)
+		}
+	}
+}
+
+// RefundGas refunds the given amount of gas. If the amount is greater than the gas consumed, it
+// refunds all the gas consumed instead and records the error.
+//
+// RefundGas implements storetypes.GasMeter.
+func (m *meter) RefundGas(amount uint64, descriptor string) {
+	if err := m.TryRefundGas(amount, descriptor); err != nil {
+		m.recordErr(err)
+		m.GasMeter.RefundGas(m.GasConsumed(), descriptor)
+	}
+}
+
+// Err implements Meter.
+func (m *meter) Err() error {
+	return m.err
+}
+
+// recordErr records the given error, unless an error has already been recorded.
+func (m *meter) recordErr(err error) {
+	if m.err == nil {
+		m.err = err
+	}
+}
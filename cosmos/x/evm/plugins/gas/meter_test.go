@@ -0,0 +1,88 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Blackchain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package gas_test
+
+import (
+	"math"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"pkg.berachain.dev/jinx/cosmos/x/evm/plugins/gas"
+	"pkg.berachain.dev/jinx/eth/core"
+	"pkg.berachain.dev/jinx/eth/core/vm"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Meter", func() {
+	var m gas.Meter
+
+	BeforeEach(func() {
+		m = gas.NewMeter(1000)
+	})
+
+	It("should consume and refund gas", func() {
+		Expect(m.TryConsumeGas(600, "")).To(Succeed())
+		Expect(m.TryRefundGas(100, "")).To(Succeed())
+		Expect(m.GasConsumed()).To(Equal(uint64(500)))
+		Expect(m.GasRemaining()).To(Equal(uint64(500)))
+		Expect(m.Err()).ToNot(HaveOccurred())
+	})
+
+	It("should return errors without consuming gas", func() {
+		Expect(m.TryConsumeGas(600, "")).To(Succeed())
+		Expect(m.TryConsumeGas(401, "")).To(MatchError(vm.ErrOutOfGas))
+		Expect(m.TryConsumeGas(math.MaxUint64, "")).To(MatchError(core.ErrGasUintOverflow))
+		Expect(m.TryRefundGas(601, "")).To(MatchError(gas.ErrRefundExceedsConsumed))
+		Expect(m.GasConsumed()).To(Equal(uint64(600)))
+		Expect(m.Err()).ToNot(HaveOccurred())
+	})
+
+	It("should record errors instead of panicking", func() {
+		Expect(func() { m.ConsumeGas(1001, "") }).ToNot(Panic())
+		Expect(m.IsOutOfGas()).To(BeTrue())
+		Expect(m.GasConsumed()).To(Equal(uint64(1000)))
+		Expect(m.Err()).To(MatchError(vm.ErrOutOfGas))
+
+		// only the first error is recorded
+		Expect(func() { m.RefundGas(1001, "") }).ToNot(Panic())
+		Expect(m.GasConsumed()).To(BeZero())
+		Expect(m.Err()).To(MatchError(vm.ErrOutOfGas))
+	})
+
+	It("should not panic on overflow of an infinite meter", func() {
+		m = gas.WrapMeter(storetypes.NewInfiniteGasMeter())
+		Expect(func() {
+			m.ConsumeGas(math.MaxUint64, "")
+			m.ConsumeGas(1, "")
+		}).ToNot(Panic())
+		Expect(m.Err()).To(MatchError(core.ErrGasUintOverflow))
+	})
+
+	It("should consume gas from the wrapped meter", func() {
+		gm := storetypes.NewGasMeter(1000)
+		m = gas.WrapMeter(gm)
+		Expect(gas.WrapMeter(m)).To(BeIdenticalTo(m))
+		Expect(m.TryConsumeGas(100, "")).To(Succeed())
+		Expect(gm.GasConsumed()).To(Equal(uint64(100)))
+	})
+})
@@ -43,9 +43,10 @@ type Plugin interface {
 }
 
 // plugin wraps a Cosmos context and utilize's the underlying `GasMeter` and `BlockGasMeter`
-// to implement the core.GasPlugin interface.
+// to implement the core.GasPlugin interface. The `GasMeter` is wrapped in a Meter, so that the
+// gas consumed by the EVM is the gas consumed by the Cosmos transaction.
 type plugin struct {
-	gasMeter        storetypes.GasMeter
+	gasMeter        Meter
 	blockGasMeter   storetypes.GasMeter
 	consensusMaxGas uint64
 }
@@ -78,21 +79,25 @@ func (p *plugin) BlockGasLimit() uint64 {
 	return p.consensusMaxGas
 }
 
-// TxConsumeGas implements the core.GasPlugin interface.
+// ConsumeGas consumes the given amount of gas from the transaction gas meter. It returns an error,
+// instead of panicking, if the gas consumed would overflow or exceed the transaction or block gas
+// limits.
+//
+// ConsumeGas implements the core.GasPlugin interface.
 func (p *plugin) ConsumeGas(amount uint64) error {
-	// We don't want to panic if we overflow so we do some safety checks.
-	// TODO: probably faster / cleaner to just wrap .ConsumeGas in a panic handler, or write our
-	// own custom gas meter that doesn't panic on overflow.
 	if newConsumed, overflow := addUint64Overflow(p.gasMeter.GasConsumed(), amount); overflow {
 		return core.ErrGasUintOverflow
 	} else if newConsumed > p.gasMeter.Limit() {
 		return vm.ErrOutOfGas
-	} else if p.blockGasMeter.GasConsumed()+newConsumed > p.blockGasMeter.Limit() {
+	} else if blockConsumed, overflow := addUint64Overflow(
+		p.blockGasMeter.GasConsumed(), newConsumed,
+	); overflow || blockConsumed > p.blockGasMeter.Limit() {
+		// The block gas meter is only consumed by the baseapp once the transaction is done, so
+		// the gas consumed by the transaction must fit in the gas remaining in the block.
 		return core.ErrBlockOutOfGas
 	}
 
-	p.gasMeter.ConsumeGas(amount, gasMeterDescriptor)
-	return nil
+	return p.gasMeter.TryConsumeGas(amount, gasMeterDescriptor)
 }
 
 // GasConsumed returns the gas used during the current transaction.
@@ -122,9 +127,10 @@ func addUint64Overflow(a, b uint64) (uint64, bool) {
 
 // if either of the gas meters on the sdk context are nil, this function will panic.
 func (p *plugin) resetMeters(ctx sdk.Context) {
-	if p.gasMeter = ctx.GasMeter(); p.gasMeter == nil {
+	if ctx.GasMeter() == nil {
 		panic("gas meter is nil")
 	}
+	p.gasMeter = WrapMeter(ctx.GasMeter())
 	if p.blockGasMeter = ctx.BlockGasMeter(); p.blockGasMeter == nil {
 		panic("block gas meter is nil")
 	}
@@ -53,7 +53,7 @@ var _ = Describe("plugin", func() {
 		p.Reset(testutil.NewContext().WithBlockGasMeter(blockGasMeter))
 
 		// tx 1
-		p.gasMeter = storetypes.NewGasMeter(1000)
+		p.gasMeter = NewMeter(1000)
 		err := p.ConsumeGas(500)
 		Expect(err).ToNot(HaveOccurred())
 		Expect(p.gasMeter.GasConsumed()).To(Equal(uint64(500)))
@@ -68,7 +68,7 @@ var _ = Describe("plugin", func() {
 		p.Reset(testutil.NewContext().WithBlockGasMeter(blockGasMeter))
 
 		// tx 2
-		p.gasMeter = storetypes.NewGasMeter(1000)
+		p.gasMeter = NewMeter(1000)
 		Expect(p.BlockGasConsumed()).To(Equal(uint64(250)))
 		err = p.ConsumeGas(1000)
 		Expect(err).ToNot(HaveOccurred())
@@ -80,7 +80,7 @@ var _ = Describe("plugin", func() {
 		p.Reset(testutil.NewContext().WithBlockGasMeter(blockGasMeter))
 
 		// tx 3
-		p.gasMeter = storetypes.NewGasMeter(1000)
+		p.gasMeter = NewMeter(1000)
 		Expect(p.BlockGasConsumed()).To(Equal(uint64(1250)))
 		err = p.ConsumeGas(250)
 		Expect(err).ToNot(HaveOccurred())
@@ -91,7 +91,7 @@ var _ = Describe("plugin", func() {
 	})
 
 	It("should error on overconsumption in tx", func() {
-		p.gasMeter = storetypes.NewGasMeter(1000)
+		p.gasMeter = NewMeter(1000)
 		p.blockGasMeter = storetypes.NewGasMeter(p.gasMeter.GasRemaining() * 2)
 		err := p.ConsumeGas(p.gasMeter.GasRemaining())
 		Expect(err).ToNot(HaveOccurred())
@@ -123,4 +123,12 @@ var _ = Describe("plugin", func() {
 		err = p.ConsumeGas(1000)
 		Expect(err.Error()).To(Equal("block is out of gas"))
 	})
+
+	It("should error on block gas uint64 overflow", func() {
+		p.blockGasMeter = storetypes.NewInfiniteGasMeter()
+		p.blockGasMeter.ConsumeGas(math.MaxUint64-10, "")
+		err := p.ConsumeGas(100)
+		Expect(err.Error()).To(Equal("block is out of gas"))
+		Expect(p.GasConsumed()).To(BeZero())
+	})
 })
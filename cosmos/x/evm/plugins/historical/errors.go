@@ -23,5 +23,6 @@ package historical
 import "errors"
 
 var (
-	ErrBlockNotFound = errors.New("block not found, is your node pruned?")
+	ErrBlockNotFound   = errors.New("block not found, is your node pruned?")
+	ErrInvalidSnapshot = errors.New("invalid historical snapshot")
 )
@@ -23,8 +23,6 @@ package historical
 import (
 	"fmt"
 
-	"cosmossdk.io/store/prefix"
-
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/ethereum/go-ethereum/rlp"
@@ -36,38 +34,58 @@ import (
 	errorslib "pkg.berachain.dev/jinx/lib/errors"
 )
 
-// TODO: WHO WROTE THIS CODE THE FIRST TIME BLS FIX IT IS HORRIBLE.
-
 // StoreBlock implements `core.HistoricalPlugin`.
 func (p *plugin) StoreBlock(block *coretypes.Block) error {
 	blockNum := block.NumberU64()
-
-	// store block hash to block number.
 	numBz := sdk.Uint64ToBigEndian(blockNum)
-	store := p.ctx.KVStore(p.storeKey)
 
-	// store block num to block
 	blockBz, err := rlp.EncodeToBytes(block)
 	if err != nil {
+		return errorslib.Wrapf(err, "failed to marshal block %d", blockNum)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	batch := p.db.NewBatch()
+	defer batch.Close()
+
+	// store block num to block.
+	if err = batch.Set(prefixed(types.BlockNumKeyToBlockPrefix, numBz), blockBz); err != nil {
 		return err
 	}
-	prefix.NewStore(store, []byte{types.BlockNumKeyToBlockPrefix}).Set(numBz, blockBz)
 
 	// store block hash to block number.
-	prefix.NewStore(store, []byte{types.BlockHashKeyToNumPrefix}).Set(block.Hash().Bytes(), numBz)
-
-	// store the version offchain for consistency.
-	offChainNum := sdk.BigEndianToUint64(store.Get([]byte{types.VersionKey}))
-	if blockNum > 0 && offChainNum != blockNum-1 {
-		panic(
-			fmt.Errorf(
-				"off-chain store's latest block number %d is not synced with previous block number %d",
-				offChainNum,
-				blockNum-1,
-			),
-		)
-	}
-	store.Set([]byte{types.VersionKey}, numBz)
+	if err = batch.Set(prefixed(types.BlockHashKeyToNumPrefix, block.Hash().Bytes()), numBz); err != nil {
+		return err
+	}
+
+	// The historical data is served from the earliest block onwards, so it must be contiguous. If
+	// the previous block was not stored, e.g. because the node was state synced, the historical
+	// data starts at this block.
+	latest, ok, err := p.latestBlockNumber()
+	if err != nil {
+		return err
+	}
+	resetEarliest := !ok || blockNum > latest+1 || blockNum < p.earliest.Load()
+	if resetEarliest {
+		if err = batch.Set([]byte{types.EarliestVersionKey}, numBz); err != nil {
+			return err
+		}
+	}
+
+	// store the latest block number.
+	if err = batch.Set([]byte{types.VersionKey}, numBz); err != nil {
+		return err
+	}
+	if err = batch.Write(); err != nil {
+		return err
+	}
+
+	if resetEarliest {
+		p.earliest.Store(blockNum)
+	}
+	p.notifyPruner()
 	return nil
 }
 
@@ -76,23 +94,24 @@ func (p *plugin) StoreReceipts(blockHash common.Hash, receipts coretypes.Receipt
 	// store block hash to receipts.
 	receiptsBz, err := coretypes.MarshalReceipts(receipts)
 	if err != nil {
-		p.ctx.Logger().Error(
-			"UpdateOffChainStorage: failed to marshal receipts at block hash %s", blockHash.Hex(),
-		)
-		return err
+		return errorslib.Wrapf(err, "failed to marshal receipts at block hash %s", blockHash.Hex())
 	}
-	prefix.NewStore(p.ctx.KVStore(p.storeKey),
-		[]byte{types.BlockHashKeyToReceiptsPrefix}).Set(blockHash.Bytes(), receiptsBz)
-
-	return nil
+	return p.db.Set(prefixed(types.BlockHashKeyToReceiptsPrefix, blockHash.Bytes()), receiptsBz)
 }
 
 // StoreTransactions implements `core.HistoricalPlugin`.
 func (p *plugin) StoreTransactions(
 	blockNum uint64, blockHash common.Hash, txs coretypes.Transactions,
 ) error {
+	batch := p.db.NewBatch()
+	defer batch.Close()
+
+	p.cosmosTxHashesMu.Lock()
+	cosmosTxHashes := p.cosmosTxHashes
+	p.cosmosTxHashes = make(map[common.Hash][]byte)
+	p.cosmosTxHashesMu.Unlock()
+
 	// store all txns in the block.
-	txStore := prefix.NewStore(p.ctx.KVStore(p.storeKey), []byte{types.TxHashKeyToTxPrefix})
 	for txIndex, tx := range txs {
 		txLookupEntry := &coretypes.TxLookupEntry{
 			Tx:        tx,
@@ -100,62 +119,94 @@ func (p *plugin) StoreTransactions(
 			BlockHash: blockHash,
 			BlockNum:  blockNum,
 		}
-		var tleBz []byte
 		tleBz, err := txLookupEntry.MarshalBinary()
 		if err != nil {
-			p.ctx.Logger().Error(
-				"UpdateOffChainStorage: failed to marshal tx %s at block number %d",
-				tx.Hash().Hex(), blockNum,
+			return errorslib.Wrapf(
+				err, "failed to marshal tx %s at block number %d", tx.Hash().Hex(), blockNum,
 			)
+		}
+		if err = batch.Set(prefixed(types.TxHashKeyToTxPrefix, tx.Hash().Bytes()), tleBz); err != nil {
 			return err
 		}
-		txStore.Set(tx.Hash().Bytes(), tleBz)
+
+		// store the hash of the Cosmos transaction that included the tx, if it was recorded.
+		if cosmosTxHash, ok := cosmosTxHashes[tx.Hash()]; ok {
+			if err = batch.Set(
+				prefixed(types.TxHashKeyToCosmosTxHashPrefix, tx.Hash().Bytes()), cosmosTxHash,
+			); err != nil {
+				return err
+			}
+		}
 	}
 
-	return nil
+	return batch.Write()
+}
+
+// RecordCosmosTxHash records the hash of the Cosmos transaction that included the Ethereum
+// transaction with the given hash in the block being finalized. The hash is stored along with the
+// transaction once the block is, the ones of transactions left out of the block are dropped.
+func (p *plugin) RecordCosmosTxHash(txHash common.Hash, cosmosTxHash []byte) {
+	p.cosmosTxHashesMu.Lock()
+	defer p.cosmosTxHashesMu.Unlock()
+	p.cosmosTxHashes[txHash] = cosmosTxHash
+}
+
+// GetCosmosTxHash returns the hash of the Cosmos transaction that included the Ethereum
+// transaction with the given hash, or nil if it was not recorded, e.g. for the transactions of
+// blocks stored before the hashes were recorded. The hashes of pruned transactions are removed.
+func (p *plugin) GetCosmosTxHash(txHash common.Hash) ([]byte, error) {
+	return p.db.Get(prefixed(types.TxHashKeyToCosmosTxHashPrefix, txHash.Bytes()))
 }
 
 // GetBlockByNumber returns the block at the given height.
 func (p *plugin) GetBlockByNumber(number uint64) (*coretypes.Block, error) {
-	store := p.ctx.KVStore(p.storeKey)
-	numBz := sdk.Uint64ToBigEndian(number)
-	blockBz := prefix.NewStore(store, []byte{types.BlockNumKeyToBlockPrefix}).Get(numBz)
-	block := &coretypes.Block{}
-	err := rlp.DecodeBytes(blockBz, block)
-	if err != nil {
+	if err := p.prunedErr(number); err != nil {
 		return nil, err
 	}
-	return block, nil
-}
 
-// GetBlockByHash returns the block at the given hash.
-func (p *plugin) GetBlockByHash(blockHash common.Hash) (*coretypes.Block, error) {
-	store := p.ctx.KVStore(p.storeKey)
-	numBz := prefix.NewStore(store, []byte{types.BlockHashKeyToNumPrefix}).Get(blockHash.Bytes())
-	if numBz == nil {
+	blockBz, err := p.db.Get(prefixed(types.BlockNumKeyToBlockPrefix, sdk.Uint64ToBigEndian(number)))
+	if err != nil {
+		return nil, err
+	}
+	if blockBz == nil {
+		// the block may have been pruned since the check above.
+		if err = p.prunedErr(number); err != nil {
+			return nil, err
+		}
 		return nil, core.ErrBlockNotFound
 	}
 
-	blockBz := prefix.NewStore(store, []byte{types.BlockNumKeyToBlockPrefix}).Get(numBz)
 	block := &coretypes.Block{}
+	if err = rlp.DecodeBytes(blockBz, block); err != nil {
+		return nil, errorslib.Wrapf(err, "failed to unmarshal block %d", number)
+	}
+	// Recover the senders of the host authorized transactions, which are not signed, so that they
+	// are served and used to derive the contract addresses of their receipts.
+	coretypes.CacheHostAuthorizedSenders(block.Transactions())
+	return block, nil
+}
 
-	err := rlp.DecodeBytes(blockBz, block)
+// GetBlockByHash returns the block at the given hash.
+func (p *plugin) GetBlockByHash(blockHash common.Hash) (*coretypes.Block, error) {
+	number, err := p.getBlockNumber(blockHash)
 	if err != nil {
 		return nil, err
 	}
-	return block, nil
+	return p.GetBlockByNumber(number)
 }
 
-// GetTransactionByHash returns the transaction lookup entry with the given hash.
+// GetTransactionByHash returns the transaction lookup entry with the given hash. The transactions
+// of pruned blocks are reported as pruned.
 func (p *plugin) GetTransactionByHash(txHash common.Hash) (*coretypes.TxLookupEntry, error) {
-	// get tx from off chain.
-	tleBz := prefix.NewStore(p.ctx.KVStore(p.storeKey), []byte{types.TxHashKeyToTxPrefix}).Get(txHash.Bytes())
+	tleBz, err := p.db.Get(prefixed(types.TxHashKeyToTxPrefix, txHash.Bytes()))
+	if err != nil {
+		return nil, err
+	}
 	if tleBz == nil {
-		return nil, core.ErrTxNotFound
+		return nil, p.txNotFoundErr(txHash)
 	}
 	tle := &coretypes.TxLookupEntry{}
-	err := tle.UnmarshalBinary(tleBz)
-	if err != nil {
+	if err = tle.UnmarshalBinary(tleBz); err != nil {
 		return nil, errorslib.Wrapf(err, "failed to unmarshal tx %s", txHash.Hex())
 	}
 	return tle, nil
@@ -163,10 +214,20 @@ func (p *plugin) GetTransactionByHash(txHash common.Hash) (*coretypes.TxLookupEn
 
 // GetReceiptsByHash returns the receipts with the given block hash.
 func (p *plugin) GetReceiptsByHash(blockHash common.Hash) (coretypes.Receipts, error) {
-	// get receipts from off chain.
-	receiptsBz := prefix.NewStore(p.ctx.KVStore(p.storeKey),
-		[]byte{types.BlockHashKeyToReceiptsPrefix}).Get(blockHash.Bytes())
+	// get block to derive fields on receipts
+	block, err := p.GetBlockByHash(blockHash)
+	if err != nil {
+		return nil, err
+	}
+
+	receiptsBz, err := p.db.Get(prefixed(types.BlockHashKeyToReceiptsPrefix, blockHash.Bytes()))
+	if err != nil {
+		return nil, err
+	}
 	if receiptsBz == nil {
+		if err = p.prunedErr(block.NumberU64()); err != nil {
+			return nil, err
+		}
 		return nil, fmt.Errorf("failed to find receipts for block hash %s", blockHash.Hex())
 	}
 	receipts, err := coretypes.UnmarshalReceipts(receiptsBz)
@@ -174,16 +235,66 @@ func (p *plugin) GetReceiptsByHash(blockHash common.Hash) (coretypes.Receipts, e
 		return nil, errorslib.Wrapf(err, "failed to unmarshal receipts for block hash %s", blockHash.Hex())
 	}
 
-	// get block to derive fields on receipts
-	block, err := p.GetBlockByHash(blockHash)
-	if err != nil {
-		return nil, err
-	}
+	// The senders of the host authorized transactions of the block are cached, so the contract
+	// addresses of their receipts are derived from their actual senders.
 	if err = receipts.DeriveFields(
-		p.cp.ChainConfig(), blockHash, block.NumberU64(), block.Time(), block.BaseFee(), block.Transactions(),
+		p.cp.ChainConfigAtHeight(block.NumberU64()), blockHash, block.NumberU64(), block.Time(),
+		block.BaseFee(), block.Transactions(),
 	); err != nil {
 		return nil, err
 	}
 
 	return receipts, nil
 }
+
+// getBlockNumber returns the number of the block with the given hash. The index is kept for
+// pruned blocks.
+func (p *plugin) getBlockNumber(blockHash common.Hash) (uint64, error) {
+	numBz, err := p.db.Get(prefixed(types.BlockHashKeyToNumPrefix, blockHash.Bytes()))
+	if err != nil {
+		return 0, err
+	}
+	if numBz == nil {
+		return 0, core.ErrBlockNotFound
+	}
+	return sdk.BigEndianToUint64(numBz), nil
+}
+
+// latestBlockNumber returns the number of the latest stored block and whether any block has
+// been stored.
+func (p *plugin) latestBlockNumber() (uint64, bool, error) {
+	numBz, err := p.db.Get([]byte{types.VersionKey})
+	if err != nil || numBz == nil {
+		return 0, false, err
+	}
+	return sdk.BigEndianToUint64(numBz), true, nil
+}
+
+// txNotFoundErr returns the error for a transaction that is not indexed: `core.ErrPruned` if its
+// block has been pruned, otherwise `core.ErrTxNotFound`.
+func (p *plugin) txNotFoundErr(txHash common.Hash) error {
+	numBz, err := p.db.Get(prefixed(types.PrunedTxHashKeyToNumPrefix, txHash.Bytes()))
+	if err != nil {
+		return err
+	}
+	if numBz == nil {
+		return core.ErrTxNotFound
+	}
+	return errorslib.Wrapf(
+		core.ErrPruned, "tx %s is in the pruned block %d", txHash.Hex(), sdk.BigEndianToUint64(numBz),
+	)
+}
+
+// prunedErr returns `core.ErrPruned` if the historical data of the block with the given number
+// has been pruned, otherwise nil.
+func (p *plugin) prunedErr(number uint64) error {
+	if earliest := p.earliest.Load(); number < earliest {
+		return errorslib.Wrapf(core.ErrPruned, "block %d is before the earliest block %d", number, earliest)
+	}
+	return nil
+}
+
+// prefixed returns the database key of the given key under the given prefix.
+func prefixed(prefix byte, key []byte) []byte {
+	return append([]byte{prefix}, key...)
+}
@@ -0,0 +1,139 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Blackchain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+package historical
+
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"pkg.berachain.dev/jinx/cosmos/x/evm/types"
+)
+
+// migrateBatchSize is the number of key-value pairs moved out of the consensus state at once.
+const migrateBatchSize = 1000
+
+// legacyPrefixes are the prefixes of the historical data that older versions stored in the
+// consensus state, with the same keys as in the historical database.
+var legacyPrefixes = []byte{
+	types.BlockHashKeyToNumPrefix,
+	types.BlockNumKeyToBlockPrefix,
+	types.BlockHashKeyToReceiptsPrefix,
+	types.TxHashKeyToTxPrefix,
+}
+
+// MigrateFromStore moves the historical data that older versions stored in the consensus state,
+// i.e. in the given store, to the historical database. The entries are deleted from the store, so
+// every node ends up with the same consensus state, whether or not it keeps the historical data.
+func (p *plugin) MigrateFromStore(store storetypes.KVStore) error {
+	var (
+		earliest, latest uint64
+		migrated         bool
+	)
+	for _, prefix := range legacyPrefixes {
+		for {
+			keys, values := nextLegacyItems(store, prefix)
+			if len(keys) == 0 {
+				break
+			}
+
+			batch := p.db.NewBatch()
+			for i, key := range keys {
+				if prefix == types.BlockNumKeyToBlockPrefix {
+					number := sdk.BigEndianToUint64(key[1:])
+					if !migrated || number < earliest {
+						earliest = number
+					}
+					if !migrated || number > latest {
+						latest = number
+					}
+					migrated = true
+				}
+				if err := batch.Set(key, values[i]); err != nil {
+					batch.Close()
+					return err
+				}
+			}
+			err := batch.Write()
+			batch.Close()
+			if err != nil {
+				return err
+			}
+
+			for _, key := range keys {
+				store.Delete(key)
+			}
+		}
+	}
+	store.Delete([]byte{types.VersionKey})
+
+	if !migrated {
+		return nil
+	}
+	return p.extendRange(earliest, latest)
+}
+
+// nextLegacyItems returns the next batch of key-value pairs with the given prefix in the store.
+// The iterator is closed before the store is written to.
+func nextLegacyItems(store storetypes.KVStore, prefix byte) ([][]byte, [][]byte) {
+	iter := storetypes.KVStorePrefixIterator(store, []byte{prefix})
+	defer iter.Close()
+
+	var keys, values [][]byte
+	for ; iter.Valid() && len(keys) < migrateBatchSize; iter.Next() {
+		keys = append(keys, append([]byte(nil), iter.Key()...))
+		values = append(values, append([]byte(nil), iter.Value()...))
+	}
+	return keys, values
+}
+
+// extendRange extends the range of the stored blocks with the given earliest and latest block
+// numbers.
+func (p *plugin) extendRange(earliest, latest uint64) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	current, ok, err := p.latestBlockNumber()
+	if err != nil {
+		return err
+	}
+	if ok {
+		if current > latest {
+			latest = current
+		}
+		if stored := p.earliest.Load(); stored < earliest {
+			earliest = stored
+		}
+	}
+
+	batch := p.db.NewBatch()
+	defer batch.Close()
+	if err = batch.Set([]byte{types.EarliestVersionKey}, sdk.Uint64ToBigEndian(earliest)); err != nil {
+		return err
+	}
+	if err = batch.Set([]byte{types.VersionKey}, sdk.Uint64ToBigEndian(latest)); err != nil {
+		return err
+	}
+	if err = batch.Write(); err != nil {
+		return err
+	}
+	p.earliest.Store(earliest)
+	return nil
+}
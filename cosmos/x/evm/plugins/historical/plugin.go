@@ -22,12 +22,20 @@ package historical
 
 import (
 	"context"
+	"sync"
+	"sync/atomic"
 
+	"cosmossdk.io/log"
+	snapshot "cosmossdk.io/store/snapshots/types"
 	storetypes "cosmossdk.io/store/types"
 
+	dbm "github.com/cosmos/cosmos-db"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"pkg.berachain.dev/jinx/cosmos/x/evm/plugins"
+	"pkg.berachain.dev/jinx/cosmos/x/evm/types"
+	"pkg.berachain.dev/jinx/eth/common"
 	"pkg.berachain.dev/jinx/eth/core"
 )
 
@@ -36,38 +44,100 @@ type Plugin interface {
 	plugins.Base
 	core.HistoricalPlugin
 	plugins.HasGenesis
+	snapshot.ExtensionSnapshotter
+	Start(log.Logger)
+	Stop() error
+	MigrateFromStore(storetypes.KVStore) error
+	RecordCosmosTxHash(common.Hash, []byte)
+	GetCosmosTxHash(common.Hash) ([]byte, error)
 }
 
-// plugin keeps track of jinx blocks via headers.
+// plugin keeps track of the historical blocks, receipts, and transactions of the Jinx EVM. The
+// historical data is stored in a node-local database, outside of the consensus state, so it does
+// not contribute to the app hash and every node can choose how much of it to retain.
 type plugin struct {
-	// ctx is the current block context, used for accessing current block info and kv stores.
-	ctx sdk.Context
-	// cp is used to get the current chain config.
+	// cp is used to get the chain config of the stored blocks.
 	cp core.ConfigurationPlugin
 	// bp represents the block plugin, used for accessing historical block headers.
 	bp core.BlockPlugin
-	// storekey is the store key for the header store.
-	storeKey storetypes.StoreKey
-	// TODO: reenable offchain
-	//  `offchainStore` is the offchain store, used for accessing offchain data.
-	// offchainStoreKey storetypes.StoreKey
+	// db is the node-local database that stores the historical data.
+	db dbm.DB
+	// retention is the number of most recent blocks whose historical data is kept, 0 keeps the
+	// historical data of every block.
+	retention uint64
+	// earliest is the number of the oldest block whose historical data has not been pruned.
+	earliest atomic.Uint64
+	// mu serializes the writes of the earliest block number.
+	mu sync.Mutex
+	// cosmosTxHashes are the hashes of the Cosmos transactions that included the Ethereum
+	// transactions of the block being finalized, which are stored along with the transactions.
+	// cosmosTxHashesMu guards them, as they are recorded and stored by different goroutines.
+	cosmosTxHashes   map[common.Hash][]byte
+	cosmosTxHashesMu sync.Mutex
+	// pruneCh is used to notify the pruner that a new block has been stored.
+	pruneCh chan struct{}
+	// quit is closed to stop the pruner, which closes done once it has stopped.
+	quit     chan struct{}
+	done     chan struct{}
+	started  atomic.Bool
+	stopOnce sync.Once
+	logger   log.Logger
 }
 
-// NewPlugin creates a new instance of the block plugin from the given context.
+// NewPlugin creates a new instance of the historical plugin, which stores the historical data in
+// the given database and retains it for the given number of blocks (0 for all blocks).
 func NewPlugin(
-	cp core.ConfigurationPlugin, bp core.BlockPlugin,
-	_ storetypes.StoreKey, storekey storetypes.StoreKey,
+	cp core.ConfigurationPlugin, bp core.BlockPlugin, db dbm.DB, retention uint64,
 ) Plugin {
-	return &plugin{
-		cp:       cp,
-		bp:       bp,
-		storeKey: storekey,
+	p := &plugin{
+		cp:             cp,
+		bp:             bp,
+		db:             db,
+		retention:      retention,
+		cosmosTxHashes: make(map[common.Hash][]byte),
+		pruneCh:        make(chan struct{}, 1),
+		quit:           make(chan struct{}),
+		done:           make(chan struct{}),
+		logger:         log.NewNopLogger(),
+	}
+
+	earliestBz, err := db.Get([]byte{types.EarliestVersionKey})
+	if err != nil {
+		panic(err)
 	}
+	if earliestBz != nil {
+		p.earliest.Store(sdk.BigEndianToUint64(earliestBz))
+	}
+	return p
 }
 
-// Prepare implements core.HistoricalPlugin.
-func (p *plugin) Prepare(ctx context.Context) {
-	p.ctx = sdk.UnwrapSDKContext(ctx)
+// Start starts pruning the historical data that falls out of the retention window in the
+// background. It is a no-op if all historical data is retained.
+func (p *plugin) Start(logger log.Logger) {
+	p.logger = logger
+	if p.retention == 0 {
+		return
+	}
+	p.started.Store(true)
+	go p.loop()
+}
+
+// Stop stops the pruner, if it has been started, and closes the historical database.
+func (p *plugin) Stop() error {
+	p.stopOnce.Do(func() { close(p.quit) })
+	if p.started.Load() {
+		<-p.done
+	}
+	return p.db.Close()
+}
+
+// Prepare implements core.HistoricalPlugin. The historical data is not part of the consensus
+// state, so there is nothing to prepare.
+func (p *plugin) Prepare(context.Context) {}
+
+// EarliestBlockNumber implements core.HistoricalPlugin.
+func (p *plugin) EarliestBlockNumber() uint64 {
+	return p.earliest.Load()
 }
 
 func (p *plugin) IsPlugin() {}
@@ -21,13 +21,22 @@
 package historical
 
 import (
+	"io"
 	"math/big"
 
+	"cosmossdk.io/log"
+	snapshot "cosmossdk.io/store/snapshots/types"
+	storetypes "cosmossdk.io/store/types"
+
+	dbm "github.com/cosmos/cosmos-db"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/ethereum/go-ethereum/trie"
 
 	testutil "pkg.berachain.dev/jinx/cosmos/testing/utils"
+	"pkg.berachain.dev/jinx/cosmos/x/evm/types"
 	"pkg.berachain.dev/jinx/eth/common"
 	"pkg.berachain.dev/jinx/eth/core"
 	"pkg.berachain.dev/jinx/eth/core/mock"
@@ -42,14 +51,18 @@ var _ = Describe("Historical Data", func() {
 	var (
 		p   *plugin
 		ctx sdk.Context
+		db  dbm.DB
+		cp  *mock.ConfigurationPluginMock
+		bp  *mock.BlockPluginMock
 	)
 
 	BeforeEach(func() {
 		ctx = testutil.NewContext().WithBlockHeight(0)
-		cp := mock.NewConfigurationPluginMock()
-		bp := mock.NewBlockPluginMock()
+		cp = mock.NewConfigurationPluginMock()
+		bp = mock.NewBlockPluginMock()
+		db = dbm.NewMemDB()
 
-		p = utils.MustGetAs[*plugin](NewPlugin(cp, bp, nil, testutil.EvmKey))
+		p = utils.MustGetAs[*plugin](NewPlugin(cp, bp, db, 0))
 		p.InitGenesis(ctx, core.DefaultGenesis)
 	})
 
@@ -93,6 +106,8 @@ var _ = Describe("Historical Data", func() {
 
 			Expect(p.StoreBlock(block)).To(Succeed())
 			Expect(p.StoreReceipts(blockHash, receipts)).To(Succeed())
+			p.RecordCosmosTxHash(txHash, []byte{0x1})
+			p.RecordCosmosTxHash(common.Hash{0x1}, []byte{0x2})
 			Expect(p.StoreTransactions(1, blockHash, txs)).To(Succeed())
 
 			blockByNum, err := p.GetBlockByNumber(1)
@@ -114,7 +129,327 @@ var _ = Describe("Historical Data", func() {
 			Expect(tleByHash.BlockHash).To(Equal(blockHash))
 			Expect(tleByHash.BlockNum).To(Equal(uint64(1)))
 			Expect(tleByHash.Tx.Hash()).To(Equal(txHash))
+
+			// only the Cosmos transactions of the stored transactions are kept.
+			cosmosTxHash, err := p.GetCosmosTxHash(txHash)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cosmosTxHash).To(Equal([]byte{0x1}))
+			cosmosTxHash, err = p.GetCosmosTxHash(common.Hash{0x1})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cosmosTxHash).To(BeNil())
 		})
 	})
 
+	When("Pruning", func() {
+		var blocks []*coretypes.Block
+
+		BeforeEach(func() {
+			blocks = nil
+			for i := int64(1); i <= 10; i++ {
+				tx := coretypes.NewTransaction(
+					uint64(i), common.Address{0x1}, big.NewInt(1), 1000, big.NewInt(1), nil,
+				)
+				receipts := coretypes.Receipts{{Status: 1, TxHash: tx.Hash(), BlockNumber: big.NewInt(i)}}
+				header := &coretypes.Header{Number: big.NewInt(i), GasLimit: 1000}
+				if len(blocks) > 0 {
+					header.ParentHash = blocks[len(blocks)-1].Hash()
+				} else {
+					genesis, err := p.GetBlockByNumber(0)
+					Expect(err).ToNot(HaveOccurred())
+					header.ParentHash = genesis.Hash()
+				}
+				block := coretypes.NewBlock(
+					header, coretypes.Transactions{tx}, nil, receipts, trie.NewStackTrie(nil),
+				)
+				Expect(p.StoreBlock(block)).To(Succeed())
+				Expect(p.StoreReceipts(block.Hash(), receipts)).To(Succeed())
+				p.RecordCosmosTxHash(tx.Hash(), []byte{byte(i)})
+				Expect(p.StoreTransactions(uint64(i), block.Hash(), block.Transactions())).To(Succeed())
+				blocks = append(blocks, block)
+			}
+			bp.GetHeaderByNumberFunc = func(number uint64) (*coretypes.Header, error) {
+				return blocks[number-1].Header(), nil
+			}
+		})
+
+		It("should keep all historical data of an archive node", func() {
+			Expect(p.EarliestBlockNumber()).To(BeZero())
+			_, err := p.GetBlockByNumber(1)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should report the data before the earliest block as pruned", func() {
+			Expect(p.pruneTo(6)).To(Succeed())
+			Expect(p.EarliestBlockNumber()).To(Equal(uint64(6)))
+
+			for _, block := range blocks[:5] {
+				_, err := p.GetBlockByNumber(block.NumberU64())
+				Expect(err).To(MatchError(core.ErrPruned))
+				_, err = p.GetBlockByHash(block.Hash())
+				Expect(err).To(MatchError(core.ErrPruned))
+				_, err = p.GetReceiptsByHash(block.Hash())
+				Expect(err).To(MatchError(core.ErrPruned))
+				_, err = p.GetTransactionByHash(block.Transactions()[0].Hash())
+				Expect(err).To(MatchError(core.ErrPruned))
+				cosmosTxHash, err := p.GetCosmosTxHash(block.Transactions()[0].Hash())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(cosmosTxHash).To(BeNil())
+			}
+			for _, block := range blocks[5:] {
+				blockByHash, err := p.GetBlockByHash(block.Hash())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(blockByHash.Hash()).To(Equal(block.Hash()))
+				_, err = p.GetReceiptsByHash(block.Hash())
+				Expect(err).ToNot(HaveOccurred())
+				_, err = p.GetTransactionByHash(block.Transactions()[0].Hash())
+				Expect(err).ToNot(HaveOccurred())
+			}
+
+			_, err := p.GetBlockByNumber(11)
+			Expect(err).To(MatchError(core.ErrBlockNotFound))
+			_, err = p.GetBlockByHash(common.Hash{0x1})
+			Expect(err).To(MatchError(core.ErrBlockNotFound))
+			_, err = p.GetTransactionByHash(common.Hash{0x1})
+			Expect(err).To(MatchError(core.ErrTxNotFound))
+		})
+
+		It("should retain the configured number of blocks in the background", func() {
+			p = utils.MustGetAs[*plugin](NewPlugin(cp, bp, db, 4))
+			p.Start(log.NewNopLogger())
+
+			Eventually(p.EarliestBlockNumber).Should(Equal(uint64(7)))
+			Expect(utils.MustGetAs[*plugin](NewPlugin(cp, bp, db, 4)).EarliestBlockNumber()).
+				To(Equal(uint64(7)))
+
+			Expect(p.Stop()).To(Succeed())
+			Eventually(p.done).Should(BeClosed())
+		})
+
+		It("should stop without having been started", func() {
+			Expect(p.Stop()).To(Succeed())
+		})
+
+		It("should export and restore the historical data in a snapshot", func() {
+			Expect(p.pruneTo(4)).To(Succeed())
+			payloads := exportSnapshot(p, 8)
+
+			restored := utils.MustGetAs[*plugin](NewPlugin(cp, bp, dbm.NewMemDB(), 0))
+			Expect(restored.RestoreExtension(8, SnapshotFormat, restoreSnapshot(payloads))).
+				To(Succeed())
+			Expect(restored.EarliestBlockNumber()).To(Equal(uint64(4)))
+
+			for _, block := range blocks[:3] {
+				_, err := restored.GetBlockByHash(block.Hash())
+				Expect(err).To(MatchError(core.ErrPruned))
+				_, err = restored.GetTransactionByHash(block.Transactions()[0].Hash())
+				Expect(err).To(MatchError(core.ErrPruned))
+			}
+			for _, block := range blocks[3:8] {
+				blockByNum, err := restored.GetBlockByNumber(block.NumberU64())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(blockByNum.Hash()).To(Equal(block.Hash()))
+				_, err = restored.GetReceiptsByHash(block.Hash())
+				Expect(err).ToNot(HaveOccurred())
+				tle, err := restored.GetTransactionByHash(block.Transactions()[0].Hash())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(tle.BlockNum).To(Equal(block.NumberU64()))
+				cosmosTxHash, err := restored.GetCosmosTxHash(block.Transactions()[0].Hash())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(cosmosTxHash).To(Equal([]byte{byte(block.NumberU64())}))
+			}
+			_, err := restored.GetBlockByNumber(9)
+			Expect(err).To(MatchError(core.ErrBlockNotFound))
+			_, err = restored.GetBlockByHash(blocks[8].Hash())
+			Expect(err).To(MatchError(core.ErrBlockNotFound))
+
+			// the next block continues the restored historical data.
+			Expect(restored.StoreBlock(blocks[8])).To(Succeed())
+			Expect(restored.EarliestBlockNumber()).To(Equal(uint64(4)))
+		})
+
+		It("should prune the restored historical data out of the retention window", func() {
+			payloads := exportSnapshot(p, 10)
+
+			restored := utils.MustGetAs[*plugin](NewPlugin(cp, bp, dbm.NewMemDB(), 4))
+			restored.Start(log.NewNopLogger())
+			Expect(restored.RestoreExtension(10, SnapshotFormat, restoreSnapshot(payloads))).
+				To(Succeed())
+			Eventually(restored.EarliestBlockNumber).Should(Equal(uint64(7)))
+			Expect(restored.Stop()).To(Succeed())
+		})
+
+		It("should reject snapshots that do not end at the block at the snapshot height", func() {
+			// the snapshot of a lower height is served at the snapshot height.
+			restored := utils.MustGetAs[*plugin](NewPlugin(cp, bp, dbm.NewMemDB(), 0))
+			Expect(restored.RestoreExtension(8, SnapshotFormat, restoreSnapshot(exportSnapshot(p, 7)))).
+				To(MatchError(ErrInvalidSnapshot))
+
+			// the chain has another block at the snapshot height.
+			bp.GetHeaderByNumberFunc = func(uint64) (*coretypes.Header, error) {
+				return &coretypes.Header{Number: big.NewInt(8)}, nil
+			}
+			restored = utils.MustGetAs[*plugin](NewPlugin(cp, bp, dbm.NewMemDB(), 0))
+			Expect(restored.RestoreExtension(8, SnapshotFormat, restoreSnapshot(exportSnapshot(p, 8)))).
+				To(MatchError(ErrInvalidSnapshot))
+			Expect(restored.EarliestBlockNumber()).To(BeZero())
+		})
+
+		It("should reject snapshots with tampered historical data", func() {
+			// tamper replaces the values of the exported items with the given prefix.
+			tamper := func(prefix byte, value func(*snapshotItem) []byte) [][]byte {
+				payloads := exportSnapshot(p, 8)
+				for i, payload := range payloads {
+					item := &snapshotItem{}
+					Expect(rlp.DecodeBytes(payload, item)).To(Succeed())
+					if item.Key[0] != prefix {
+						continue
+					}
+					item.Value = value(item)
+					var err error
+					payloads[i], err = rlp.EncodeToBytes(item)
+					Expect(err).ToNot(HaveOccurred())
+				}
+				return payloads
+			}
+			// tamperBlock replaces the block with the given number.
+			tamperBlock := func(number uint64, block *coretypes.Block) [][]byte {
+				return tamper(types.BlockNumKeyToBlockPrefix, func(item *snapshotItem) []byte {
+					if sdk.BigEndianToUint64(item.Key[1:]) != number {
+						return item.Value
+					}
+					bz, err := rlp.EncodeToBytes(block)
+					Expect(err).ToNot(HaveOccurred())
+					return bz
+				})
+			}
+			header := blocks[6].Header()
+			header.Extra = []byte("tampered")
+
+			for _, payloads := range [][][]byte{
+				// a block that does not match its transactions root.
+				tamperBlock(8, blocks[7].WithBody(nil, nil)),
+				// a block that is not the parent of the block restored before it.
+				tamperBlock(7, blocks[6].WithSeal(header)),
+				// receipts that do not match the receipts root of their block.
+				tamper(types.BlockHashKeyToReceiptsPrefix, func(*snapshotItem) []byte {
+					bz, err := coretypes.MarshalReceipts(coretypes.Receipts{{Status: 0}})
+					Expect(err).ToNot(HaveOccurred())
+					return bz
+				}),
+				// a tx of another block.
+				tamper(types.TxHashKeyToTxPrefix, func(item *snapshotItem) []byte {
+					tle := &coretypes.TxLookupEntry{}
+					Expect(tle.UnmarshalBinary(item.Value)).To(Succeed())
+					tle.BlockNum++
+					bz, err := tle.MarshalBinary()
+					Expect(err).ToNot(HaveOccurred())
+					return bz
+				}),
+				// a block hash indexed at another restored block.
+				tamper(types.BlockHashKeyToNumPrefix, func(*snapshotItem) []byte {
+					return sdk.Uint64ToBigEndian(8)
+				}),
+			} {
+				restored := utils.MustGetAs[*plugin](NewPlugin(cp, bp, dbm.NewMemDB(), 0))
+				Expect(restored.RestoreExtension(8, SnapshotFormat, restoreSnapshot(payloads))).
+					To(MatchError(ErrInvalidSnapshot))
+			}
+		})
+
+		It("should reject snapshots of an unknown format", func() {
+			Expect(p.RestoreExtension(1, SnapshotFormat+1, func() ([]byte, error) {
+				return nil, io.EOF
+			})).To(MatchError(snapshot.ErrUnknownFormat))
+		})
+
+		It("should start the historical data after a gap", func() {
+			block := coretypes.NewBlockWithHeader(&coretypes.Header{Number: big.NewInt(20)})
+			Expect(p.StoreBlock(block)).To(Succeed())
+			Expect(p.EarliestBlockNumber()).To(Equal(uint64(20)))
+
+			_, err := p.GetBlockByNumber(10)
+			Expect(err).To(MatchError(core.ErrPruned))
+			_, err = p.GetBlockByNumber(20)
+			Expect(err).ToNot(HaveOccurred())
+		})
+	})
+
+	When("Migrating from the consensus state", func() {
+		It("should move the historical data out of the store", func() {
+			store := ctx.KVStore(storetypes.NewKVStoreKey("evm"))
+			legacy := dbm.NewMemDB()
+			legacyPlugin := utils.MustGetAs[*plugin](NewPlugin(cp, bp, legacy, 0))
+
+			var blocks []*coretypes.Block
+			for i := int64(1); i <= 3; i++ {
+				tx := coretypes.NewTransaction(
+					uint64(i), common.Address{0x1}, big.NewInt(1), 1000, big.NewInt(1), nil,
+				)
+				receipts := coretypes.Receipts{{Status: 1, TxHash: tx.Hash(), BlockNumber: big.NewInt(i)}}
+				block := coretypes.NewBlock(
+					&coretypes.Header{Number: big.NewInt(i), GasLimit: 1000},
+					coretypes.Transactions{tx}, nil, receipts, trie.NewStackTrie(nil),
+				)
+				Expect(legacyPlugin.StoreBlock(block)).To(Succeed())
+				Expect(legacyPlugin.StoreReceipts(block.Hash(), receipts)).To(Succeed())
+				Expect(legacyPlugin.StoreTransactions(uint64(i), block.Hash(), block.Transactions())).
+					To(Succeed())
+				blocks = append(blocks, block)
+			}
+			// older versions used the same keys in the consensus state.
+			iter, err := legacy.Iterator(nil, nil)
+			Expect(err).ToNot(HaveOccurred())
+			for ; iter.Valid(); iter.Next() {
+				if iter.Key()[0] != types.EarliestVersionKey {
+					store.Set(iter.Key(), iter.Value())
+				}
+			}
+			Expect(iter.Close()).To(Succeed())
+
+			migrated := utils.MustGetAs[*plugin](NewPlugin(cp, bp, dbm.NewMemDB(), 0))
+			Expect(migrated.MigrateFromStore(store)).To(Succeed())
+			Expect(migrated.EarliestBlockNumber()).To(Equal(uint64(1)))
+			for _, block := range blocks {
+				blockByHash, err := migrated.GetBlockByHash(block.Hash())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(blockByHash.Hash()).To(Equal(block.Hash()))
+				_, err = migrated.GetReceiptsByHash(block.Hash())
+				Expect(err).ToNot(HaveOccurred())
+				_, err = migrated.GetTransactionByHash(block.Transactions()[0].Hash())
+				Expect(err).ToNot(HaveOccurred())
+			}
+
+			storeIter := store.Iterator(nil, nil)
+			Expect(storeIter.Valid()).To(BeFalse())
+			Expect(storeIter.Close()).To(Succeed())
+
+			// the next block continues the migrated historical data.
+			Expect(migrated.StoreBlock(coretypes.NewBlockWithHeader(
+				&coretypes.Header{Number: big.NewInt(4)},
+			))).To(Succeed())
+			Expect(migrated.EarliestBlockNumber()).To(Equal(uint64(1)))
+		})
+	})
 })
+
+// exportSnapshot returns the payloads of the historical snapshot of the plugin at a height.
+func exportSnapshot(p *plugin, height uint64) [][]byte {
+	var payloads [][]byte
+	Expect(p.SnapshotExtension(height, func(payload []byte) error {
+		payloads = append(payloads, payload)
+		return nil
+	})).To(Succeed())
+	return payloads
+}
+
+// restoreSnapshot returns a reader of the given historical snapshot payloads.
+func restoreSnapshot(payloads [][]byte) func() ([]byte, error) {
+	return func() ([]byte, error) {
+		if len(payloads) == 0 {
+			return nil, io.EOF
+		}
+		payload := payloads[0]
+		payloads = payloads[1:]
+		return payload, nil
+	}
+}
@@ -0,0 +1,159 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Blackchain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package historical
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ethereum/go-ethereum/rlp"
+
+	"pkg.berachain.dev/jinx/cosmos/x/evm/types"
+	coretypes "pkg.berachain.dev/jinx/eth/core/types"
+	errorslib "pkg.berachain.dev/jinx/lib/errors"
+)
+
+// loop prunes the historical data every time a new block is stored, until the plugin is stopped.
+func (p *plugin) loop() {
+	defer close(p.done)
+
+	// Catch up with the retention window on startup, it may have been lowered.
+	p.notifyPruner()
+	for {
+		select {
+		case <-p.quit:
+			return
+		case <-p.pruneCh:
+			p.prune()
+		}
+	}
+}
+
+// prune prunes the historical data that fell out of the retention window.
+func (p *plugin) prune() {
+	latest, ok, err := p.latestBlockNumber()
+	if err != nil {
+		p.logger.Error("failed to read the latest historical block number", "err", err)
+		return
+	}
+	if !ok || latest < p.retention {
+		return
+	}
+	if err = p.pruneTo(latest - p.retention + 1); err != nil {
+		p.logger.Error("failed to prune historical data", "err", err)
+	}
+}
+
+// notifyPruner notifies the pruner that a new block has been stored, without blocking if a
+// notification is already pending.
+func (p *plugin) notifyPruner() {
+	select {
+	case p.pruneCh <- struct{}{}:
+	default:
+	}
+}
+
+// pruneTo removes the block, receipts, and transactions of every block before the given block
+// number. The block hash to block number index is kept and the transactions are indexed by the
+// number of their block, so lookups of pruned blocks and transactions by hash can be told apart
+// from lookups of unknown ones. Each block is removed in its own batch, so that
+// storing new blocks is not held up and progress is kept if the node is stopped while pruning.
+func (p *plugin) pruneTo(number uint64) error {
+	for {
+		select {
+		case <-p.quit:
+			return nil
+		default:
+		}
+
+		pruned, err := p.pruneEarliest(number)
+		if err != nil {
+			return err
+		}
+		if !pruned {
+			return nil
+		}
+	}
+}
+
+// pruneEarliest prunes the earliest block if it is before the given block number and returns
+// whether it did.
+func (p *plugin) pruneEarliest(number uint64) (bool, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	earliest := p.earliest.Load()
+	if earliest >= number {
+		return false, nil
+	}
+
+	// Mark the block as pruned before removing it, so that concurrent lookups which miss its
+	// data report it as pruned.
+	p.earliest.Store(earliest + 1)
+	if err := p.pruneBlock(earliest); err != nil {
+		p.earliest.Store(earliest)
+		return false, errorslib.Wrapf(err, "failed to prune block %d", earliest)
+	}
+	return true, nil
+}
+
+// pruneBlock removes the block, receipts, and transactions, along with the hashes of the Cosmos
+// transactions that included them, of the block with the given number and records the next block
+// as the earliest block.
+func (p *plugin) pruneBlock(number uint64) error {
+	numBz := sdk.Uint64ToBigEndian(number)
+	blockBz, err := p.db.Get(prefixed(types.BlockNumKeyToBlockPrefix, numBz))
+	if err != nil {
+		return err
+	}
+
+	batch := p.db.NewBatch()
+	defer batch.Close()
+
+	// The block may be missing if the node did not store it, e.g. if it was state synced.
+	if blockBz != nil {
+		block := &coretypes.Block{}
+		if err = rlp.DecodeBytes(blockBz, block); err != nil {
+			return err
+		}
+		for _, tx := range block.Transactions() {
+			if err = batch.Delete(prefixed(types.TxHashKeyToTxPrefix, tx.Hash().Bytes())); err != nil {
+				return err
+			}
+			if err = batch.Delete(prefixed(types.TxHashKeyToCosmosTxHashPrefix, tx.Hash().Bytes())); err != nil {
+				return err
+			}
+			if err = batch.Set(prefixed(types.PrunedTxHashKeyToNumPrefix, tx.Hash().Bytes()), numBz); err != nil {
+				return err
+			}
+		}
+		if err = batch.Delete(prefixed(types.BlockHashKeyToReceiptsPrefix, block.Hash().Bytes())); err != nil {
+			return err
+		}
+		if err = batch.Delete(prefixed(types.BlockNumKeyToBlockPrefix, numBz)); err != nil {
+			return err
+		}
+	}
+
+	if err = batch.Set([]byte{types.EarliestVersionKey}, sdk.Uint64ToBigEndian(number+1)); err != nil {
+		return err
+	}
+	return batch.Write()
+}
@@ -0,0 +1,409 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Blackchain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package historical
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	snapshot "cosmossdk.io/store/snapshots/types"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/trie"
+
+	"pkg.berachain.dev/jinx/cosmos/x/evm/types"
+	"pkg.berachain.dev/jinx/eth/common"
+	"pkg.berachain.dev/jinx/eth/core"
+	coretypes "pkg.berachain.dev/jinx/eth/core/types"
+	errorslib "pkg.berachain.dev/jinx/lib/errors"
+)
+
+const (
+	// SnapshotName is the name of the snapshot extension of the historical data.
+	SnapshotName = "jinx_historical"
+	// SnapshotFormat is the format of the snapshot payloads: every payload is an RLP encoded
+	// key-value pair of the historical database.
+	SnapshotFormat uint32 = 1
+	// restoreBatchSize is the number of key-value pairs restored in a single database batch.
+	restoreBatchSize = 1000
+)
+
+// snapshotItem is a key-value pair of the historical database, which is the payload of the
+// snapshot extension.
+type snapshotItem struct {
+	Key   []byte
+	Value []byte
+}
+
+// SnapshotName implements `snapshot.ExtensionSnapshotter`.
+func (p *plugin) SnapshotName() string {
+	return SnapshotName
+}
+
+// SnapshotFormat implements `snapshot.ExtensionSnapshotter`.
+func (p *plugin) SnapshotFormat() uint32 {
+	return SnapshotFormat
+}
+
+// SupportedFormats implements `snapshot.ExtensionSnapshotter`.
+func (p *plugin) SupportedFormats() []uint32 {
+	return []uint32{SnapshotFormat}
+}
+
+// SnapshotExtension implements `snapshot.ExtensionSnapshotter`. It exports the historical data
+// up to the snapshot height: the block, receipts, and transactions of every retained block in
+// descending order, so that every block can be verified against its child on restore, followed by
+// the block hash to block number index, including the entries of pruned blocks, and the index of
+// the pruned transactions.
+func (p *plugin) SnapshotExtension(height uint64, write snapshot.ExtensionPayloadWriter) error {
+	latest, ok, err := p.latestBlockNumber()
+	if err != nil || !ok {
+		return err
+	}
+	last := latest
+	if height < last {
+		last = height
+	}
+
+	// Blocks are pruned from the earliest one onwards, so the export ends at the first block that
+	// has been pruned since it started.
+	first := last + 1
+	for earliest := p.earliest.Load(); first > earliest; first-- {
+		exported, err := p.exportBlock(first-1, write)
+		if err != nil {
+			return errorslib.Wrapf(err, "failed to export block %d", first-1)
+		}
+		if !exported {
+			break
+		}
+	}
+
+	// The transactions of the exported blocks that are pruned while exporting are left out.
+	if err = p.exportIndex(types.BlockHashKeyToNumPrefix, last+1, write); err != nil {
+		return err
+	}
+	return p.exportIndex(types.PrunedTxHashKeyToNumPrefix, first, write)
+}
+
+// exportIndex exports the entries of the hash to block number index with the given prefix, of the
+// blocks before the given block number.
+func (p *plugin) exportIndex(prefix byte, end uint64, write snapshot.ExtensionPayloadWriter) error {
+	iter, err := dbm.IteratePrefix(p.db, []byte{prefix})
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		if sdk.BigEndianToUint64(iter.Value()) >= end {
+			continue
+		}
+		if err = writeItem(write, iter.Key(), iter.Value()); err != nil {
+			return err
+		}
+	}
+	return iter.Error()
+}
+
+// exportBlock exports the block, receipts, and transactions, along with the hashes of the Cosmos
+// transactions that included them, of the block with the given number. It returns false if the
+// block has been pruned.
+func (p *plugin) exportBlock(number uint64, write snapshot.ExtensionPayloadWriter) (bool, error) {
+	blockKey := prefixed(types.BlockNumKeyToBlockPrefix, sdk.Uint64ToBigEndian(number))
+	blockBz, err := p.db.Get(blockKey)
+	if err != nil || blockBz == nil {
+		return false, err
+	}
+	block := &coretypes.Block{}
+	if err = rlp.DecodeBytes(blockBz, block); err != nil {
+		return false, err
+	}
+
+	keys := [][]byte{blockKey, prefixed(types.BlockHashKeyToReceiptsPrefix, block.Hash().Bytes())}
+	for _, tx := range block.Transactions() {
+		keys = append(keys,
+			prefixed(types.TxHashKeyToTxPrefix, tx.Hash().Bytes()),
+			prefixed(types.TxHashKeyToCosmosTxHashPrefix, tx.Hash().Bytes()),
+		)
+	}
+	for _, key := range keys {
+		value, err := p.db.Get(key)
+		if err != nil {
+			return false, err
+		}
+		if value == nil {
+			continue
+		}
+		if err = writeItem(write, key, value); err != nil {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// RestoreExtension implements `snapshot.ExtensionSnapshotter`. It restores the historical data
+// exported by SnapshotExtension and serves it from the earliest restored block onwards. The data
+// comes from an untrusted peer, so every item is verified before it is written: the latest block
+// must be the block at the snapshot height of the restored chain, every other block must be the
+// parent of the block restored before it, and the transactions and receipts must match the roots
+// of their block. The restore is rejected on any mismatch.
+func (p *plugin) RestoreExtension(
+	height uint64, format uint32, read snapshot.ExtensionPayloadReader,
+) error {
+	if format != SnapshotFormat {
+		return errorslib.Wrapf(snapshot.ErrUnknownFormat, "format %d", format)
+	}
+
+	var (
+		v     = newRestoreVerifier(p.bp, height)
+		batch = p.db.NewBatch()
+		size  int
+	)
+	defer func() { batch.Close() }()
+
+	for {
+		payload, err := read()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return err
+		}
+
+		item := &snapshotItem{}
+		if err = rlp.DecodeBytes(payload, item); err != nil {
+			return errorslib.Wrap(err, "failed to decode historical snapshot item")
+		}
+		if err = v.verify(item); err != nil {
+			return errorslib.Wrap(ErrInvalidSnapshot, err.Error())
+		}
+
+		if err = batch.Set(item.Key, item.Value); err != nil {
+			return err
+		}
+		if size++; size >= restoreBatchSize {
+			if err = batch.Write(); err != nil {
+				return err
+			}
+			batch.Close()
+			batch, size = p.db.NewBatch(), 0
+		}
+	}
+
+	// Without any block the earliest and latest block numbers are left as is, so the historical
+	// data starts at the first block stored after the snapshot.
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if v.restored {
+		earliestBz, latestBz := sdk.Uint64ToBigEndian(v.earliest), sdk.Uint64ToBigEndian(v.latest)
+		if err := batch.Set([]byte{types.EarliestVersionKey}, earliestBz); err != nil {
+			return err
+		}
+		if err := batch.Set([]byte{types.VersionKey}, latestBz); err != nil {
+			return err
+		}
+	}
+	if err := batch.Write(); err != nil {
+		return err
+	}
+	if v.restored {
+		p.earliest.Store(v.earliest)
+		// the restored blocks may fall out of the retention window.
+		p.notifyPruner()
+	}
+	return nil
+}
+
+// restoreVerifier verifies the items of a historical snapshot, in the order they are exported.
+type restoreVerifier struct {
+	// bp is used to get the header of the restored chain at the snapshot height.
+	bp     core.BlockPlugin
+	height uint64
+	// earliest and latest are the numbers of the restored blocks, which are contiguous.
+	earliest, latest uint64
+	restored         bool
+	// hashes are the hashes of the restored blocks by number.
+	hashes map[uint64]common.Hash
+	// last is the block restored last, whose receipts and transactions are restored after it,
+	// and lastTxs are the indexes of its transactions by hash.
+	last    *coretypes.Block
+	lastTxs map[common.Hash]int
+}
+
+// newRestoreVerifier creates a verifier of the historical snapshot at the given height.
+func newRestoreVerifier(bp core.BlockPlugin, height uint64) *restoreVerifier {
+	return &restoreVerifier{bp: bp, height: height, hashes: make(map[uint64]common.Hash)}
+}
+
+// verify verifies the given snapshot item.
+func (v *restoreVerifier) verify(item *snapshotItem) error {
+	if len(item.Key) == 0 {
+		return errors.New("empty key")
+	}
+	switch item.Key[0] {
+	case types.BlockNumKeyToBlockPrefix:
+		return v.verifyBlock(item)
+	case types.BlockHashKeyToReceiptsPrefix:
+		return v.verifyReceipts(item)
+	case types.TxHashKeyToTxPrefix:
+		return v.verifyTx(item)
+	case types.TxHashKeyToCosmosTxHashPrefix:
+		if _, ok := v.lastTxs[common.BytesToHash(item.Key[1:])]; !ok {
+			return fmt.Errorf("cosmos tx hash of unknown tx %x", item.Key[1:])
+		}
+		return nil
+	case types.BlockHashKeyToNumPrefix, types.PrunedTxHashKeyToNumPrefix:
+		return v.verifyIndex(item)
+	default:
+		return fmt.Errorf("unknown key prefix %d", item.Key[0])
+	}
+}
+
+// verifyBlock verifies that the block is the block at the snapshot height of the restored chain,
+// or the parent of the block restored before it, and that it matches its transactions root.
+func (v *restoreVerifier) verifyBlock(item *snapshotItem) error {
+	number := sdk.BigEndianToUint64(item.Key[1:])
+	block := &coretypes.Block{}
+	if err := rlp.DecodeBytes(item.Value, block); err != nil {
+		return errorslib.Wrapf(err, "failed to decode block %d", number)
+	}
+	if block.NumberU64() != number {
+		return fmt.Errorf("block %d stored at number %d", block.NumberU64(), number)
+	}
+
+	if !v.restored {
+		if number != v.height {
+			return fmt.Errorf("latest block %d is not at the snapshot height %d", number, v.height)
+		}
+		header, err := v.bp.GetHeaderByNumber(number)
+		if err != nil {
+			return errorslib.Wrapf(err, "failed to get the header of block %d", number)
+		}
+		if block.Hash() != header.Hash() {
+			return fmt.Errorf("block %d hash %s does not match the chain hash %s",
+				number, block.Hash().Hex(), header.Hash().Hex())
+		}
+		v.latest = number
+	} else {
+		if number+1 != v.earliest {
+			return fmt.Errorf("block %d does not precede block %d", number, v.earliest)
+		}
+		if block.Hash() != v.last.ParentHash() {
+			return fmt.Errorf("block %d hash %s is not the parent hash %s of block %d",
+				number, block.Hash().Hex(), v.last.ParentHash().Hex(), v.earliest)
+		}
+	}
+	txHash := coretypes.DeriveSha(block.Transactions(), trie.NewStackTrie(nil))
+	if txHash != block.TxHash() {
+		return fmt.Errorf("block %d transactions root %s does not match %s",
+			number, txHash.Hex(), block.TxHash().Hex())
+	}
+
+	v.earliest, v.restored = number, true
+	v.hashes[number] = block.Hash()
+	v.last = block
+	v.lastTxs = make(map[common.Hash]int, len(block.Transactions()))
+	for i, tx := range block.Transactions() {
+		v.lastTxs[tx.Hash()] = i
+	}
+	return nil
+}
+
+// verifyReceipts verifies that the receipts are the receipts of the block restored last and that
+// they match its receipts root.
+func (v *restoreVerifier) verifyReceipts(item *snapshotItem) error {
+	if v.last == nil || common.BytesToHash(item.Key[1:]) != v.last.Hash() {
+		return fmt.Errorf("receipts of unknown block %x", item.Key[1:])
+	}
+	receipts, err := coretypes.UnmarshalReceipts(item.Value)
+	if err != nil {
+		return errorslib.Wrapf(err, "failed to decode the receipts of block %d", v.last.NumberU64())
+	}
+	txs := v.last.Transactions()
+	if len(receipts) != len(txs) {
+		return fmt.Errorf("block %d has %d receipts for %d transactions",
+			v.last.NumberU64(), len(receipts), len(txs))
+	}
+	// the type of the receipts is not stored, it is the one of their transactions.
+	for i, receipt := range receipts {
+		receipt.Type = txs[i].Type()
+	}
+	receiptHash := coretypes.DeriveSha(receipts, trie.NewStackTrie(nil))
+	if receiptHash != v.last.ReceiptHash() {
+		return fmt.Errorf("block %d receipts root %s does not match %s",
+			v.last.NumberU64(), receiptHash.Hex(), v.last.ReceiptHash().Hex())
+	}
+	return nil
+}
+
+// verifyTx verifies that the transaction lookup entry is the one of a transaction of the block
+// restored last.
+func (v *restoreVerifier) verifyTx(item *snapshotItem) error {
+	txHash := common.BytesToHash(item.Key[1:])
+	index, ok := v.lastTxs[txHash]
+	if !ok {
+		return fmt.Errorf("unknown tx %s", txHash.Hex())
+	}
+	tle := &coretypes.TxLookupEntry{}
+	if err := tle.UnmarshalBinary(item.Value); err != nil {
+		return errorslib.Wrapf(err, "failed to decode tx %s", txHash.Hex())
+	}
+	if tle.Tx.Hash() != txHash || tle.TxIndex != uint64(index) ||
+		tle.BlockHash != v.last.Hash() || tle.BlockNum != v.last.NumberU64() {
+		return fmt.Errorf("tx %s does not match block %d", txHash.Hex(), v.last.NumberU64())
+	}
+	return nil
+}
+
+// verifyIndex verifies that the hash to block number index entry is the one of a restored block,
+// or refers to a pruned block.
+func (v *restoreVerifier) verifyIndex(item *snapshotItem) error {
+	if len(item.Value) != 8 {
+		return fmt.Errorf("invalid block number %x", item.Value)
+	}
+	number := sdk.BigEndianToUint64(item.Value)
+	if number > v.height {
+		return fmt.Errorf("block %d is after the snapshot height %d", number, v.height)
+	}
+	if !v.restored || number < v.earliest {
+		return nil
+	}
+	if item.Key[0] == types.PrunedTxHashKeyToNumPrefix {
+		return fmt.Errorf("pruned tx of restored block %d", number)
+	}
+	if common.BytesToHash(item.Key[1:]) != v.hashes[number] {
+		return fmt.Errorf("hash %x is not the hash of block %d", item.Key[1:], number)
+	}
+	return nil
+}
+
+// writeItem writes the given key-value pair of the historical database as a snapshot payload.
+func writeItem(write snapshot.ExtensionPayloadWriter, key, value []byte) error {
+	payload, err := rlp.EncodeToBytes(&snapshotItem{Key: key, Value: value})
+	if err != nil {
+		return err
+	}
+	return write(payload)
+}
@@ -28,6 +28,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"pkg.berachain.dev/jinx/cosmos/x/evm/plugins"
+	"pkg.berachain.dev/jinx/cosmos/x/evm/plugins/gas"
 	"pkg.berachain.dev/jinx/cosmos/x/evm/plugins/state"
 	"pkg.berachain.dev/jinx/eth/common"
 	"pkg.berachain.dev/jinx/eth/core"
@@ -48,6 +49,7 @@ type Plugin interface {
 	SetKVGasConfig(storetypes.GasConfig)
 	TransientKVGasConfig() storetypes.GasConfig
 	SetTransientKVGasConfig(storetypes.GasConfig)
+	GetPrecompileInfos(*params.Rules) ([]*ethprecompile.Info, error)
 }
 
 // plugin runs precompile containers in the Cosmos environment with the context gas configs.
@@ -92,6 +94,24 @@ func (p *plugin) GetActive(rules *params.Rules) []common.Address {
 	return active
 }
 
+// GetPrecompileInfos returns the descriptions of the stateful precompiles that are active under
+// the given chain rules, with the ABIs they serve.
+//
+// GetPrecompileInfos implements Plugin.
+func (p *plugin) GetPrecompileInfos(rules *params.Rules) ([]*ethprecompile.Info, error) {
+	active := make(map[common.Address]struct{})
+	for _, addr := range p.GetActive(rules) {
+		active[addr] = struct{}{}
+	}
+	var precompiles []ethprecompile.Registrable
+	for _, pc := range p.GetPrecompiles(rules) {
+		if _, ok := active[pc.RegistryKey()]; ok {
+			precompiles = append(precompiles, pc)
+		}
+	}
+	return ethprecompile.GetInfos(precompiles)
+}
+
 // KVGasConfig implements Plugin.
 func (p *plugin) KVGasConfig() storetypes.GasConfig {
 	return p.kvGasConfig
@@ -113,8 +133,8 @@ func (p *plugin) SetTransientKVGasConfig(transientKVGasConfig storetypes.GasConf
 }
 
 // Run runs the a precompile container and returns the remaining gas after execution by injecting
-// a Cosmos SDK `GasMeter`. This function returns an error if the precompile execution returns an
-// error or insufficient gas is provided.
+// a gas Meter, which never panics, with the supplied gas as limit. This function returns an error
+// if the precompile execution returns an error or insufficient gas is provided.
 //
 // Run implements core.PrecompilePlugin.
 func (p *plugin) Run(
@@ -122,9 +142,11 @@ func (p *plugin) Run(
 	caller common.Address, value *big.Int, suppliedGas uint64, readonly bool,
 ) ([]byte, uint64, error) {
 	// use a precompile-specific gas meter for dynamic consumption
-	gm := storetypes.NewInfiniteGasMeter()
+	gm := gas.NewMeter(suppliedGas)
 	// consume static gas from RequiredGas
-	gm.ConsumeGas(pc.RequiredGas(input), "RequiredGas")
+	if err := gm.TryConsumeGas(pc.RequiredGas(input), "RequiredGas"); err != nil {
+		return nil, 0, vm.ErrOutOfGas
+	}
 
 	// get native Cosmos SDK context from the Jinx StateDB
 	sdb := utils.MustGetAs[vm.JinxStateDB](evm.GetStateDB())
@@ -148,13 +170,13 @@ func (p *plugin) Run(
 	// enable reentrancy into the EVM
 	p.enableReentrancy(sdb)
 
-	// handle overconsumption of gas
-	if gm.GasConsumed() > suppliedGas {
+	// handle overconsumption of gas, which the gas meter records instead of panicking
+	if gm.Err() != nil {
 		return nil, 0, vm.ErrOutOfGas
 	}
 
-	// valid precompile gas consumption => return supplied gas
-	return ret, suppliedGas - gm.GasConsumed(), err
+	// valid precompile gas consumption => return remaining gas
+	return ret, gm.GasRemaining(), err
 }
 
 // EnableReentrancy sets the state so that execution can enter the EVM again.
@@ -65,6 +65,14 @@ var _ = Describe("plugin", func() {
 		Expect(err.Error()).To(Equal("out of gas"))
 	})
 
+	It("should error on insufficient gas for the dynamic consumption", func() {
+		var err error
+		Expect(func() {
+			_, _, err = p.Run(e, &mockStateless{}, []byte{}, addr, new(big.Int), 15, false)
+		}).ToNot(Panic())
+		Expect(err.Error()).To(Equal("out of gas"))
+	})
+
 	It("should plug in custom gas configs", func() {
 		Expect(p.KVGasConfig().DeleteCost).To(Equal(uint64(1000)))
 		Expect(p.TransientKVGasConfig().DeleteCost).To(Equal(uint64(100)))
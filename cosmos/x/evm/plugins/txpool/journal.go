@@ -0,0 +1,142 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Blackchain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package txpool
+
+import (
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+
+	"github.com/ethereum/go-ethereum/rlp"
+
+	coretypes "pkg.berachain.dev/jinx/eth/core/types"
+)
+
+// errNoActiveJournal is returned if a transaction is attempted to be inserted into the journal,
+// but no such file is currently open.
+var errNoActiveJournal = errors.New("no active journal")
+
+// journalEntry is a local transaction as it is stored in the journal.
+type journalEntry struct {
+	Tx *coretypes.Transaction
+	// Private is true if the transaction was submitted through `SendPrivTx` and must not be
+	// gossiped to peers.
+	Private bool
+}
+
+// journal is a rotating log of local transactions, stored as a stream of RLP encoded
+// `journalEntry`s, with the aim of storing locally created transactions to allow them to survive
+// node restarts.
+type journal struct {
+	// path is the filesystem path to store the transactions at.
+	path string
+	// writer is the output stream to write new transactions into.
+	writer io.WriteCloser
+}
+
+// newJournal creates a new transaction journal at the given path.
+func newJournal(path string) *journal {
+	return &journal{
+		path: path,
+	}
+}
+
+// load parses a transaction journal dump from disk, calling add for each of the entries. A
+// missing journal is not an error, as there is nothing to replay, and neither is a torn last
+// entry, as left by a crash while it was being written.
+func (j *journal) load(add func(*journalEntry)) error {
+	input, err := os.Open(j.path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	defer input.Close()
+
+	stream := rlp.NewStream(input, 0)
+	for {
+		entry := new(journalEntry)
+		if err = stream.Decode(entry); err != nil {
+			if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+				return nil
+			}
+			return err
+		}
+		add(entry)
+	}
+}
+
+// insert adds the specified entry to the local disk journal.
+func (j *journal) insert(entry *journalEntry) error {
+	if j.writer == nil {
+		return errNoActiveJournal
+	}
+	return rlp.Encode(j.writer, entry)
+}
+
+// rotate regenerates the transaction journal based on the given entries, dropping everything
+// else that was previously journaled, and opens the journal for appending new entries.
+func (j *journal) rotate(entries []*journalEntry) error {
+	// Close the current journal (if any is open).
+	if j.writer != nil {
+		if err := j.writer.Close(); err != nil {
+			return err
+		}
+		j.writer = nil
+	}
+
+	// Generate a new journal with the contents of the current pool.
+	replacement, err := os.OpenFile(j.path+".new", os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o644) //#nosec:G302
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		if err = rlp.Encode(replacement, entry); err != nil {
+			replacement.Close()
+			return err
+		}
+	}
+	if err = replacement.Close(); err != nil {
+		return err
+	}
+
+	// Replace the live journal with the newly generated one.
+	if err = os.Rename(j.path+".new", j.path); err != nil {
+		return err
+	}
+	sink, err := os.OpenFile(j.path, os.O_WRONLY|os.O_APPEND, 0o644) //#nosec:G302
+	if err != nil {
+		return err
+	}
+	j.writer = sink
+	return nil
+}
+
+// close flushes the transaction journal contents to disk and closes the file.
+func (j *journal) close() error {
+	var err error
+	if j.writer != nil {
+		err = j.writer.Close()
+		j.writer = nil
+	}
+	return err
+}
@@ -0,0 +1,117 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Blackchain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package txpool
+
+import (
+	"math/big"
+	"os"
+	"path/filepath"
+
+	coretypes "pkg.berachain.dev/jinx/eth/core/types"
+	"pkg.berachain.dev/jinx/eth/crypto"
+	"pkg.berachain.dev/jinx/eth/params"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Journal", func() {
+	var (
+		j      *journal
+		path   string
+		key, _ = crypto.GenerateEthKey()
+		signer = coretypes.LatestSignerForChainID(params.DefaultChainConfig.ChainID)
+	)
+
+	newTx := func(nonce uint64) *coretypes.Transaction {
+		return coretypes.MustSignNewTx(key, signer, &coretypes.LegacyTx{
+			Nonce: nonce, Gas: 21000, GasPrice: big.NewInt(1),
+		})
+	}
+
+	load := func() []*journalEntry {
+		var entries []*journalEntry
+		Expect(newJournal(path).load(func(entry *journalEntry) {
+			entries = append(entries, entry)
+		})).To(Succeed())
+		return entries
+	}
+
+	BeforeEach(func() {
+		path = filepath.Join(GinkgoT().TempDir(), "transactions.rlp")
+		j = newJournal(path)
+	})
+
+	AfterEach(func() {
+		Expect(j.close()).To(Succeed())
+	})
+
+	It("should load nothing from a missing journal", func() {
+		Expect(load()).To(BeEmpty())
+	})
+
+	It("should not insert before the journal is opened", func() {
+		Expect(j.insert(&journalEntry{Tx: newTx(0)})).To(MatchError(errNoActiveJournal))
+	})
+
+	It("should journal and load local transactions", func() {
+		tx0, tx1 := newTx(0), newTx(1)
+		Expect(j.rotate(nil)).To(Succeed())
+		Expect(j.insert(&journalEntry{Tx: tx0})).To(Succeed())
+		Expect(j.insert(&journalEntry{Tx: tx1, Private: true})).To(Succeed())
+
+		entries := load()
+		Expect(entries).To(HaveLen(2))
+		Expect(entries[0].Tx.Hash()).To(Equal(tx0.Hash()))
+		Expect(entries[0].Private).To(BeFalse())
+		Expect(entries[1].Tx.Hash()).To(Equal(tx1.Hash()))
+		Expect(entries[1].Private).To(BeTrue())
+	})
+
+	It("should load the entries before a torn last entry", func() {
+		tx0, tx1 := newTx(0), newTx(1)
+		Expect(j.rotate(nil)).To(Succeed())
+		Expect(j.insert(&journalEntry{Tx: tx0})).To(Succeed())
+		Expect(j.insert(&journalEntry{Tx: tx1})).To(Succeed())
+		info, err := os.Stat(path)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(os.Truncate(path, info.Size()-1)).To(Succeed())
+
+		entries := load()
+		Expect(entries).To(HaveLen(1))
+		Expect(entries[0].Tx.Hash()).To(Equal(tx0.Hash()))
+	})
+
+	It("should drop everything else on rotate", func() {
+		tx0, tx1, tx2 := newTx(0), newTx(1), newTx(2)
+		Expect(j.rotate(nil)).To(Succeed())
+		Expect(j.insert(&journalEntry{Tx: tx0})).To(Succeed())
+		Expect(j.insert(&journalEntry{Tx: tx1})).To(Succeed())
+
+		Expect(j.rotate([]*journalEntry{{Tx: tx1}})).To(Succeed())
+		Expect(j.insert(&journalEntry{Tx: tx2})).To(Succeed())
+
+		entries := load()
+		Expect(entries).To(HaveLen(2))
+		Expect(entries[0].Tx.Hash()).To(Equal(tx1.Hash()))
+		Expect(entries[1].Tx.Hash()).To(Equal(tx2.Hash()))
+	})
+})
@@ -0,0 +1,184 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Blackchain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package txpool
+
+import (
+	"sort"
+	"time"
+
+	"cosmossdk.io/log"
+
+	coretypes "pkg.berachain.dev/jinx/eth/core/types"
+)
+
+const (
+	// rebroadcastInterval is the time interval at which local transactions that are missing from
+	// the mempool are resubmitted.
+	rebroadcastInterval = 30 * time.Second
+
+	// rejournalInterval is the time interval at which the local transactions journal is
+	// regenerated.
+	rejournalInterval = time.Hour
+)
+
+// LoadJournal loads the local transactions journal at the given path, tracking every journaled
+// transaction as a local transaction, and opens the journal for new transactions. The journal is
+// regenerated from the transactions loaded before any error, which is returned after, so that
+// new transactions are still journaled. The loaded transactions are replayed once the plugin is
+// started.
+func (p *plugin) LoadJournal(path string) error {
+	p.localsMu.Lock()
+	defer p.localsMu.Unlock()
+
+	p.journal = newJournal(path)
+	loadErr := p.journal.load(func(entry *journalEntry) {
+		p.locals[entry.Tx.Hash()] = entry
+	})
+	if err := p.journal.rotate(p.localEntries()); err != nil {
+		return err
+	}
+	return loadErr
+}
+
+// Start replays the local transactions and starts the loop that periodically resubmits them
+// until they are included or made stale by nonce.
+func (p *plugin) Start(logger log.Logger) {
+	p.logger = logger
+	p.started.Store(true)
+	go p.loop()
+}
+
+// Stop stops the loop, if it has been started, stops announcing pending transactions and closes
+// the local transactions journal.
+func (p *plugin) Stop() error {
+	p.stopOnce.Do(func() { close(p.quit) })
+	if p.started.Load() {
+		<-p.done
+	}
+	p.EthTxPool.Close()
+
+	p.localsMu.Lock()
+	defer p.localsMu.Unlock()
+	if p.journal == nil {
+		return nil
+	}
+	return p.journal.close()
+}
+
+// loop resubmits the local transactions that are missing from the mempool and regenerates the
+// journal on their respective intervals, until the plugin is stopped.
+func (p *plugin) loop() {
+	defer close(p.done)
+	rebroadcast := time.NewTicker(rebroadcastInterval)
+	defer rebroadcast.Stop()
+	rejournal := time.NewTicker(rejournalInterval)
+	defer rejournal.Stop()
+
+	// Replay the journaled transactions on startup.
+	p.resubmitLocals()
+	for {
+		select {
+		case <-rebroadcast.C:
+			p.resubmitLocals()
+		case <-rejournal.C:
+			p.rejournalLocals()
+		case <-p.quit:
+			return
+		}
+	}
+}
+
+// trackLocal marks the given transaction as a local transaction and writes it to the journal.
+func (p *plugin) trackLocal(entry *journalEntry) {
+	p.localsMu.Lock()
+	defer p.localsMu.Unlock()
+
+	p.locals[entry.Tx.Hash()] = entry
+	if p.journal == nil {
+		return
+	}
+	if err := p.journal.insert(entry); err != nil {
+		p.logger.Error("failed to journal local transaction", "hash", entry.Tx.Hash(), "err", err)
+	}
+}
+
+// resubmitLocals drops the local transactions that are made stale by the nonce of their sender
+// and resubmits the ones that are missing from the mempool. Private transactions are only
+// reinserted into the local mempool. The lock is not held while resubmitting, so that new local
+// transactions are not held up by the network.
+func (p *plugin) resubmitLocals() {
+	p.localsMu.Lock()
+	entries := p.localEntries()
+	p.localsMu.Unlock()
+
+	for _, entry := range entries {
+		hash := entry.Tx.Hash()
+
+		// The transaction, or one with the same nonce, has been included in a block.
+		if p.localsNR != nil && entry.Tx.Nonce() < p.localsNR.GetNonce(coretypes.GetSender(entry.Tx)) {
+			p.localsMu.Lock()
+			delete(p.locals, hash)
+			p.localsMu.Unlock()
+			continue
+		}
+
+		// The transaction is still in the mempool, so there is nothing to do.
+		if p.EthTxPool.Get(hash) != nil {
+			continue
+		}
+
+		var err error
+		if entry.Private {
+			err = p.insertPrivTx(entry.Tx)
+		} else {
+			err = p.broadcastTx(entry.Tx)
+		}
+		if err != nil {
+			p.logger.Debug("failed to resubmit local transaction", "hash", hash, "err", err)
+		}
+	}
+}
+
+// rejournalLocals regenerates the journal from the local transactions that are still tracked.
+func (p *plugin) rejournalLocals() {
+	p.localsMu.Lock()
+	defer p.localsMu.Unlock()
+
+	if p.journal == nil {
+		return
+	}
+	if err := p.journal.rotate(p.localEntries()); err != nil {
+		p.logger.Error("failed to rotate local transactions journal", "err", err)
+	}
+}
+
+// localEntries returns the tracked local transactions sorted by nonce, so that they are
+// journaled (and replayed) in an order in which they can be executed.
+func (p *plugin) localEntries() []*journalEntry {
+	entries := make([]*journalEntry, 0, len(p.locals))
+	for _, entry := range p.locals {
+		entries = append(entries, entry)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Tx.Nonce() < entries[j].Tx.Nonce()
+	})
+	return entries
+}
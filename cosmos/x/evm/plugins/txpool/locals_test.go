@@ -0,0 +1,105 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Blackchain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package txpool
+
+import (
+	"math/big"
+	"os"
+	"path/filepath"
+
+	"cosmossdk.io/log"
+
+	"pkg.berachain.dev/jinx/cosmos/x/evm/plugins/txpool/mempool"
+	"pkg.berachain.dev/jinx/eth/common"
+	coretypes "pkg.berachain.dev/jinx/eth/core/types"
+	"pkg.berachain.dev/jinx/eth/crypto"
+	"pkg.berachain.dev/jinx/eth/params"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// mockNonceRetriever returns the same nonce for every address.
+type mockNonceRetriever uint64
+
+func (nr mockNonceRetriever) GetNonce(common.Address) uint64 {
+	return uint64(nr)
+}
+
+var _ = Describe("Locals", func() {
+	var (
+		p      *plugin
+		path   string
+		key, _ = crypto.GenerateEthKey()
+		signer = coretypes.LatestSignerForChainID(params.DefaultChainConfig.ChainID)
+	)
+
+	BeforeEach(func() {
+		path = filepath.Join(GinkgoT().TempDir(), "transactions.rlp")
+		p = NewPlugin(mempool.NewJinxEthereumTxPool()).(*plugin)
+		Expect(p.LoadJournal(path)).To(Succeed())
+	})
+
+	It("should drop the local transactions that have been included", func() {
+		tx := coretypes.MustSignNewTx(key, signer, &coretypes.LegacyTx{
+			Nonce: 1, Gas: 21000, GasPrice: big.NewInt(1),
+		})
+		p.trackLocal(&journalEntry{Tx: tx})
+		p.SetLocalsNonceRetriever(mockNonceRetriever(2))
+
+		p.resubmitLocals()
+		Expect(p.locals).To(BeEmpty())
+	})
+
+	It("should stop the loop and close the journal", func() {
+		p.Start(log.NewNopLogger())
+		Expect(p.Stop()).To(Succeed())
+		Eventually(p.done).Should(BeClosed())
+		Expect(p.journal.insert(&journalEntry{})).To(MatchError(errNoActiveJournal))
+
+		// stopping again is a no-op.
+		Expect(p.Stop()).To(Succeed())
+	})
+
+	It("should regenerate the journal if it fails to load", func() {
+		Expect(p.Stop()).To(Succeed())
+		// a journal with an invalid entry that is not the last one.
+		Expect(os.WriteFile(path, []byte{0xf8, 0x00, 0xc0}, 0o600)).To(Succeed())
+
+		p = NewPlugin(mempool.NewJinxEthereumTxPool()).(*plugin)
+		Expect(p.LoadJournal(path)).ToNot(Succeed())
+		Expect(p.journal.insert(&journalEntry{Tx: coretypes.MustSignNewTx(
+			key, signer, &coretypes.LegacyTx{Nonce: 1, Gas: 21000, GasPrice: big.NewInt(1)},
+		)})).To(Succeed())
+		Expect(p.Stop()).To(Succeed())
+
+		var entries []*journalEntry
+		Expect(newJournal(path).load(func(entry *journalEntry) {
+			entries = append(entries, entry)
+		})).To(Succeed())
+		Expect(entries).To(HaveLen(1))
+	})
+
+	It("should close the journal if the loop was never started", func() {
+		Expect(p.Stop()).To(Succeed())
+		Expect(p.journal.insert(&journalEntry{})).To(MatchError(errNoActiveJournal))
+	})
+})
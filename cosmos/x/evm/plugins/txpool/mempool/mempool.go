@@ -21,12 +21,16 @@
 package mempool
 
 import (
+	"context"
 	"math/big"
 	"sync"
 
 	"github.com/cosmos/cosmos-sdk/types/mempool"
 
+	"github.com/ethereum/go-ethereum/event"
+
 	"pkg.berachain.dev/jinx/eth/common"
+	"pkg.berachain.dev/jinx/eth/core"
 	coretypes "pkg.berachain.dev/jinx/eth/core/types"
 )
 
@@ -47,14 +51,28 @@ type EthTxPool struct {
 	// later
 	ethTxCache map[common.Hash]*coretypes.Transaction
 
-	// nonceToHash maps a nonce to the hash of the transaction that was added to the mempool with
-	// that nonce. This is used to retrieve the hash of a transaction that was added to the mempool
-	// by nonce.
-	nonceToHash map[common.Address]map[uint64]common.Hash
+	// senders indexes the transactions in the mempool by sender. Each sender keeps a nonce-sorted
+	// list of its transactions, split into pending and queued sets, which is maintained on
+	// Insert, Remove and Prepare so that reads never need to iterate over the whole mempool.
+	senders map[common.Address]*senderTxs
 
-	// We have a mutex to protect the ethTxCache and nonces maps since they are accessed
+	// We have a mutex to protect the ethTxCache and senders maps since they are accessed
 	// concurrently by multiple goroutines.
 	mu sync.RWMutex
+
+	// txFeed and scope are used to announce the Ethereum transactions that become pending, either
+	// on Insert or when queued transactions are promoted, to new txs subscribers.
+	txFeed event.Feed
+	scope  event.SubscriptionScope
+
+	// announced queues the transactions to announce, which the announce loop sends to the new txs
+	// subscribers, so that a slow subscriber never holds up the mempool, e.g. in Prepare at the
+	// start of every block. announceReq wakes up the loop and quit stops it.
+	announced   coretypes.Transactions
+	announceMu  sync.Mutex
+	announceReq chan struct{}
+	quit        chan struct{}
+	closeOnce   sync.Once
 }
 
 // NewJinxEthereumTxPool creates a new Ethereum transaction pool.
@@ -76,12 +94,24 @@ func NewJinxEthereumTxPool() *EthTxPool {
 		MaxTx: 10000, //nolint:gomnd // todo: parametize this.
 	}
 
-	return &EthTxPool{
+	etp := &EthTxPool{
 		PriorityNonceMempool: mempool.NewPriorityMempool(config),
-		nonceToHash:          make(map[common.Address]map[uint64]common.Hash),
+		senders:              make(map[common.Address]*senderTxs),
 		ethTxCache:           make(map[common.Hash]*coretypes.Transaction),
 		priorityPolicy:       &tpp,
+		announceReq:          make(chan struct{}, 1),
+		quit:                 make(chan struct{}),
 	}
+	go etp.announceLoop()
+	return etp
+}
+
+// Close stops announcing pending transactions and ends the subscriptions to the new txs feed.
+func (etp *EthTxPool) Close() {
+	etp.closeOnce.Do(func() {
+		close(etp.quit)
+		etp.scope.Close()
+	})
 }
 
 // SetNonceRetriever sets the nonce retriever db for the mempool.
@@ -89,7 +119,73 @@ func (etp *EthTxPool) SetNonceRetriever(nr NonceRetriever) {
 	etp.nr = nr
 }
 
+// SubscribeNewTxsEvent returns a new event subscription for the new txs feed. An event is sent
+// for every batch of Ethereum transactions that become pending in the mempool.
+//
+// SubscribeNewTxsEvent implements `core.TxPoolPlugin`.
+func (etp *EthTxPool) SubscribeNewTxsEvent(ch chan<- core.NewTxsEvent) event.Subscription {
+	return etp.scope.Track(etp.txFeed.Subscribe(ch))
+}
+
+// announce queues the given batch of newly pending transactions to be sent to the new txs
+// subscribers by the announce loop. It never blocks on the subscribers.
+func (etp *EthTxPool) announce(txs coretypes.Transactions) {
+	if len(txs) == 0 {
+		return
+	}
+	etp.announceMu.Lock()
+	etp.announced = append(etp.announced, txs...)
+	etp.announceMu.Unlock()
+
+	select {
+	case etp.announceReq <- struct{}{}:
+	default:
+	}
+}
+
+// announceLoop sends the queued transactions to the new txs subscribers, until the mempool is
+// closed. The batches queued while a send is blocked on a slow subscriber are sent together, in
+// order, once it returns.
+func (etp *EthTxPool) announceLoop() {
+	for {
+		select {
+		case <-etp.quit:
+			return
+		case <-etp.announceReq:
+		}
+
+		etp.announceMu.Lock()
+		txs := etp.announced
+		etp.announced = nil
+		etp.announceMu.Unlock()
+		if len(txs) > 0 {
+			etp.txFeed.Send(core.NewTxsEvent{Txs: txs})
+		}
+	}
+}
+
 // SetBaseFee updates the base fee in the priority policy.
 func (etp *EthTxPool) SetBaseFee(baseFee *big.Int) {
 	etp.priorityPolicy.baseFee = baseFee
 }
+
+// Prepare is called at the start of every block, after the NonceRetriever has been prepared for
+// the new block. It refreshes the state nonce of every sender in the mempool, which promotes
+// queued transactions whose nonce gap has been filled and drops transactions whose nonce has
+// been used from the pending set. All promoted transactions are announced in a single batch.
+//
+// Prepare implements `core.TxPoolPlugin`.
+func (etp *EthTxPool) Prepare(context.Context) {
+	if etp.nr == nil {
+		return
+	}
+
+	var promoted coretypes.Transactions
+	etp.mu.Lock()
+	for addr, st := range etp.senders {
+		promoted = append(promoted, st.setStateNonce(etp.nr.GetNonce(addr))...)
+	}
+	etp.mu.Unlock()
+
+	etp.announce(promoted)
+}
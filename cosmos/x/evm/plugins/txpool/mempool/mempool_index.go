@@ -0,0 +1,152 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Blackchain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package mempool
+
+import (
+	"sort"
+
+	"pkg.berachain.dev/jinx/eth/common"
+	coretypes "pkg.berachain.dev/jinx/eth/core/types"
+)
+
+// senderTxs is the per-sender index of the mempool. It keeps the nonces of all of a sender's
+// transactions sorted, along with the sender's Ethereum transactions split into the pending
+// (executable) and queued (nonce gapped) sets.
+type senderTxs struct {
+	// stateNonce is the nonce of the sender as last reported by the NonceRetriever.
+	stateNonce uint64
+
+	// pendingNonce is the first nonce after the contiguous run of nonces starting at
+	// stateNonce, i.e. the next nonce the sender should use.
+	pendingNonce uint64
+
+	// nonces is the sorted list of the nonces of the sender's transactions in the mempool.
+	nonces []uint64
+
+	// txs maps a nonce to the Ethereum transaction with that nonce. Cosmos transactions take up
+	// a nonce in nonces, but map to nil here.
+	txs map[uint64]*coretypes.Transaction
+
+	// pending and queued hold the sender's Ethereum transactions sorted by nonce. They are
+	// rebuilt, never mutated, on every change so that they can be safely handed to readers.
+	pending coretypes.Transactions
+	queued  coretypes.Transactions
+}
+
+// newSenderTxs returns an empty index for a sender with the given state nonce.
+func newSenderTxs(stateNonce uint64) *senderTxs {
+	return &senderTxs{
+		stateNonce:   stateNonce,
+		pendingNonce: stateNonce,
+		txs:          make(map[uint64]*coretypes.Transaction),
+	}
+}
+
+// add adds the transaction with the given nonce to the index, replacing the transaction that
+// previously used this nonce, which is returned along with the transactions that became pending.
+// ethTx is nil for Cosmos transactions.
+func (st *senderTxs) add(
+	nonce uint64, ethTx *coretypes.Transaction,
+) (*coretypes.Transaction, coretypes.Transactions) {
+	i, found := st.search(nonce)
+	replaced := st.txs[nonce]
+	if !found {
+		st.nonces = append(st.nonces, 0)
+		copy(st.nonces[i+1:], st.nonces[i:])
+		st.nonces[i] = nonce
+	}
+	st.txs[nonce] = ethTx
+	return replaced, st.rebuild()
+}
+
+// remove removes the transaction with the given nonce from the index.
+func (st *senderTxs) remove(nonce uint64) {
+	i, found := st.search(nonce)
+	if !found {
+		return
+	}
+	st.nonces = append(st.nonces[:i], st.nonces[i+1:]...)
+	delete(st.txs, nonce)
+	st.rebuild()
+}
+
+// setStateNonce updates the state nonce of the sender and promotes or demotes its transactions
+// accordingly. It returns the transactions that were promoted to the pending set.
+func (st *senderTxs) setStateNonce(nonce uint64) coretypes.Transactions {
+	if st.stateNonce == nonce {
+		return nil
+	}
+	st.stateNonce = nonce
+	return st.rebuild()
+}
+
+// empty returns true if the sender has no transactions in the mempool.
+func (st *senderTxs) empty() bool {
+	return len(st.nonces) == 0
+}
+
+// search returns the position of the given nonce in the sorted nonces and whether it is present.
+func (st *senderTxs) search(nonce uint64) (int, bool) {
+	i := sort.Search(len(st.nonces), func(i int) bool { return st.nonces[i] >= nonce })
+	return i, i < len(st.nonces) && st.nonces[i] == nonce
+}
+
+// rebuild recomputes the pending nonce and the pending and queued sets from the sorted nonces.
+// Transactions with a nonce lower than the state nonce are stale and are in neither set. It
+// returns the transactions that are in the new pending set but were not in the previous one.
+func (st *senderTxs) rebuild() coretypes.Transactions {
+	var (
+		pending, queued, promoted coretypes.Transactions
+		gapped                    bool
+	)
+	wasPending := make(map[common.Hash]struct{}, len(st.pending))
+	for _, ethTx := range st.pending {
+		wasPending[ethTx.Hash()] = struct{}{}
+	}
+	st.pendingNonce = st.stateNonce
+	for _, nonce := range st.nonces {
+		if nonce < st.stateNonce {
+			continue
+		}
+
+		// As long as the nonces are contiguous the transactions are executable, as soon as we
+		// see a gap the rest of the transactions are queued.
+		if gapped = gapped || nonce != st.pendingNonce; !gapped {
+			st.pendingNonce++
+		}
+
+		ethTx := st.txs[nonce]
+		switch {
+		case ethTx == nil:
+			// Cosmos transactions only take up a nonce.
+			continue
+		case gapped:
+			queued = append(queued, ethTx)
+		default:
+			pending = append(pending, ethTx)
+			if _, ok := wasPending[ethTx.Hash()]; !ok {
+				promoted = append(promoted, ethTx)
+			}
+		}
+	}
+	st.pending, st.queued = pending, queued
+	return promoted
+}
@@ -21,162 +21,79 @@
 package mempool
 
 import (
-	"context"
-
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/signing"
 
 	cosmlib "pkg.berachain.dev/jinx/cosmos/lib"
-	evmtypes "pkg.berachain.dev/jinx/cosmos/x/evm/types"
 	"pkg.berachain.dev/jinx/eth/common"
 	coretypes "pkg.berachain.dev/jinx/eth/core/types"
 )
 
 // Get is called when a transaction is retrieved from the mempool.
 func (etp *EthTxPool) Get(hash common.Hash) *coretypes.Transaction {
+	etp.mu.RLock()
+	defer etp.mu.RUnlock()
 	return etp.ethTxCache[hash]
 }
 
-// Pending is called when txs in the mempool are retrieved.
+// Pending is called when txs in the mempool are retrieved. It returns the executable Ethereum
+// txs of every sender, sorted by nonce.
 func (etp *EthTxPool) Pending(bool) map[common.Address]coretypes.Transactions {
 	etp.mu.RLock()
 	defer etp.mu.RUnlock()
 
-	pendingNonces := make(map[common.Address]uint64)
 	pending := make(map[common.Address]coretypes.Transactions)
-
-	for iter := etp.PriorityNonceMempool.Select(context.Background(), nil); iter != nil; iter = iter.Next() {
-		tx := iter.Tx()
-		if ethTx := evmtypes.GetAsEthTx(tx); ethTx != nil {
-			addr := coretypes.GetSender(ethTx)
-			pendingNonce := pendingNonces[addr]
-			switch {
-			case pendingNonce == 0:
-				// If its the first tx, set the pending nonce to the nonce of the tx.
-				txNonce := ethTx.Nonce()
-				// If on the first lookup the nonce delta is more than 0, then there is a gap
-				// and thus no pending transactions, but there are queued transactions. We
-				// continue.
-				if sdbNonce := etp.nr.GetNonce(addr); txNonce-sdbNonce >= 1 {
-					continue
-				}
-				// this is a pending tx, add it to the pending map.
-				pendingNonces[addr] = txNonce
-				pending[addr] = append(pending[addr], ethTx)
-			case ethTx.Nonce() == pendingNonce+1:
-				// If its not the first tx, but the nonce is the same as the pending nonce, add
-				// it to the list.
-				pending[addr] = append(pending[addr], ethTx)
-				pendingNonces[addr] = pendingNonce + 1
-			default:
-				// If we see an out of order nonce, we break since the rest should be "queued".
-				break
-			}
+	for addr, st := range etp.senders {
+		if len(st.pending) > 0 {
+			pending[addr] = st.pending
 		}
 	}
-
 	return pending
 }
 
-// queued retrieves the content of the mempool.
+// queued retrieves the content of the mempool that is not yet executable, because of a nonce
+// gap, sorted by nonce for every sender.
 func (etp *EthTxPool) queued() map[common.Address]coretypes.Transactions {
 	etp.mu.RLock()
 	defer etp.mu.RUnlock()
 
-	pendingNonces := make(map[common.Address]uint64)
 	queued := make(map[common.Address]coretypes.Transactions)
-
-	// After the lock is released we can iterate over the mempool.
-	for iter := etp.PriorityNonceMempool.Select(context.Background(), nil); iter != nil; iter = iter.Next() {
-		if ethTx := evmtypes.GetAsEthTx(iter.Tx()); ethTx != nil {
-			addr := coretypes.GetSender(ethTx)
-			pendingNonce, seenTransaction := pendingNonces[addr]
-			switch {
-			case !seenTransaction:
-				// When we see a transaction, mark it as the pending nonce.
-				pendingNonce = ethTx.Nonce()
-				// If on the first lookup the nonce delta is more than 0, then there is a gap
-				// and thus no pending transactions, but there are queued transactions.
-				if pendingNonce-etp.nr.GetNonce(addr) >= 1 {
-					queued[addr] = append(queued[addr], ethTx)
-				} else {
-					// this is a pending tx, add it to the pending map.
-					pendingNonces[addr] = pendingNonce
-				}
-			case ethTx.Nonce() == pendingNonce+1:
-				// If we are still contiguous and the nonce is the same as the pending nonce,
-				// increment the pending nonce.
-				pendingNonce++
-				pendingNonces[addr] = pendingNonce
-			default:
-				// If we are still contiguous and the nonce is greater than the pending nonce, we are no longer contiguous.
-				// Add to the queued list.
-				queued[addr] = append(queued[addr], ethTx)
-				// All other transactions in the skip list should be queued.
-			}
+	for addr, st := range etp.senders {
+		if len(st.queued) > 0 {
+			queued[addr] = st.queued
 		}
 	}
-
 	return queued
 }
 
 // Nonce returns the nonce for the given address from the mempool if the address has sent a tx
 // in the mempool.
 func (etp *EthTxPool) Nonce(addr common.Address) uint64 {
-	pendingNonces := make(map[common.Address]uint64)
+	var pendingNonce uint64
 	etp.mu.RLock()
-
-	// search for the last pending tx for the given address
-	for iter := etp.PriorityNonceMempool.Select(context.Background(), nil); iter != nil; iter = iter.Next() {
-		txAddr, txNonce := getTxSenderNonce(iter.Tx())
-		if addr != txAddr {
-			continue
-		}
-		pendingNonce, ok := pendingNonces[addr]
-		switch {
-		case !ok:
-			// If on the first lookup the nonce delta is more than 0, then there is a gap
-			// and thus no pending transactions, but there are queued transactions.
-			if sdbNonce := etp.nr.GetNonce(addr); txNonce-sdbNonce >= 1 {
-				return sdbNonce
-			}
-			// this is a pending tx, add it to the pending map.
-			pendingNonces[addr] = txNonce
-		case txNonce == pendingNonce+1:
-			// If we are still contiguous and the nonce is the same as the pending nonce,
-			// increment the pending nonce.
-			pendingNonces[addr]++
-		case txNonce > pendingNonce+1:
-			// As soon as we see a non contiguous nonce we break.
-			break
-		}
+	st, ok := etp.senders[addr]
+	if ok {
+		// The pending nonce is the next nonce after the sender's contiguous run of txs.
+		pendingNonce = st.pendingNonce
 	}
-
-	// We move this here instead of defer as a slight optimization.
 	etp.mu.RUnlock()
 
-	// if the addr has no eth txs, fallback to the nonce retriever db
-	if _, ok := pendingNonces[addr]; !ok {
+	// if the addr has no txs, fallback to the nonce retriever db
+	if !ok {
 		return etp.nr.GetNonce(addr)
 	}
-
-	// pending nonce is 1 more than the current nonce
-	return pendingNonces[addr] + 1
+	return pendingNonce
 }
 
 // Stats returns the number of currently pending and queued (locally created) transactions.
 func (etp *EthTxPool) Stats() (int, int) {
-	var pendingTxsLen, queuedTxsLen int
-	pending, queued := etp.Content()
-
 	etp.mu.RLock()
 	defer etp.mu.RUnlock()
 
-	for _, txs := range pending {
-		pendingTxsLen += len(txs)
-	}
-	for _, txs := range queued {
-		queuedTxsLen += len(txs)
+	var pendingTxsLen, queuedTxsLen int
+	for _, st := range etp.senders {
+		pendingTxsLen += len(st.pending)
+		queuedTxsLen += len(st.queued)
 	}
 	return pendingTxsLen, queuedTxsLen
 }
@@ -184,8 +101,13 @@ func (etp *EthTxPool) Stats() (int, int) {
 // ContentFrom retrieves the data content of the transaction pool, returning the pending as well as
 // queued transactions of this address, grouped by nonce.
 func (etp *EthTxPool) ContentFrom(addr common.Address) (coretypes.Transactions, coretypes.Transactions) {
-	pending, queued := etp.Content()
-	return pending[addr], queued[addr]
+	etp.mu.RLock()
+	defer etp.mu.RUnlock()
+
+	if st, ok := etp.senders[addr]; ok {
+		return st.pending, st.queued
+	}
+	return nil, nil
 }
 
 // Content retrieves the data content of the transaction pool, returning all the pending as well as
@@ -196,11 +118,16 @@ func (etp *EthTxPool) Content() (
 	return etp.Pending(false), etp.queued()
 }
 
-// getTxSenderNonce returns the sender address (as an Eth address) and the nonce of the given tx.
-func getTxSenderNonce(tx sdk.Tx) (common.Address, uint64) {
-	sigs, err := tx.(signing.SigVerifiableTx).GetSignaturesV2()
+// getTxSenderNonce returns the sender address (as an Ethereum address) and the nonce of the first
+// signer of the given transaction, and false if the transaction has no signatures.
+func getTxSenderNonce(tx sdk.Tx) (common.Address, uint64, bool) {
+	sigTx, ok := tx.(signing.SigVerifiableTx)
+	if !ok {
+		return common.Address{}, 0, false
+	}
+	sigs, err := sigTx.GetSignaturesV2()
 	if err != nil || len(sigs) == 0 {
-		return common.Address{}, 0
+		return common.Address{}, 0, false
 	}
-	return cosmlib.AccAddressToEthAddress(sdk.AccAddress(sigs[0].PubKey.Address())), sigs[0].Sequence
+	return cosmlib.AccAddressToEthAddress(sdk.AccAddress(sigs[0].PubKey.Address())), sigs[0].Sequence, true
 }
@@ -79,6 +79,10 @@ var _ = Describe("EthTxPool", func() {
 		etp.SetNonceRetriever(sp)
 	})
 
+	AfterEach(func() {
+		etp.Close()
+	})
+
 	Describe("All Cases", func() {
 		It("should handle empty txs", func() {
 			Expect(etp.Get(common.Hash{})).To(BeNil())
@@ -365,6 +369,154 @@ var _ = Describe("EthTxPool", func() {
 			Expect(etp.Nonce(addr1)).To(BeEquivalentTo(4)) // should not be 10
 		})
 
+		It("should promote queued txs when the state nonce catches up on Prepare", func() {
+			ethTx3, tx3 := buildTx(key1, &coretypes.LegacyTx{Nonce: 3})
+			ethTx4, tx4 := buildTx(key1, &coretypes.LegacyTx{Nonce: 4})
+			Expect(etp.Insert(ctx, tx3)).ToNot(HaveOccurred())
+			Expect(etp.Insert(ctx, tx4)).ToNot(HaveOccurred())
+			Expect(isQueuedTx(etp, ethTx3)).To(BeTrue())
+			Expect(isQueuedTx(etp, ethTx4)).To(BeTrue())
+			Expect(etp.Nonce(addr1)).To(BeEquivalentTo(1))
+
+			// nonces 1 and 2 are used by a block, e.g. by txs that never hit this mempool.
+			sp.SetNonce(addr1, 3)
+			sp.Finalize()
+			sp.Reset(ctx)
+			etp.Prepare(ctx)
+
+			p1, q1 := etp.ContentFrom(addr1)
+			Expect(p1).To(HaveLen(2))
+			Expect(q1).To(BeEmpty())
+			Expect(p1[0].Hash()).To(Equal(ethTx3.Hash()))
+			Expect(p1[1].Hash()).To(Equal(ethTx4.Hash()))
+			Expect(etp.Nonce(addr1)).To(BeEquivalentTo(5))
+		})
+
+		It("should drop stale txs from the pending set on Prepare", func() {
+			_, tx1 := buildTx(key1, &coretypes.LegacyTx{Nonce: 1})
+			ethTx2, tx2 := buildTx(key1, &coretypes.LegacyTx{Nonce: 2})
+			Expect(etp.Insert(ctx, tx1)).ToNot(HaveOccurred())
+			Expect(etp.Insert(ctx, tx2)).ToNot(HaveOccurred())
+			pending, queued := etp.Stats()
+			Expect(pending).To(Equal(2))
+			Expect(queued).To(Equal(0))
+
+			// nonce 1 is used by a block.
+			sp.SetNonce(addr1, 2)
+			sp.Finalize()
+			sp.Reset(ctx)
+			etp.Prepare(ctx)
+
+			p1, q1 := etp.ContentFrom(addr1)
+			Expect(p1).To(HaveLen(1))
+			Expect(q1).To(BeEmpty())
+			Expect(p1[0].Hash()).To(Equal(ethTx2.Hash()))
+			Expect(etp.Nonce(addr1)).To(BeEquivalentTo(3))
+
+			// removing the included tx leaves the index consistent.
+			Expect(etp.Remove(tx1)).ToNot(HaveOccurred())
+			pending, _ = etp.Stats()
+			Expect(pending).To(Equal(1))
+		})
+
+		It("should announce txs as they become pending on Insert", func() {
+			ch := make(chan core.NewTxsEvent, 10)
+			sub := etp.SubscribeNewTxsEvent(ch)
+			defer sub.Unsubscribe()
+
+			ethTx1, tx1 := buildTx(key1, &coretypes.LegacyTx{Nonce: 1})
+			ethTx2, tx2 := buildTx(key1, &coretypes.LegacyTx{Nonce: 2})
+			ethTx3, tx3 := buildTx(key1, &coretypes.LegacyTx{Nonce: 3})
+
+			Expect(etp.Insert(ctx, tx1)).ToNot(HaveOccurred())
+			var ev core.NewTxsEvent
+			Eventually(ch).Should(Receive(&ev))
+			Expect(ev.Txs).To(HaveLen(1))
+			Expect(ev.Txs[0].Hash()).To(Equal(ethTx1.Hash()))
+
+			// a nonce gapped tx is queued and not announced.
+			Expect(etp.Insert(ctx, tx3)).ToNot(HaveOccurred())
+			Consistently(ch).ShouldNot(Receive())
+
+			// filling the gap announces the inserted and the promoted tx as one batch.
+			Expect(etp.Insert(ctx, tx2)).ToNot(HaveOccurred())
+			Eventually(ch).Should(Receive(&ev))
+			Expect(ev.Txs).To(HaveLen(2))
+			Expect(ev.Txs[0].Hash()).To(Equal(ethTx2.Hash()))
+			Expect(ev.Txs[1].Hash()).To(Equal(ethTx3.Hash()))
+			Consistently(ch).ShouldNot(Receive())
+		})
+
+		It("should announce txs promoted on Prepare as one batch", func() {
+			ethTx3, tx3 := buildTx(key1, &coretypes.LegacyTx{Nonce: 3})
+			ethTx4, tx4 := buildTx(key2, &coretypes.LegacyTx{Nonce: 4})
+			Expect(etp.Insert(ctx, tx3)).ToNot(HaveOccurred())
+			Expect(etp.Insert(ctx, tx4)).ToNot(HaveOccurred())
+
+			ch := make(chan core.NewTxsEvent, 10)
+			sub := etp.SubscribeNewTxsEvent(ch)
+			defer sub.Unsubscribe()
+
+			// the nonce gaps of both senders are filled by a block.
+			sp.SetNonce(addr1, 3)
+			sp.SetNonce(addr2, 4)
+			sp.Finalize()
+			sp.Reset(ctx)
+			etp.Prepare(ctx)
+
+			var ev core.NewTxsEvent
+			Eventually(ch).Should(Receive(&ev))
+			hashes := make([]common.Hash, 0, len(ev.Txs))
+			for _, tx := range ev.Txs {
+				hashes = append(hashes, tx.Hash())
+			}
+			Expect(hashes).To(ConsistOf(ethTx3.Hash(), ethTx4.Hash()))
+
+			// nothing is announced when no tx is promoted.
+			etp.Prepare(ctx)
+			Consistently(ch).ShouldNot(Receive())
+		})
+
+		It("should not block on a slow subscriber", func() {
+			ch := make(chan core.NewTxsEvent)
+			sub := etp.SubscribeNewTxsEvent(ch)
+			defer sub.Unsubscribe()
+
+			ethTx1, tx1 := buildTx(key1, &coretypes.LegacyTx{Nonce: 1})
+			ethTx2, tx2 := buildTx(key1, &coretypes.LegacyTx{Nonce: 2})
+			ethTx3, tx3 := buildTx(key1, &coretypes.LegacyTx{Nonce: 3})
+
+			// nobody receives from the unbuffered channel while the txs are inserted.
+			Expect(etp.Insert(ctx, tx1)).ToNot(HaveOccurred())
+			Expect(etp.Insert(ctx, tx2)).ToNot(HaveOccurred())
+			Expect(etp.Insert(ctx, tx3)).ToNot(HaveOccurred())
+			etp.Prepare(ctx)
+
+			// every tx is still announced, in order.
+			var hashes []common.Hash
+			Eventually(func() []common.Hash {
+				select {
+				case ev := <-ch:
+					for _, tx := range ev.Txs {
+						hashes = append(hashes, tx.Hash())
+					}
+				default:
+				}
+				return hashes
+			}).Should(Equal([]common.Hash{ethTx1.Hash(), ethTx2.Hash(), ethTx3.Hash()}))
+		})
+
+		It("should end the subscriptions when closed", func() {
+			ch := make(chan core.NewTxsEvent, 10)
+			sub := etp.SubscribeNewTxsEvent(ch)
+			etp.Close()
+			Eventually(sub.Err()).Should(BeClosed())
+
+			_, tx1 := buildTx(key1, &coretypes.LegacyTx{Nonce: 1})
+			Expect(etp.Insert(ctx, tx1)).ToNot(HaveOccurred())
+			Consistently(ch).ShouldNot(Receive())
+		})
+
 	})
 	Describe("Race Cases", func() {
 		It("should handle concurrent additions", func() {
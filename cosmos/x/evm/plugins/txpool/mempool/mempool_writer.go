@@ -27,44 +27,73 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	evmtypes "pkg.berachain.dev/jinx/cosmos/x/evm/types"
-	"pkg.berachain.dev/jinx/eth/common"
 	coretypes "pkg.berachain.dev/jinx/eth/core/types"
 )
 
-// Insert is called when a transaction is added to the mempool.
+// Insert is called when a transaction is added to the mempool. The Ethereum transactions that
+// become pending, i.e. the inserted transaction and the queued transactions of its sender whose
+// nonce gap it fills, are announced to the new txs subscribers as a single batch.
 func (etp *EthTxPool) Insert(ctx context.Context, tx sdk.Tx) error {
+	promoted, err := etp.insert(ctx, tx)
+	if err != nil {
+		return err
+	}
+	etp.announce(promoted)
+	return nil
+}
+
+// insert adds the transaction to the mempool and the sender index, returning the Ethereum
+// transactions that were promoted to the pending set.
+func (etp *EthTxPool) insert(ctx context.Context, tx sdk.Tx) (coretypes.Transactions, error) {
 	etp.mu.Lock()
 	defer etp.mu.Unlock()
 
+	sender, nonce, ok := getTxSenderNonce(tx)
+	ethTx := evmtypes.GetAsEthTx(tx)
+	if ethTx != nil {
+		// Blob transactions are not supported.
+		if coretypes.IsBlobTx(ethTx) {
+			return nil, coretypes.ErrBlobTxNotSupported
+		}
+		sender, nonce, ok = coretypes.GetSender(ethTx), ethTx.Nonce(), true
+	}
+
+	// Reject Ethereum txs with a nonce lower than the nonce reported by the statedb.
+	var sdbNonce uint64
+	if ok {
+		if sdbNonce = etp.nr.GetNonce(sender); ethTx != nil && sdbNonce > nonce {
+			return nil, errors.New("nonce too low")
+		}
+	}
+
 	// Call the base mempool's Insert method
 	if err := etp.PriorityNonceMempool.Insert(ctx, tx); err != nil {
-		return err
+		return nil, err
 	}
 
-	// We want to cache the transaction for lookup.
-	if ethTx := evmtypes.GetAsEthTx(tx); ethTx != nil {
-		sender := coretypes.GetSender(ethTx)
-		nonce := ethTx.Nonce()
-
-		// Reject txs with a nonce lower than the nonce reported by the statedb.
-		if sdbNonce := etp.nr.GetNonce(sender); sdbNonce > nonce {
-			return errors.New("nonce too low")
-		}
+	// Without a sender and nonce there is nothing to index.
+	if !ok {
+		return nil, nil
+	}
 
-		// Delete old hash.
-		hash := etp.nonceToHash[sender][nonce]
-		delete(etp.ethTxCache, hash)
+	// Add the tx to the sender's index, which also refreshes the sender's state nonce.
+	st := etp.senders[sender]
+	if st == nil {
+		st = newSenderTxs(sdbNonce)
+		etp.senders[sender] = st
+	}
+	promoted := st.setStateNonce(sdbNonce)
 
-		// Add new hash.
-		newHash := ethTx.Hash()
-		if etp.nonceToHash[sender] == nil {
-			etp.nonceToHash[sender] = make(map[uint64]common.Hash)
-		}
-		etp.nonceToHash[sender][nonce] = newHash
-		etp.ethTxCache[newHash] = ethTx
+	// We want to cache the transaction for lookup, replacing the old one with the same nonce.
+	replaced, added := st.add(nonce, ethTx)
+	if replaced != nil {
+		delete(etp.ethTxCache, replaced.Hash())
+	}
+	if ethTx != nil {
+		etp.ethTxCache[ethTx.Hash()] = ethTx
 	}
 
-	return nil
+	return append(promoted, added...), nil
 }
 
 // Remove is called when a transaction is removed from the mempool.
@@ -77,10 +106,21 @@ func (etp *EthTxPool) Remove(tx sdk.Tx) error {
 		return err
 	}
 
-	// We want to remove any references to the tx from the cache.
-	if ethTx := evmtypes.GetAsEthTx(tx); ethTx != nil {
+	sender, nonce, ok := getTxSenderNonce(tx)
+	ethTx := evmtypes.GetAsEthTx(tx)
+	if ethTx != nil {
+		// We want to remove any references to the tx from the cache.
 		delete(etp.ethTxCache, ethTx.Hash())
-		delete(etp.nonceToHash[coretypes.GetSender(ethTx)], ethTx.Nonce())
+		sender, nonce, ok = coretypes.GetSender(ethTx), ethTx.Nonce(), true
+	}
+
+	// Remove the tx from the sender's index. The remaining txs of the sender are promoted once
+	// the state nonce is refreshed in Prepare.
+	if st := etp.senders[sender]; ok && st != nil {
+		st.remove(nonce)
+		if st.empty() {
+			delete(etp.senders, sender)
+		}
 	}
 
 	return nil
@@ -0,0 +1,152 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Blackchain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package txpool
+
+import (
+	"context"
+	"time"
+
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	cmtrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+
+	"github.com/ethereum/go-ethereum"
+)
+
+const (
+	// netQueryTimeout bounds the CometBFT queries that back the sync and networking APIs.
+	netQueryTimeout = 2 * time.Second
+
+	// blockTimeWindow is the number of recent blocks used to estimate the average block time of
+	// the network when the node is catching up.
+	blockTimeWindow = 20
+)
+
+// SyncProgress implements core.TxPoolPlugin. It returns the sync progress reported by the
+// CometBFT node. If the node is catching up, the highest block of the network is estimated from
+// the time elapsed since the latest block and the average block time of the recent blocks.
+func (p *plugin) SyncProgress() ethereum.SyncProgress {
+	ctx, cancel := context.WithTimeout(context.Background(), netQueryTimeout)
+	defer cancel()
+
+	node, err := p.clientContext.GetNode()
+	if err != nil {
+		p.logger.Error("failed to get node for sync progress", "err", err)
+		return ethereum.SyncProgress{}
+	}
+	status, err := node.Status(ctx)
+	if err != nil {
+		p.logger.Error("failed to query node status for sync progress", "err", err)
+		return ethereum.SyncProgress{}
+	}
+
+	latest := uint64(status.SyncInfo.LatestBlockHeight)
+	progress := ethereum.SyncProgress{
+		StartingBlock: uint64(status.SyncInfo.EarliestBlockHeight),
+		CurrentBlock:  latest,
+		HighestBlock:  latest,
+	}
+	if status.SyncInfo.CatchingUp {
+		progress.HighestBlock = estimateHighestBlock(ctx, node, &status.SyncInfo)
+	}
+	return progress
+}
+
+// PeerCount implements core.TxPoolPlugin. It returns the number of peers the CometBFT node is
+// connected to, or 0 if the net info cannot be queried.
+func (p *plugin) PeerCount() uint64 {
+	netInfo := p.netInfo()
+	if netInfo == nil {
+		return 0
+	}
+	return uint64(netInfo.NPeers)
+}
+
+// Listening implements core.TxPoolPlugin. It returns whether the CometBFT node is listening for
+// p2p connections, or false if the net info cannot be queried.
+func (p *plugin) Listening() bool {
+	netInfo := p.netInfo()
+	if netInfo == nil {
+		return false
+	}
+	return netInfo.Listening
+}
+
+// netInfo queries the net info of the CometBFT node. It returns nil if the node does not expose
+// the network RPCs or the query fails.
+func (p *plugin) netInfo() *cmtrpctypes.ResultNetInfo {
+	ctx, cancel := context.WithTimeout(context.Background(), netQueryTimeout)
+	defer cancel()
+
+	node, err := p.clientContext.GetNode()
+	if err != nil {
+		p.logger.Error("failed to get node for net info", "err", err)
+		return nil
+	}
+	netClient, ok := node.(rpcclient.NetworkClient)
+	if !ok {
+		return nil
+	}
+	netInfo, err := netClient.NetInfo(ctx)
+	if err != nil {
+		p.logger.Error("failed to query node net info", "err", err)
+		return nil
+	}
+	return netInfo
+}
+
+// estimateHighestBlock estimates the highest block of the network for a node that is catching
+// up. A catching up node is always assumed to be at least one block behind the network.
+func estimateHighestBlock(
+	ctx context.Context, node client.CometRPC, syncInfo *cmtrpctypes.SyncInfo,
+) uint64 {
+	latest := syncInfo.LatestBlockHeight
+	highest := latest + 1
+
+	minHeight := latest - blockTimeWindow
+	if minHeight < syncInfo.EarliestBlockHeight {
+		minHeight = syncInfo.EarliestBlockHeight
+	}
+	if minHeight >= latest {
+		return uint64(highest)
+	}
+
+	// The block metas are returned in descending order of height.
+	res, err := node.BlockchainInfo(ctx, minHeight, latest)
+	if err != nil || len(res.BlockMetas) < 2 {
+		return uint64(highest)
+	}
+	newest := res.BlockMetas[0].Header
+	oldest := res.BlockMetas[len(res.BlockMetas)-1].Header
+	if newest.Height <= oldest.Height {
+		return uint64(highest)
+	}
+
+	blockTime := newest.Time.Sub(oldest.Time) / time.Duration(newest.Height-oldest.Height)
+	if blockTime <= 0 {
+		return uint64(highest)
+	}
+	if estimate := latest + int64(time.Since(syncInfo.LatestBlockTime)/blockTime); estimate > highest {
+		highest = estimate
+	}
+	return uint64(highest)
+}
@@ -0,0 +1,91 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Blackchain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package txpool
+
+import (
+	"context"
+	"time"
+
+	cmtrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// mockCometRPC serves the block metas of a chain with a constant block time.
+type mockCometRPC struct {
+	client.CometRPC
+	genesis   time.Time
+	blockTime time.Duration
+}
+
+func (m *mockCometRPC) BlockchainInfo(
+	_ context.Context, minHeight, maxHeight int64,
+) (*cmtrpctypes.ResultBlockchainInfo, error) {
+	res := &cmtrpctypes.ResultBlockchainInfo{LastHeight: maxHeight}
+	for height := maxHeight; height >= minHeight; height-- {
+		res.BlockMetas = append(res.BlockMetas, &cmttypes.BlockMeta{
+			Header: cmttypes.Header{
+				Height: height,
+				Time:   m.genesis.Add(time.Duration(height) * m.blockTime),
+			},
+		})
+	}
+	return res, nil
+}
+
+var _ = Describe("Network", func() {
+	var node *mockCometRPC
+
+	BeforeEach(func() {
+		node = &mockCometRPC{blockTime: 2 * time.Second}
+	})
+
+	It("should estimate the highest block from the average block time", func() {
+		node.genesis = time.Now().Add(-200 * time.Second)
+		syncInfo := &cmtrpctypes.SyncInfo{
+			LatestBlockHeight:   50,
+			LatestBlockTime:     node.genesis.Add(50 * node.blockTime),
+			EarliestBlockHeight: 1,
+			CatchingUp:          true,
+		}
+		// 100 seconds have passed since the latest block, which is 50 blocks at 2s per block.
+		Expect(estimateHighestBlock(context.Background(), node, syncInfo)).
+			To(BeNumerically("~", 100, 1))
+	})
+
+	It("should be at least one block ahead of the latest block", func() {
+		node.genesis = time.Now().Add(-100 * time.Second)
+		syncInfo := &cmtrpctypes.SyncInfo{
+			LatestBlockHeight:   50,
+			LatestBlockTime:     node.genesis.Add(50 * node.blockTime),
+			EarliestBlockHeight: 1,
+			CatchingUp:          true,
+		}
+		Expect(estimateHighestBlock(context.Background(), node, syncInfo)).To(Equal(uint64(51)))
+
+		syncInfo.EarliestBlockHeight = 50
+		Expect(estimateHighestBlock(context.Background(), node, syncInfo)).To(Equal(uint64(51)))
+	})
+})
@@ -21,16 +21,19 @@
 package txpool
 
 import (
+	"sync"
+	"sync/atomic"
+
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/log"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
-	"github.com/ethereum/go-ethereum/event"
-
 	"pkg.berachain.dev/jinx/cosmos/x/evm/plugins"
 	mempool "pkg.berachain.dev/jinx/cosmos/x/evm/plugins/txpool/mempool"
+	"pkg.berachain.dev/jinx/eth/common"
 	"pkg.berachain.dev/jinx/eth/core"
 	coretypes "pkg.berachain.dev/jinx/eth/core/types"
 	errorslib "pkg.berachain.dev/jinx/lib/errors"
@@ -44,7 +47,11 @@ type Plugin interface {
 	plugins.Base
 	core.TxPoolPlugin
 	SetNonceRetriever(mempool.NonceRetriever)
+	SetLocalsNonceRetriever(mempool.NonceRetriever)
 	SetClientContext(client.Context)
+	LoadJournal(string) error
+	Start(log.Logger)
+	Stop() error
 }
 
 // plugin represents the transaction pool plugin.
@@ -53,33 +60,84 @@ type plugin struct {
 
 	clientContext client.Context
 
-	// txFeed and scope is used to send new batch transactions to new txs subscribers when the
-	// batch is added to the mempool.
-	txFeed event.Feed
-	scope  event.SubscriptionScope
+	// localsNR is used to retrieve the nonces of the senders of local transactions. It is read
+	// from the loop goroutine, so it must not read the state of the block being executed.
+	localsNR mempool.NonceRetriever
+
+	// locals holds the transactions submitted through this node, which are journaled and
+	// resubmitted until they are included or made stale by nonce.
+	locals   map[common.Hash]*journalEntry
+	journal  *journal
+	localsMu sync.Mutex
+	logger   log.Logger
+
+	// quit stops the loop and done is closed once it has returned.
+	quit     chan struct{}
+	done     chan struct{}
+	started  atomic.Bool
+	stopOnce sync.Once
 }
 
 // NewPlugin returns a new transaction pool plugin.
 func NewPlugin(ethTxMempool *mempool.EthTxPool) Plugin {
 	return &plugin{
 		EthTxPool: ethTxMempool,
+		locals:    make(map[common.Hash]*journalEntry),
+		logger:    log.NewNopLogger(),
+		quit:      make(chan struct{}),
+		done:      make(chan struct{}),
 	}
 }
 
+// SetNonceRetriever implements the Plugin interface.
+func (p *plugin) SetNonceRetriever(nr mempool.NonceRetriever) {
+	p.EthTxPool.SetNonceRetriever(nr)
+}
+
+// SetLocalsNonceRetriever sets the nonce retriever used to drop the local transactions that
+// have been included. It is used concurrently with block execution, so it should read the last
+// committed state.
+func (p *plugin) SetLocalsNonceRetriever(nr mempool.NonceRetriever) {
+	p.localsNR = nr
+}
+
 // SetClientContext implements the Plugin interface.
 func (p *plugin) SetClientContext(ctx client.Context) {
 	p.clientContext = ctx
 }
 
-// SubscribeNewTxsEvent returns a new event subscription for the new txs feed.
-func (p *plugin) SubscribeNewTxsEvent(ch chan<- core.NewTxsEvent) event.Subscription {
-	return p.scope.Track(p.txFeed.Subscribe(ch))
-}
-
 // SendTx sends a transaction to the transaction pool. It takes in a signed Ethereum transaction
 // from the rpc backend and wraps it in a Cosmos transaction. The Cosmos transaction is then
-// broadcasted to the network.
+// broadcasted to the network. Once it has been accepted, the transaction is tracked as a local
+// transaction, so it is rebroadcast if it drops out of the mempool. New txs subscribers are
+// notified by the mempool once the transaction is inserted and pending.
 func (p *plugin) SendTx(signedEthTx *coretypes.Transaction) error {
+	if coretypes.IsBlobTx(signedEthTx) {
+		return coretypes.ErrBlobTxNotSupported
+	}
+	if err := p.broadcastTx(signedEthTx); err != nil {
+		return err
+	}
+	p.trackLocal(&journalEntry{Tx: signedEthTx})
+	return nil
+}
+
+// SendPrivTx sends a private transaction to the transaction pool. It takes in a signed ethereum
+// transaction from the rpc backend and wraps it in a Cosmos transaction. The Cosmos transaction is
+// injected into the local mempool, but is NOT gossiped to peers.
+func (p *plugin) SendPrivTx(signedTx *coretypes.Transaction) error {
+	if coretypes.IsBlobTx(signedTx) {
+		return coretypes.ErrBlobTxNotSupported
+	}
+	if err := p.insertPrivTx(signedTx); err != nil {
+		return err
+	}
+	p.trackLocal(&journalEntry{Tx: signedTx, Private: true})
+	return nil
+}
+
+// broadcastTx serializes the given transaction and broadcasts it to the CometBFT mempool.
+func (p *plugin) broadcastTx(signedEthTx *coretypes.Transaction) error {
 	// Serialize the transaction to Bytes
 	txBytes, err := SerializeToBytes(p.clientContext, signedEthTx)
 	if err != nil {
@@ -93,23 +151,11 @@ func (p *plugin) SendTx(signedEthTx *coretypes.Transaction) error {
 	if rsp != nil && rsp.Code != 0 {
 		err = errorsmod.ABCIError(rsp.Codespace, rsp.Code, rsp.RawLog)
 	}
-	if err != nil {
-		// b.logger.Error("failed to broadcast tx", "error", err.Errsor())
-		return err
-	}
-
-	// Currently sending an individual new txs event for every new tx added to the mempool via
-	// broadcast.
-	// TODO: support sending batch new txs events when adding queued txs to the pending txs.
-	// TODO: move to mempool?
-	p.txFeed.Send(core.NewTxsEvent{Txs: coretypes.Transactions{signedEthTx}})
-	return nil
+	return err
 }
 
-// SendPrivTx sends a private transaction to the transaction pool. It takes in a signed ethereum
-// transaction from the rpc backend and wraps it in a Cosmos transaction. The Cosmos transaction is
-// injected into the local mempool, but is NOT gossiped to peers.
-func (p *plugin) SendPrivTx(signedTx *coretypes.Transaction) error {
+// insertPrivTx serializes the given transaction and inserts it into the local mempool only.
+func (p *plugin) insertPrivTx(signedTx *coretypes.Transaction) error {
 	cosmosTx, err := SerializeToSdkTx(p.clientContext, signedTx)
 	if err != nil {
 		return err
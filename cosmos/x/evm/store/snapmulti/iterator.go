@@ -0,0 +1,155 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Blackchain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package snapmulti
+
+import (
+	"bytes"
+
+	storetypes "cosmossdk.io/store/types"
+)
+
+// Compile-time type assertion.
+var _ storetypes.Iterator = (*mergeIterator)(nil)
+
+// dirtyPair is a key and its dirty value. A nil value marks a deleted key.
+type dirtyPair struct {
+	key   []byte
+	value []byte
+}
+
+// mergeIterator iterates over the dirty pairs of a `journalStore` merged with an iterator over
+// the committed KV store. Dirty pairs shadow the committed pairs with the same key and deleted
+// keys are skipped.
+type mergeIterator struct {
+	parent    storetypes.Iterator
+	dirty     []*dirtyPair
+	ascending bool
+}
+
+// newMergeIterator creates a new `mergeIterator`. The dirty pairs must be in iteration order.
+func newMergeIterator(
+	parent storetypes.Iterator, dirty []*dirtyPair, ascending bool,
+) *mergeIterator {
+	it := &mergeIterator{
+		parent:    parent,
+		dirty:     dirty,
+		ascending: ascending,
+	}
+	it.skipUntilExistsOrInvalid()
+	return it
+}
+
+// Domain implements `storetypes.Iterator`.
+func (it *mergeIterator) Domain() ([]byte, []byte) {
+	return it.parent.Domain()
+}
+
+// Valid implements `storetypes.Iterator`.
+func (it *mergeIterator) Valid() bool {
+	return it.parent.Valid() || len(it.dirty) > 0
+}
+
+// Next implements `storetypes.Iterator`.
+func (it *mergeIterator) Next() {
+	if !it.Valid() {
+		panic("iterator is invalid")
+	}
+	if it.onDirty() {
+		it.dirty = it.dirty[1:]
+	} else {
+		it.parent.Next()
+	}
+	it.skipUntilExistsOrInvalid()
+}
+
+// Key implements `storetypes.Iterator`.
+func (it *mergeIterator) Key() []byte {
+	if !it.Valid() {
+		panic("iterator is invalid")
+	}
+	if it.onDirty() {
+		return it.dirty[0].key
+	}
+	return it.parent.Key()
+}
+
+// Value implements `storetypes.Iterator`.
+func (it *mergeIterator) Value() []byte {
+	if !it.Valid() {
+		panic("iterator is invalid")
+	}
+	if it.onDirty() {
+		return it.dirty[0].value
+	}
+	return it.parent.Value()
+}
+
+// Error implements `storetypes.Iterator`.
+func (it *mergeIterator) Error() error {
+	return it.parent.Error()
+}
+
+// Close implements `storetypes.Iterator`.
+func (it *mergeIterator) Close() error {
+	return it.parent.Close()
+}
+
+// onDirty returns whether the current pair is a dirty pair. It assumes the iterator is valid and
+// that the current keys of the parent and the dirty pairs are not equal.
+func (it *mergeIterator) onDirty() bool {
+	if len(it.dirty) == 0 {
+		return false
+	}
+	if !it.parent.Valid() {
+		return true
+	}
+	return it.compare(it.dirty[0].key, it.parent.Key()) < 0
+}
+
+// compare compares two keys in iteration order.
+func (it *mergeIterator) compare(a, b []byte) int {
+	if it.ascending {
+		return bytes.Compare(a, b)
+	}
+	return bytes.Compare(b, a)
+}
+
+// skipUntilExistsOrInvalid advances the iterator past the committed keys that are shadowed by a
+// dirty pair and past the deleted keys, until it points to an existing pair or is invalid.
+func (it *mergeIterator) skipUntilExistsOrInvalid() {
+	for len(it.dirty) > 0 {
+		if it.parent.Valid() {
+			switch cmp := it.compare(it.dirty[0].key, it.parent.Key()); {
+			case cmp > 0:
+				// The committed pair is next.
+				return
+			case cmp == 0:
+				// The dirty pair shadows the committed pair.
+				it.parent.Next()
+			}
+		}
+		if it.dirty[0].value != nil {
+			return
+		}
+		// The dirty pair is a deleted key.
+		it.dirty = it.dirty[1:]
+	}
+}
@@ -0,0 +1,182 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Blackchain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package snapmulti
+
+import (
+	"bytes"
+	"io"
+	"sort"
+
+	"cosmossdk.io/store/cachekv"
+	"cosmossdk.io/store/tracekv"
+	storetypes "cosmossdk.io/store/types"
+)
+
+// Compile-time type assertion.
+var _ storetypes.KVStore = (*journalStore)(nil)
+
+// dirtyValue is a value written to a `journalStore`. A nil value marks a deleted key.
+type dirtyValue struct {
+	value []byte
+}
+
+// journalEntry records the dirty value of a key in a `journalStore` before it was changed.
+type journalEntry struct {
+	js   *journalStore
+	key  string
+	prev *dirtyValue // nil if the key was not dirty
+}
+
+// revert restores the dirty value of the key to what it was before the change.
+func (je *journalEntry) revert() {
+	if je.prev == nil {
+		delete(je.js.dirty, je.key)
+		return
+	}
+	je.js.dirty[je.key] = je.prev
+}
+
+// journalStore is a flat write-set on top of a committed KV store. Changes are journaled in the
+// undo journal of the `store` it belongs to.
+type journalStore struct {
+	ms     *store
+	parent storetypes.KVStore
+	dirty  map[string]*dirtyValue
+}
+
+// newJournalStore creates a new, empty write-set on top of `parent`.
+func newJournalStore(ms *store, parent storetypes.KVStore) *journalStore {
+	return &journalStore{
+		ms:     ms,
+		parent: parent,
+		dirty:  make(map[string]*dirtyValue),
+	}
+}
+
+// GetStoreType implements `storetypes.KVStore`.
+func (js *journalStore) GetStoreType() storetypes.StoreType {
+	return js.parent.GetStoreType()
+}
+
+// Get implements `storetypes.KVStore`.
+func (js *journalStore) Get(key []byte) []byte {
+	storetypes.AssertValidKey(key)
+	if dv, found := js.dirty[string(key)]; found {
+		return dv.value
+	}
+	return js.parent.Get(key)
+}
+
+// Has implements `storetypes.KVStore`.
+func (js *journalStore) Has(key []byte) bool {
+	storetypes.AssertValidKey(key)
+	if dv, found := js.dirty[string(key)]; found {
+		return dv.value != nil
+	}
+	return js.parent.Has(key)
+}
+
+// Set implements `storetypes.KVStore`.
+func (js *journalStore) Set(key, value []byte) {
+	storetypes.AssertValidKey(key)
+	storetypes.AssertValidValue(value)
+	js.setDirty(string(key), value)
+}
+
+// Delete implements `storetypes.KVStore`.
+func (js *journalStore) Delete(key []byte) {
+	storetypes.AssertValidKey(key)
+	js.setDirty(string(key), nil)
+}
+
+// setDirty journals the previous dirty value of `key` and sets it to `value`.
+func (js *journalStore) setDirty(key string, value []byte) {
+	js.ms.journal.Push(&journalEntry{js: js, key: key, prev: js.dirty[key]})
+	js.dirty[key] = &dirtyValue{value: value}
+}
+
+// Iterator implements `storetypes.KVStore`.
+func (js *journalStore) Iterator(start, end []byte) storetypes.Iterator {
+	return js.iterator(start, end, true)
+}
+
+// ReverseIterator implements `storetypes.KVStore`.
+func (js *journalStore) ReverseIterator(start, end []byte) storetypes.Iterator {
+	return js.iterator(start, end, false)
+}
+
+// iterator returns an iterator over the write-set merged with the committed KV store.
+func (js *journalStore) iterator(start, end []byte, ascending bool) storetypes.Iterator {
+	var parent storetypes.Iterator
+	if ascending {
+		parent = js.parent.Iterator(start, end)
+	} else {
+		parent = js.parent.ReverseIterator(start, end)
+	}
+	return newMergeIterator(parent, js.dirtyPairs(start, end, ascending), ascending)
+}
+
+// dirtyPairs returns the dirty keys in the domain [start, end) in iteration order.
+func (js *journalStore) dirtyPairs(start, end []byte, ascending bool) []*dirtyPair {
+	pairs := make([]*dirtyPair, 0)
+	for key, dv := range js.dirty {
+		bz := []byte(key)
+		if (start != nil && bytes.Compare(bz, start) < 0) || (end != nil && bytes.Compare(bz, end) >= 0) {
+			continue
+		}
+		pairs = append(pairs, &dirtyPair{key: bz, value: dv.value})
+	}
+	sort.Slice(pairs, func(i, j int) bool {
+		if ascending {
+			return bytes.Compare(pairs[i].key, pairs[j].key) < 0
+		}
+		return bytes.Compare(pairs[i].key, pairs[j].key) > 0
+	})
+	return pairs
+}
+
+// CacheWrap implements `storetypes.CacheWrapper`.
+func (js *journalStore) CacheWrap() storetypes.CacheWrap {
+	return cachekv.NewStore(js)
+}
+
+// CacheWrapWithTrace implements `storetypes.CacheWrapper`.
+func (js *journalStore) CacheWrapWithTrace(w io.Writer, tc storetypes.TraceContext) storetypes.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(js, w, tc))
+}
+
+// write writes the write-set to the committed KV store, in order of the keys, and clears it.
+func (js *journalStore) write() {
+	keys := make([]string, 0, len(js.dirty))
+	for key := range js.dirty {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if value := js.dirty[key].value; value != nil {
+			js.parent.Set([]byte(key), value)
+		} else {
+			js.parent.Delete([]byte(key))
+		}
+	}
+	js.dirty = make(map[string]*dirtyValue)
+}
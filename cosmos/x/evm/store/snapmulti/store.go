@@ -21,12 +21,12 @@
 package snapmulti
 
 import (
-	"cosmossdk.io/store/cachekv"
+	"sort"
+
 	storetypes "cosmossdk.io/store/types"
 
 	"pkg.berachain.dev/jinx/lib/ds"
 	"pkg.berachain.dev/jinx/lib/ds/stack"
-	"pkg.berachain.dev/jinx/lib/utils"
 )
 
 const (
@@ -34,29 +34,26 @@ const (
 	initJournalCapacity = 16
 )
 
-// mapMultiStore represents a cached multistore, which is just a map of store keys to its
-// corresponding cache kv store currently being used.
-type mapMultiStore map[storetypes.StoreKey]storetypes.CacheKVStore
-
 // store is a wrapper around the Cosmos SDK `MultiStore` which supports snapshots and reverts.
-// It journals revisions by cache-wrapping the cachekv stores on a call to `Snapshot`. In this
-// store's lifecycle, any operations done before the first call to snapshot will be enforced on the
-// root `mapMultiStore`.
+// Every store key that is used gets a single flat write-set, a `journalStore`, on top of the
+// committed KV store. Every write to a `journalStore` records the previous dirty value of the key
+// in an undo journal that is shared by all stores, so taking a snapshot is O(1), reverting is
+// O(changes since the snapshot) and reads are O(1).
 type store struct {
 	// MultiStore is the underlying multistore
 	storetypes.MultiStore
-	// root is the mapMultiStore used before the first snapshot is called
-	root mapMultiStore
-	// journal holds the snapshots of cachemultistores
-	journal ds.Stack[mapMultiStore]
+	// stores holds the write-sets of the stores used
+	stores map[storetypes.StoreKey]*journalStore
+	// journal holds the changes made to the write-sets, in order
+	journal ds.Stack[*journalEntry]
 }
 
 // NewStoreFrom creates and returns a new `store` from a given Multistore `ms`.
 func NewStoreFrom(ms storetypes.MultiStore) *store { //nolint:revive // its okay.
 	return &store{
 		MultiStore: ms,
-		root:       make(mapMultiStore),
-		journal:    stack.New[mapMultiStore](initJournalCapacity),
+		stores:     make(map[storetypes.StoreKey]*journalStore),
+		journal:    stack.New[*journalEntry](initJournalCapacity),
 	}
 }
 
@@ -73,64 +70,46 @@ func (s *store) GetCommittedKVStore(key storetypes.StoreKey) storetypes.KVStore
 
 // GetKVStore shadows the SDK's `storetypes.MultiStore` function. Routes native module calls to
 // read the dirty state during an eth tx. Any state that is modified by evm statedb, and using the
-// context passed in to StateDB, will be routed to a tx-specific cache kv store.
+// context passed in to StateDB, will be routed to a tx-specific journaled write-set.
 func (s *store) GetKVStore(key storetypes.StoreKey) storetypes.KVStore {
-	var cms mapMultiStore
-	if cms = s.journal.Peek(); cms == nil {
-		// use root if the journal is empty
-		cms = s.root
+	// check if the write-set of the store is already used
+	if js, found := s.stores[key]; found {
+		return js
 	}
 
-	// check if cache kv store already used
-	if cacheKVStore, found := cms[key]; found {
-		return cacheKVStore
-	}
-
-	// get kvstore from mapMultiStore and set cachekv to memory
-	cms[key] = cachekv.NewStore(s.GetCommittedKVStore(key))
-	return cms[key]
+	s.stores[key] = newJournalStore(s, s.GetCommittedKVStore(key))
+	return s.stores[key]
 }
 
 // Snapshot implements `libtypes.Snapshottable`.
 func (s *store) Snapshot() int {
-	cms := s.journal.Peek()
-	if cms == nil {
-		// use root if the journal is empty
-		cms = s.root
-	}
-
-	// build revision of cms by cachewrapping each cachekv store
-	revision := make(mapMultiStore)
-	for key, cacheKVStore := range cms {
-		revision[key] = utils.MustGetAs[storetypes.CacheKVStore](cacheKVStore.CacheWrap())
-	}
-
-	// push the revision to the journal and return the size BEFORE snapshot
-	return s.journal.Push(revision) - 1
+	// the snapshot is the size of the journal, i.e. the number of changes so far.
+	return s.journal.Size()
 }
 
 // Revert implements `libtypes.Snapshottable`.
 func (s *store) RevertToSnapshot(id int) {
-	// id is the new size of the journal we want to maintain.
-	s.journal.PopToSize(id)
+	// id is the size of the journal we want to maintain, so undo the changes after it in
+	// reverse order.
+	for s.journal.Size() > id {
+		s.journal.Pop().revert()
+	}
 }
 
-// Finalize commits each of the individual cachekv stores to its corresponding parent cachekv stores
-// in the journal. Finally it commits the root cachekv stores.
+// Finalize writes each of the write-sets to its corresponding committed KV store and clears the
+// write-sets and the journal.
 //
 // Finalize implements `libtypes.Controllable`.
 func (s *store) Finalize() {
-	// Recursively pop the journal and write each cachekv store to its parent cachekv store.
-	for revision := s.journal.Pop(); revision != nil; revision = s.journal.Pop() {
-		for key, cacheKVStore := range revision {
-			cacheKVStore.Write()
-			delete(revision, key)
-		}
+	// Write the stores in a deterministic order.
+	keys := make([]storetypes.StoreKey, 0, len(s.stores))
+	for key := range s.stores {
+		keys = append(keys, key)
 	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i].Name() < keys[j].Name() })
 
-	// We must handle the root separately.
-	for key, cacheKVStore := range s.root {
-		cacheKVStore.Write()
-		delete(s.root, key)
+	for _, key := range keys {
+		s.stores[key].write()
 	}
+	s.journal = stack.New[*journalEntry](initJournalCapacity)
 }
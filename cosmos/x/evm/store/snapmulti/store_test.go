@@ -26,7 +26,6 @@ import (
 
 	dbm "github.com/cosmos/cosmos-db"
 
-	sdkcachekv "cosmossdk.io/store/cachekv"
 	sdkcachemulti "cosmossdk.io/store/cachemulti"
 	"cosmossdk.io/store/dbadapter"
 	storetypes "cosmossdk.io/store/types"
@@ -73,8 +72,8 @@ var _ = Describe("Snapmulti Store", func() {
 
 	It("CorrectStoreType", func() {
 		// Test that the correct store type is returned
-		Expect(reflect.TypeOf(cms.GetKVStore(evmStoreKey))).To(Equal(reflect.TypeOf(&sdkcachekv.Store{})))
-		Expect(reflect.TypeOf(cms.GetKVStore(accStoreKey))).To(Equal(reflect.TypeOf(&sdkcachekv.Store{})))
+		Expect(reflect.TypeOf(cms.GetKVStore(evmStoreKey))).To(Equal(reflect.TypeOf(&journalStore{})))
+		Expect(reflect.TypeOf(cms.GetKVStore(accStoreKey))).To(Equal(reflect.TypeOf(&journalStore{})))
 	})
 
 	It("TestWrite", func() {
@@ -140,5 +139,65 @@ var _ = Describe("Snapmulti Store", func() {
 			cms.Finalize()
 			Expect(accStoreParent.Get(byte1)).To(Equal([]byte{2}))
 		})
+
+		It("should revert deletes and keys that were not dirty", func() {
+			cms.GetKVStore(accStoreKey).Delete(byte1)
+			cms.GetKVStore(evmStoreKey).Set(byte1, byte1)
+			Expect(cms.GetKVStore(accStoreKey).Has(byte1)).To(BeFalse())
+
+			cms.RevertToSnapshot(snapshot1)
+			Expect(cms.GetKVStore(accStoreKey).Get(byte1)).To(Equal(byte1))
+			Expect(cms.GetKVStore(evmStoreKey).Has(byte1)).To(BeFalse())
+
+			cms.Finalize()
+			Expect(accStoreParent.Get(byte1)).To(Equal(byte1))
+			Expect(evmStoreParent.Has(byte1)).To(BeFalse())
+		})
+	})
+
+	When("iterating", func() {
+		BeforeEach(func() {
+			for i := byte(1); i <= 4; i++ {
+				evmStoreParent.Set([]byte{i}, []byte{i})
+			}
+			evmStoreCache.Set([]byte{2}, []byte{20})
+			evmStoreCache.Delete([]byte{3})
+			evmStoreCache.Set([]byte{5}, []byte{5})
+		})
+
+		collect := func(it storetypes.Iterator) [][]byte {
+			defer it.Close()
+			var kvs [][]byte
+			for ; it.Valid(); it.Next() {
+				kvs = append(kvs, append(append([]byte{}, it.Key()...), it.Value()...))
+			}
+			return kvs
+		}
+
+		It("should merge the dirty and committed state", func() {
+			Expect(collect(evmStoreCache.Iterator(nil, nil))).To(Equal([][]byte{
+				{1, 1}, {2, 20}, {4, 4}, {5, 5},
+			}))
+			Expect(collect(evmStoreCache.ReverseIterator(nil, nil))).To(Equal([][]byte{
+				{5, 5}, {4, 4}, {2, 20}, {1, 1},
+			}))
+			Expect(collect(evmStoreCache.Iterator([]byte{2}, []byte{5}))).To(Equal([][]byte{
+				{2, 20}, {4, 4},
+			}))
+		})
+
+		It("should iterate over the reverted state", func() {
+			snapshot := cms.Snapshot()
+			evmStoreCache.Delete([]byte{1})
+			evmStoreCache.Set([]byte{3}, []byte{30})
+			Expect(collect(evmStoreCache.Iterator(nil, nil))).To(Equal([][]byte{
+				{2, 20}, {3, 30}, {4, 4}, {5, 5},
+			}))
+
+			cms.RevertToSnapshot(snapshot)
+			Expect(collect(evmStoreCache.Iterator(nil, nil))).To(Equal([][]byte{
+				{1, 1}, {2, 20}, {4, 4}, {5, 5},
+			}))
+		})
 	})
 })
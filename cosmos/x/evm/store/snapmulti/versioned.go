@@ -0,0 +1,235 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Blackchain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package snapmulti
+
+import (
+	"io"
+	"sync"
+
+	"cosmossdk.io/store/cachekv"
+	"cosmossdk.io/store/tracekv"
+	storetypes "cosmossdk.io/store/types"
+
+	"pkg.berachain.dev/jinx/lib/blockstm"
+)
+
+// Compile-time type assertions.
+var (
+	_ storetypes.MultiStore = (*versionedStore)(nil)
+	_ storetypes.KVStore    = (*versionedKVStore)(nil)
+)
+
+// VersionedStores builds the versioned views of the multistore of a block whose transactions are
+// executed in parallel, and writes the final state of the block to it. The keys of every store
+// are prefixed with the name of the store in the multi-version memory of the block.
+type VersionedStores struct {
+	mu   sync.RWMutex
+	keys map[string]storetypes.StoreKey
+}
+
+// NewVersionedStores creates and returns a new `VersionedStores`.
+func NewVersionedStores() *VersionedStores {
+	return &VersionedStores{keys: make(map[string]storetypes.StoreKey)}
+}
+
+// NewStoreFrom returns a `MultiStore` whose KV stores read and write the state of the block
+// through the given view, on top of the stores of the given multistore `ms`. It is the multistore
+// of an incarnation of a transaction.
+func (vs *VersionedStores) NewStoreFrom(ms storetypes.MultiStore, view *blockstm.View) storetypes.MultiStore {
+	return &versionedStore{
+		MultiStore: ms,
+		vs:         vs,
+		view:       view,
+		stores:     make(map[storetypes.StoreKey]*versionedKVStore),
+	}
+}
+
+// Write writes the given final state of the block to the stores of the given multistore `ms`.
+func (vs *VersionedStores) Write(ms storetypes.MultiStore, writes []blockstm.KVPair) {
+	vs.mu.RLock()
+	defer vs.mu.RUnlock()
+	for _, pair := range writes {
+		name, key := splitVersionedKey(pair.Key)
+		store := ms.GetKVStore(vs.keys[name])
+		if pair.Value != nil {
+			store.Set(key, pair.Value)
+		} else {
+			store.Delete(key)
+		}
+	}
+}
+
+// register records the store key, so that the writes to its store can be written.
+func (vs *VersionedStores) register(key storetypes.StoreKey) {
+	vs.mu.RLock()
+	_, found := vs.keys[key.Name()]
+	vs.mu.RUnlock()
+	if found {
+		return
+	}
+
+	vs.mu.Lock()
+	defer vs.mu.Unlock()
+	vs.keys[key.Name()] = key
+}
+
+// versionedStore is the multistore of an incarnation of a transaction executed in parallel.
+//
+// NOTE: like the `store`, only its KV stores are versioned, so cache-wrapping the multistore
+// itself reads the state of the block before its transactions.
+type versionedStore struct {
+	// MultiStore is the multistore of the block.
+	storetypes.MultiStore
+	vs     *VersionedStores
+	view   *blockstm.View
+	stores map[storetypes.StoreKey]*versionedKVStore
+}
+
+// GetKVStore shadows the SDK's `storetypes.MultiStore` function. Routes reads and writes of the
+// store to the view of the incarnation.
+func (s *versionedStore) GetKVStore(key storetypes.StoreKey) storetypes.KVStore {
+	if vkv, found := s.stores[key]; found {
+		return vkv
+	}
+
+	s.vs.register(key)
+	s.stores[key] = &versionedKVStore{
+		parent: s.MultiStore.GetKVStore(key),
+		view:   s.view,
+		prefix: []byte(versionedKeyPrefix(key.Name())),
+	}
+	return s.stores[key]
+}
+
+// versionedKVStore is a KV store whose reads and writes go through a view. Keys that were not
+// written by the block are read from the store of the block.
+type versionedKVStore struct {
+	parent storetypes.KVStore
+	view   *blockstm.View
+	prefix []byte
+}
+
+// versionedKey returns the key in the multi-version memory of the given key of the store.
+func (vkv *versionedKVStore) versionedKey(key []byte) string {
+	return string(vkv.prefix) + string(key)
+}
+
+// GetStoreType implements `storetypes.KVStore`.
+func (vkv *versionedKVStore) GetStoreType() storetypes.StoreType {
+	return vkv.parent.GetStoreType()
+}
+
+// Get implements `storetypes.KVStore`.
+func (vkv *versionedKVStore) Get(key []byte) []byte {
+	storetypes.AssertValidKey(key)
+	if value, found := vkv.view.Get(vkv.versionedKey(key)); found {
+		return value
+	}
+	return vkv.parent.Get(key)
+}
+
+// Has implements `storetypes.KVStore`.
+func (vkv *versionedKVStore) Has(key []byte) bool {
+	return vkv.Get(key) != nil
+}
+
+// Set implements `storetypes.KVStore`.
+func (vkv *versionedKVStore) Set(key, value []byte) {
+	storetypes.AssertValidKey(key)
+	storetypes.AssertValidValue(value)
+	vkv.view.Set(vkv.versionedKey(key), value)
+}
+
+// Delete implements `storetypes.KVStore`.
+func (vkv *versionedKVStore) Delete(key []byte) {
+	storetypes.AssertValidKey(key)
+	vkv.view.Delete(vkv.versionedKey(key))
+}
+
+// Iterator implements `storetypes.KVStore`.
+func (vkv *versionedKVStore) Iterator(start, end []byte) storetypes.Iterator {
+	return vkv.iterator(start, end, true)
+}
+
+// ReverseIterator implements `storetypes.KVStore`.
+func (vkv *versionedKVStore) ReverseIterator(start, end []byte) storetypes.Iterator {
+	return vkv.iterator(start, end, false)
+}
+
+// iterator returns an iterator over the keys written by the block merged with the store of the
+// block. The whole domain is read from the view, so that the incarnation is validated against
+// keys written to it by lower transactions.
+func (vkv *versionedKVStore) iterator(start, end []byte, ascending bool) storetypes.Iterator {
+	var parent storetypes.Iterator
+	if ascending {
+		parent = vkv.parent.Iterator(start, end)
+	} else {
+		parent = vkv.parent.ReverseIterator(start, end)
+	}
+
+	vStart := []byte(vkv.versionedKey(start))
+	vEnd := storetypes.PrefixEndBytes(vkv.prefix)
+	if end != nil {
+		vEnd = []byte(vkv.versionedKey(end))
+	}
+	written := vkv.view.Range(vStart, vEnd)
+
+	pairs := make([]*dirtyPair, len(written))
+	for i, pair := range written {
+		pos := i
+		if !ascending {
+			pos = len(written) - 1 - i
+		}
+		pairs[pos] = &dirtyPair{key: []byte(pair.Key[len(vkv.prefix):]), value: pair.Value}
+	}
+	return newMergeIterator(parent, pairs, ascending)
+}
+
+// CacheWrap implements `storetypes.CacheWrapper`.
+func (vkv *versionedKVStore) CacheWrap() storetypes.CacheWrap {
+	return cachekv.NewStore(vkv)
+}
+
+// CacheWrapWithTrace implements `storetypes.CacheWrapper`.
+func (vkv *versionedKVStore) CacheWrapWithTrace(
+	w io.Writer, tc storetypes.TraceContext,
+) storetypes.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(vkv, w, tc))
+}
+
+// versionedKeyPrefix returns the prefix of the keys of the store with the given name in the
+// multi-version memory, which is the length of the name followed by the name.
+// VersionedKey returns the key in the multi-version memory of the given key of the store with the
+// given name.
+func VersionedKey(name string, key []byte) string {
+	return versionedKeyPrefix(name) + string(key)
+}
+
+func versionedKeyPrefix(name string) string {
+	return string([]byte{byte(len(name))}) + name
+}
+
+// splitVersionedKey returns the name of the store and the key in the store of the given key in
+// the multi-version memory.
+func splitVersionedKey(vkey string) (string, []byte) {
+	n := int(vkey[0])
+	return vkey[1 : 1+n], []byte(vkey[1+n:])
+}
@@ -0,0 +1,116 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Blackchain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package snapmulti
+
+import (
+	dbm "github.com/cosmos/cosmos-db"
+
+	sdkcachemulti "cosmossdk.io/store/cachemulti"
+	"cosmossdk.io/store/dbadapter"
+	storetypes "cosmossdk.io/store/types"
+
+	"pkg.berachain.dev/jinx/lib/blockstm"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Versioned Stores", func() {
+	var (
+		ms          storetypes.MultiStore
+		vs          *VersionedStores
+		accStoreKey = storetypes.NewKVStoreKey("acc")
+		evmStoreKey = storetypes.NewKVStoreKey("evm")
+		counter     = []byte("counter")
+	)
+
+	BeforeEach(func() {
+		ms = sdkcachemulti.NewStore(
+			dbm.NewMemDB(),
+			map[storetypes.StoreKey]storetypes.CacheWrapper{
+				evmStoreKey: dbadapter.Store{DB: dbm.NewMemDB()},
+				accStoreKey: dbadapter.Store{DB: dbm.NewMemDB()},
+			},
+			map[string]storetypes.StoreKey{},
+			nil,
+			nil,
+		)
+		ms.GetKVStore(accStoreKey).Set([]byte{0}, []byte{0})
+		vs = NewVersionedStores()
+	})
+
+	It("should read the writes of lower transactions", func() {
+		numTxs := 20
+		counts := make([]int, numTxs)
+		writes, err := blockstm.Execute(numTxs, 4, func(txIdx int, view *blockstm.View) {
+			// every transaction increments the counter and adds an account, on top of a snapmulti
+			// store like the state plugin.
+			cms := NewStoreFrom(vs.NewStoreFrom(ms, view))
+			evmStore := cms.GetKVStore(evmStoreKey)
+			value := evmStore.Get(counter)
+			if value == nil {
+				value = []byte{0}
+			}
+			evmStore.Set(counter, []byte{value[0] + 1})
+			cms.GetKVStore(accStoreKey).Set([]byte{byte(txIdx + 1)}, []byte{1})
+			cms.Finalize()
+
+			// count the accounts in reverse, which must include the ones of lower transactions.
+			it := cms.GetKVStore(accStoreKey).ReverseIterator(nil, nil)
+			defer it.Close()
+			counts[txIdx] = 0
+			for ; it.Valid(); it.Next() {
+				counts[txIdx]++
+			}
+		})
+		Expect(err).ToNot(HaveOccurred())
+		for txIdx, count := range counts {
+			Expect(count).To(Equal(txIdx + 2))
+		}
+
+		// nothing is written to the multistore until the writes of the block are.
+		Expect(ms.GetKVStore(evmStoreKey).Get(counter)).To(BeNil())
+		vs.Write(ms, writes)
+		Expect(ms.GetKVStore(evmStoreKey).Get(counter)).To(Equal([]byte{byte(numTxs)}))
+		Expect(ms.GetKVStore(accStoreKey).Get([]byte{byte(numTxs)})).To(Equal([]byte{1}))
+	})
+
+	It("should hide the keys deleted by lower transactions", func() {
+		var has, valid bool
+		writes, err := blockstm.Execute(2, 2, func(txIdx int, view *blockstm.View) {
+			accStore := vs.NewStoreFrom(ms, view).GetKVStore(accStoreKey)
+			if txIdx == 0 {
+				accStore.Delete([]byte{0})
+				return
+			}
+			has = accStore.Has([]byte{0})
+			it := accStore.Iterator(nil, nil)
+			defer it.Close()
+			valid = it.Valid()
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(has).To(BeFalse())
+		Expect(valid).To(BeFalse())
+
+		vs.Write(ms, writes)
+		Expect(ms.GetKVStore(accStoreKey).Has([]byte{0})).To(BeFalse())
+	})
+})
@@ -0,0 +1,144 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Blackchain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"errors"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	cosmlib "pkg.berachain.dev/jinx/cosmos/lib"
+	"pkg.berachain.dev/jinx/eth/common"
+	coretypes "pkg.berachain.dev/jinx/eth/core/types"
+)
+
+var (
+	// MsgEthereumCall defines a Cosmos SDK message for calling EVM contracts.
+	_ sdk.Msg = (*MsgEthereumCall)(nil)
+	// MsgEthereumCreate defines a Cosmos SDK message for deploying EVM contracts.
+	_ sdk.Msg = (*MsgEthereumCreate)(nil)
+)
+
+var (
+	// ErrInvalidRecipient is returned when the recipient of a `MsgEthereumCall` is not a hex
+	// address.
+	ErrInvalidRecipient = errors.New("recipient is not a hex address")
+	// ErrInvalidValue is returned when the value of a `MsgEthereumCall` or `MsgEthereumCreate` is
+	// not a non-negative 256-bit decimal integer.
+	ErrInvalidValue = errors.New("value is invalid")
+	// ErrZeroGasLimit is returned when the gas limit of a `MsgEthereumCall` or `MsgEthereumCreate`
+	// is zero.
+	ErrZeroGasLimit = errors.New("gas limit is zero")
+)
+
+// NewMsgEthereumCall returns a message calling the given contract from the given Cosmos account.
+func NewMsgEthereumCall(
+	from sdk.AccAddress, to common.Address, data []byte, value *big.Int, gasLimit uint64,
+) *MsgEthereumCall {
+	return &MsgEthereumCall{
+		From:     from.String(),
+		To:       to.Hex(),
+		Data:     data,
+		Value:    value.String(),
+		GasLimit: gasLimit,
+	}
+}
+
+// ValidateBasic performs the stateless validation of the message.
+func (msg *MsgEthereumCall) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.From); err != nil {
+		return err
+	}
+	if !common.IsHexAddress(msg.To) {
+		return ErrInvalidRecipient
+	}
+	return validateValueAndGas(msg.Value, msg.GasLimit)
+}
+
+// GetSender returns the Ethereum address of the caller.
+func (msg *MsgEthereumCall) GetSender() common.Address {
+	return cosmlib.AccAddressToEthAddress(sdk.MustAccAddressFromBech32(msg.From))
+}
+
+// AsTransaction returns the Ethereum transaction of the call with the given nonce. The message
+// must be valid.
+func (msg *MsgEthereumCall) AsTransaction(nonce uint64) *coretypes.Transaction {
+	to := common.HexToAddress(msg.To)
+	value, _ := parseValue(msg.Value)
+	return coretypes.NewHostAuthorizedTx(msg.GetSender(), nonce, &to, value, msg.GasLimit, msg.Data)
+}
+
+// NewMsgEthereumCreate returns a message deploying the contract with the given init code from the
+// given Cosmos account.
+func NewMsgEthereumCreate(
+	from sdk.AccAddress, data []byte, value *big.Int, gasLimit uint64,
+) *MsgEthereumCreate {
+	return &MsgEthereumCreate{
+		From:     from.String(),
+		Data:     data,
+		Value:    value.String(),
+		GasLimit: gasLimit,
+	}
+}
+
+// ValidateBasic performs the stateless validation of the message.
+func (msg *MsgEthereumCreate) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.From); err != nil {
+		return err
+	}
+	return validateValueAndGas(msg.Value, msg.GasLimit)
+}
+
+// GetSender returns the Ethereum address of the deployer.
+func (msg *MsgEthereumCreate) GetSender() common.Address {
+	return cosmlib.AccAddressToEthAddress(sdk.MustAccAddressFromBech32(msg.From))
+}
+
+// AsTransaction returns the Ethereum transaction of the deployment with the given nonce. The
+// message must be valid.
+func (msg *MsgEthereumCreate) AsTransaction(nonce uint64) *coretypes.Transaction {
+	value, _ := parseValue(msg.Value)
+	return coretypes.NewHostAuthorizedTx(msg.GetSender(), nonce, nil, value, msg.GasLimit, msg.Data)
+}
+
+// validateValueAndGas validates the value and gas limit of a message.
+func validateValueAndGas(value string, gasLimit uint64) error {
+	if _, err := parseValue(value); err != nil {
+		return err
+	}
+	if gasLimit == 0 {
+		return ErrZeroGasLimit
+	}
+	return nil
+}
+
+// parseValue parses the given decimal value, which defaults to zero if empty.
+func parseValue(value string) (*big.Int, error) {
+	if value == "" {
+		return new(big.Int), nil
+	}
+	v, ok := new(big.Int).SetString(value, 10)   //nolint:gomnd // decimal.
+	if !ok || v.Sign() < 0 || v.BitLen() > 256 { //nolint:gomnd // 256 bits.
+		return nil, ErrInvalidValue
+	}
+	return v, nil
+}
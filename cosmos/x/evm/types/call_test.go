@@ -0,0 +1,98 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Blackchain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types_test
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	cosmlib "pkg.berachain.dev/jinx/cosmos/lib"
+	"pkg.berachain.dev/jinx/cosmos/x/evm/types"
+	"pkg.berachain.dev/jinx/eth/common"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("MsgEthereumCall", func() {
+	var (
+		from = sdk.AccAddress(common.Address{0x1}.Bytes())
+		to   = common.Address{0x2}
+	)
+
+	It("should validate the message", func() {
+		msg := types.NewMsgEthereumCall(from, to, []byte("abcdef"), big.NewInt(10), 21000)
+		Expect(msg.ValidateBasic()).To(Succeed())
+
+		msg.To = "0x1234"
+		Expect(msg.ValidateBasic()).To(MatchError(types.ErrInvalidRecipient))
+
+		msg.To = to.Hex()
+		msg.Value = "-1"
+		Expect(msg.ValidateBasic()).To(MatchError(types.ErrInvalidValue))
+
+		msg.Value = ""
+		msg.GasLimit = 0
+		Expect(msg.ValidateBasic()).To(MatchError(types.ErrZeroGasLimit))
+
+		msg.GasLimit = 21000
+		msg.From = "invalid"
+		Expect(msg.ValidateBasic()).To(HaveOccurred())
+	})
+
+	It("should build a deterministic transaction that is unique to the sender", func() {
+		msg := types.NewMsgEthereumCall(from, to, []byte("abcdef"), big.NewInt(10), 21000)
+		Expect(msg.GetSender()).To(Equal(cosmlib.AccAddressToEthAddress(from)))
+
+		tx := msg.AsTransaction(3)
+		Expect(tx.Nonce()).To(Equal(uint64(3)))
+		Expect(*tx.To()).To(Equal(to))
+		Expect(tx.Value()).To(Equal(big.NewInt(10)))
+		Expect(tx.Gas()).To(Equal(uint64(21000)))
+		Expect(tx.Data()).To(Equal([]byte("abcdef")))
+		Expect(tx.GasPrice().Sign()).To(BeZero())
+		Expect(msg.AsTransaction(3).Hash()).To(Equal(tx.Hash()))
+
+		other := types.NewMsgEthereumCall(
+			sdk.AccAddress(common.Address{0x3}.Bytes()), to, []byte("abcdef"), big.NewInt(10), 21000,
+		)
+		Expect(other.AsTransaction(3).Hash()).ToNot(Equal(tx.Hash()))
+	})
+})
+
+var _ = Describe("MsgEthereumCreate", func() {
+	from := sdk.AccAddress(common.Address{0x1}.Bytes())
+
+	It("should validate the message", func() {
+		msg := types.NewMsgEthereumCreate(from, []byte("abcdef"), new(big.Int), 100000)
+		Expect(msg.ValidateBasic()).To(Succeed())
+
+		msg.Value = "abc"
+		Expect(msg.ValidateBasic()).To(MatchError(types.ErrInvalidValue))
+	})
+
+	It("should build a contract creation transaction", func() {
+		tx := types.NewMsgEthereumCreate(from, []byte("abcdef"), new(big.Int), 100000).AsTransaction(0)
+		Expect(tx.To()).To(BeNil())
+		Expect(tx.Data()).To(Equal([]byte("abcdef")))
+	})
+})
@@ -31,6 +31,8 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
 		&WrappedEthereumTransaction{},
+		&MsgEthereumCall{},
+		&MsgEthereumCreate{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_MsgService_serviceDesc)
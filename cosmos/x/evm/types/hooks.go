@@ -0,0 +1,65 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Blackchain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"context"
+
+	coretypes "pkg.berachain.dev/jinx/eth/core/types"
+)
+
+// EvmHooks are the hooks that other modules register on the EVM module, to react to Ethereum
+// transactions within the same Cosmos transaction.
+type EvmHooks interface {
+	// PostTxProcessing is called with the receipt of every Ethereum transaction that executed
+	// successfully. Returning an error reverts the whole transaction.
+	PostTxProcessing(ctx context.Context, tx *coretypes.Transaction, receipt *coretypes.Receipt) error
+}
+
+// EvmHooksWrapper is a wrapper for modules to inject EvmHooks using depinject.
+type EvmHooksWrapper struct{ EvmHooks }
+
+// IsOnePerModuleType implements the depinject.OnePerModuleType interface.
+func (EvmHooksWrapper) IsOnePerModuleType() {}
+
+// Compile-time check to ensure `MultiEvmHooks` implements the `EvmHooks` interface.
+var _ EvmHooks = MultiEvmHooks{}
+
+// MultiEvmHooks combines multiple EVM hooks, which are called in order.
+type MultiEvmHooks []EvmHooks
+
+// NewMultiEvmHooks returns the given hooks combined.
+func NewMultiEvmHooks(hooks ...EvmHooks) MultiEvmHooks {
+	return hooks
+}
+
+// PostTxProcessing calls the PostTxProcessing hook of every hook in order, stopping at the first
+// error.
+func (mh MultiEvmHooks) PostTxProcessing(
+	ctx context.Context, tx *coretypes.Transaction, receipt *coretypes.Receipt,
+) error {
+	for _, h := range mh {
+		if err := h.PostTxProcessing(ctx, tx, receipt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
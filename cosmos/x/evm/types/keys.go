@@ -34,4 +34,10 @@ const (
 	GenesisHeaderKey
 	ParamsKey
 	ChainConfigPrefix
+	EarliestVersionKey
+	ChainConfigHistoryPrefix
+	PrunedTxHashKeyToNumPrefix
+	TxHashKeyToCosmosTxHashPrefix
+	CometBlockHashKey
+	SafeBlockNumberKey
 )
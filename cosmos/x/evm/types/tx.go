@@ -36,6 +36,10 @@ import (
 // WrappedEthereumTransaction defines a Cosmos SDK message for Ethereum transactions.
 var _ sdk.Msg = (*WrappedEthereumTransaction)(nil)
 
+// ErrInvalidTxData is returned when the data of a `WrappedEthereumTransaction` is not a valid
+// binary encoded Ethereum transaction.
+var ErrInvalidTxData = errors.New("transaction data is invalid")
+
 // NewFromTransaction sets the transaction data from an `coretypes.Transaction`.
 func NewFromTransaction(tx *coretypes.Transaction) *WrappedEthereumTransaction {
 	bz, err := tx.MarshalBinary()
@@ -57,39 +61,53 @@ func (etr *WrappedEthereumTransaction) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{sdk.AccAddress(sender.Bytes())}
 }
 
-// AsTransaction extracts the transaction as an `coretypes.Transaction`.
+// AsTransaction extracts the transaction as an `coretypes.Transaction`. The decoded transaction
+// is cached and shared with every other caller that decodes the same data, so it must be treated
+// as immutable: its accessors already return copies, and it must never be decoded into again (e.g.
+// with `UnmarshalBinary`). Callers that need a different transaction must derive a new one, e.g.
+// with `WithSignature`.
 func (etr *WrappedEthereumTransaction) AsTransaction() *coretypes.Transaction {
-	tx := new(coretypes.Transaction)
-	if err := tx.UnmarshalBinary(etr.Data); err != nil {
+	dtx := decodeTx(etr.Data)
+	if dtx == nil {
 		return nil
 	}
-	return tx
+	return dtx.tx
 }
 
 // GetSignBytes returns the bytes to sign over for the transaction.
 func (etr *WrappedEthereumTransaction) GetSignBytes() ([]byte, error) {
 	tx := etr.AsTransaction()
+	if tx == nil {
+		return nil, ErrInvalidTxData
+	}
 	return coretypes.LatestSignerForChainID(tx.ChainId()).
 		Hash(tx).Bytes(), nil
 }
 
 // GetSender extracts the sender address from the signature values using the latest signer for the given chainID.
 func (etr *WrappedEthereumTransaction) GetSender() (common.Address, error) {
-	tx := etr.AsTransaction()
-	signer := coretypes.LatestSignerForChainID(tx.ChainId())
-	return signer.Sender(tx)
+	dtx := decodeTx(etr.Data)
+	if dtx == nil {
+		return common.Address{}, ErrInvalidTxData
+	}
+	return dtx.sender()
 }
 
-// GetSender extracts the sender address from the signature values using the latest signer for the given chainID.
+// GetPubKey extracts the sender public key from the signature values using the latest signer for the given chainID.
 func (etr *WrappedEthereumTransaction) GetPubKey() ([]byte, error) {
-	tx := etr.AsTransaction()
-	signer := coretypes.LatestSignerForChainID(tx.ChainId())
-	return signer.PubKey(tx)
+	dtx := decodeTx(etr.Data)
+	if dtx == nil {
+		return nil, ErrInvalidTxData
+	}
+	return dtx.senderPubKey()
 }
 
-// GetSender extracts the sender address from the signature values using the latest signer for the given chainID.
+// GetSignature extracts the signature from the signature values using the latest signer for the given chainID.
 func (etr *WrappedEthereumTransaction) GetSignature() ([]byte, error) {
 	tx := etr.AsTransaction()
+	if tx == nil {
+		return nil, ErrInvalidTxData
+	}
 	signer := coretypes.LatestSignerForChainID(tx.ChainId())
 	return signer.Signature(tx)
 }
@@ -108,7 +126,7 @@ func (etr *WrappedEthereumTransaction) ValidateBasic() error {
 	// Ensure the transaction is signed properly
 	tx := etr.AsTransaction()
 	if tx == nil {
-		return errors.New("transaction data is invalid")
+		return ErrInvalidTxData
 	}
 
 	// Ensure the transaction does not have a negative value.
@@ -134,7 +152,8 @@ func (etr *WrappedEthereumTransaction) ValidateBasic() error {
 	return nil
 }
 
-// GetAsEthTx is a helper function to get an EthTx from a sdk.Tx.
+// GetAsEthTx is a helper function to get an EthTx from a sdk.Tx. The returned transaction is
+// shared, see `AsTransaction`.
 func GetAsEthTx(tx sdk.Tx) *coretypes.Transaction {
 	if len(tx.GetMsgs()) == 0 {
 		return nil
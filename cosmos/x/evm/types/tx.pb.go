@@ -40,7 +40,7 @@ func (m *WrappedEthereumTransaction) Reset()         { *m = WrappedEthereumTrans
 func (m *WrappedEthereumTransaction) String() string { return proto.CompactTextString(m) }
 func (*WrappedEthereumTransaction) ProtoMessage()    {}
 func (*WrappedEthereumTransaction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d8b33d2a2c64400f, []int{0}
+	return fileDescriptor_b9699353e9f39e07, []int{0}
 }
 func (m *WrappedEthereumTransaction) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -97,7 +97,7 @@ func (m *WrappedEthereumTransactionResult) Reset()         { *m = WrappedEthereu
 func (m *WrappedEthereumTransactionResult) String() string { return proto.CompactTextString(m) }
 func (*WrappedEthereumTransactionResult) ProtoMessage()    {}
 func (*WrappedEthereumTransactionResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d8b33d2a2c64400f, []int{1}
+	return fileDescriptor_b9699353e9f39e07, []int{1}
 }
 func (m *WrappedEthereumTransactionResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -147,38 +147,358 @@ func (m *WrappedEthereumTransactionResult) GetReturnData() []byte {
 	return nil
 }
 
+// MsgEthereumCall is a call to an EVM contract from a Cosmos account, which is signed with the
+// Cosmos sign modes instead of as an Ethereum transaction.
+type MsgEthereumCall struct {
+	// `from` is the bech32 address of the caller.
+	From string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	// `to` is the hex address of the called contract.
+	To string `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	// `data` is the input data of the call.
+	Data []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	// `value` is the amount of wei sent with the call, as a decimal string.
+	Value string `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	// `gas_limit` is the maximum amount of gas used by the call.
+	GasLimit uint64 `protobuf:"varint,5,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+}
+
+func (m *MsgEthereumCall) Reset()         { *m = MsgEthereumCall{} }
+func (m *MsgEthereumCall) String() string { return proto.CompactTextString(m) }
+func (*MsgEthereumCall) ProtoMessage()    {}
+func (*MsgEthereumCall) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b9699353e9f39e07, []int{2}
+}
+func (m *MsgEthereumCall) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgEthereumCall) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgEthereumCall.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgEthereumCall) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgEthereumCall.Merge(m, src)
+}
+func (m *MsgEthereumCall) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgEthereumCall) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgEthereumCall.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgEthereumCall proto.InternalMessageInfo
+
+func (m *MsgEthereumCall) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *MsgEthereumCall) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *MsgEthereumCall) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *MsgEthereumCall) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *MsgEthereumCall) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+// MsgEthereumCallResponse defines the Msg/EthereumCall response type.
+type MsgEthereumCallResponse struct {
+	// `tx_hash` is the hash of the Ethereum transaction of the call.
+	TxHash string `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	// `gas_used` represents the gas used by the virtual machine execution.
+	GasUsed uint64 `protobuf:"varint,2,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// `vm_error` contains an error message if the virtual machine execution failed.
+	VmError string `protobuf:"bytes,3,opt,name=vm_error,json=vmError,proto3" json:"vm_error,omitempty"`
+	// `return_data` contains the return data of the virtual machine execution.
+	ReturnData []byte `protobuf:"bytes,4,opt,name=return_data,json=returnData,proto3" json:"return_data,omitempty"`
+}
+
+func (m *MsgEthereumCallResponse) Reset()         { *m = MsgEthereumCallResponse{} }
+func (m *MsgEthereumCallResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgEthereumCallResponse) ProtoMessage()    {}
+func (*MsgEthereumCallResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b9699353e9f39e07, []int{3}
+}
+func (m *MsgEthereumCallResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgEthereumCallResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgEthereumCallResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgEthereumCallResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgEthereumCallResponse.Merge(m, src)
+}
+func (m *MsgEthereumCallResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgEthereumCallResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgEthereumCallResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgEthereumCallResponse proto.InternalMessageInfo
+
+func (m *MsgEthereumCallResponse) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *MsgEthereumCallResponse) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *MsgEthereumCallResponse) GetVmError() string {
+	if m != nil {
+		return m.VmError
+	}
+	return ""
+}
+
+func (m *MsgEthereumCallResponse) GetReturnData() []byte {
+	if m != nil {
+		return m.ReturnData
+	}
+	return nil
+}
+
+// MsgEthereumCreate is the deployment of an EVM contract from a Cosmos account, which is signed
+// with the Cosmos sign modes instead of as an Ethereum transaction.
+type MsgEthereumCreate struct {
+	// `from` is the bech32 address of the deployer.
+	From string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	// `data` is the init code of the contract, followed by the constructor arguments.
+	Data []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	// `value` is the amount of wei sent to the contract, as a decimal string.
+	Value string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	// `gas_limit` is the maximum amount of gas used by the deployment.
+	GasLimit uint64 `protobuf:"varint,4,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+}
+
+func (m *MsgEthereumCreate) Reset()         { *m = MsgEthereumCreate{} }
+func (m *MsgEthereumCreate) String() string { return proto.CompactTextString(m) }
+func (*MsgEthereumCreate) ProtoMessage()    {}
+func (*MsgEthereumCreate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b9699353e9f39e07, []int{4}
+}
+func (m *MsgEthereumCreate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgEthereumCreate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgEthereumCreate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgEthereumCreate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgEthereumCreate.Merge(m, src)
+}
+func (m *MsgEthereumCreate) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgEthereumCreate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgEthereumCreate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgEthereumCreate proto.InternalMessageInfo
+
+func (m *MsgEthereumCreate) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *MsgEthereumCreate) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *MsgEthereumCreate) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *MsgEthereumCreate) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+// MsgEthereumCreateResponse defines the Msg/EthereumCreate response type.
+type MsgEthereumCreateResponse struct {
+	// `tx_hash` is the hash of the Ethereum transaction of the deployment.
+	TxHash string `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	// `contract_address` is the hex address of the deployed contract.
+	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// `gas_used` represents the gas used by the virtual machine execution.
+	GasUsed uint64 `protobuf:"varint,3,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// `vm_error` contains an error message if the virtual machine execution failed.
+	VmError string `protobuf:"bytes,4,opt,name=vm_error,json=vmError,proto3" json:"vm_error,omitempty"`
+}
+
+func (m *MsgEthereumCreateResponse) Reset()         { *m = MsgEthereumCreateResponse{} }
+func (m *MsgEthereumCreateResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgEthereumCreateResponse) ProtoMessage()    {}
+func (*MsgEthereumCreateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b9699353e9f39e07, []int{5}
+}
+func (m *MsgEthereumCreateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgEthereumCreateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgEthereumCreateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgEthereumCreateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgEthereumCreateResponse.Merge(m, src)
+}
+func (m *MsgEthereumCreateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgEthereumCreateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgEthereumCreateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgEthereumCreateResponse proto.InternalMessageInfo
+
+func (m *MsgEthereumCreateResponse) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *MsgEthereumCreateResponse) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *MsgEthereumCreateResponse) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *MsgEthereumCreateResponse) GetVmError() string {
+	if m != nil {
+		return m.VmError
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*WrappedEthereumTransaction)(nil), "jinx.evm.v1alpha1.WrappedEthereumTransaction")
 	proto.RegisterType((*WrappedEthereumTransactionResult)(nil), "jinx.evm.v1alpha1.WrappedEthereumTransactionResult")
+	proto.RegisterType((*MsgEthereumCall)(nil), "jinx.evm.v1alpha1.MsgEthereumCall")
+	proto.RegisterType((*MsgEthereumCallResponse)(nil), "jinx.evm.v1alpha1.MsgEthereumCallResponse")
+	proto.RegisterType((*MsgEthereumCreate)(nil), "jinx.evm.v1alpha1.MsgEthereumCreate")
+	proto.RegisterType((*MsgEthereumCreateResponse)(nil), "jinx.evm.v1alpha1.MsgEthereumCreateResponse")
 }
 
-func init() { proto.RegisterFile("jinx/evm/v1alpha1/tx.proto", fileDescriptor_d8b33d2a2c64400f) }
-
-var fileDescriptor_d8b33d2a2c64400f = []byte{
-	// 368 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xbd, 0x4e, 0xe3, 0x40,
-	0x14, 0x85, 0x33, 0xbb, 0xd9, 0xcd, 0xee, 0xec, 0x6a, 0x0b, 0xef, 0x6a, 0x09, 0x46, 0x98, 0x28,
-	0x55, 0x14, 0x21, 0x9b, 0x10, 0x89, 0x82, 0x92, 0x90, 0x74, 0x34, 0x06, 0x84, 0x44, 0x63, 0xdd,
-	0xd8, 0x17, 0xdb, 0x4a, 0xfc, 0xa3, 0x99, 0xf1, 0xc8, 0xa1, 0x42, 0x79, 0x01, 0x78, 0x94, 0x3c,
-	0x06, 0x65, 0x4a, 0x4a, 0x94, 0x14, 0x79, 0x0d, 0xe4, 0x71, 0x22, 0x51, 0x90, 0x82, 0x6a, 0x7e,
-	0xbe, 0xb9, 0xa3, 0x73, 0xee, 0x3d, 0x74, 0x3f, 0x4d, 0xc6, 0xc0, 0x42, 0x6e, 0xa1, 0x8c, 0x2c,
-	0xd9, 0x81, 0x71, 0x1a, 0x40, 0xc7, 0x12, 0xb9, 0x99, 0xb2, 0x44, 0x24, 0xda, 0xbf, 0x35, 0x36,
-	0x51, 0x46, 0xe6, 0x06, 0xeb, 0x3b, 0x6e, 0xc2, 0xa3, 0x84, 0x5b, 0x11, 0xf7, 0x2d, 0xd9, 0x29,
-	0x96, 0xf2, 0x79, 0x73, 0x4a, 0xa8, 0x7e, 0xc3, 0x20, 0x4d, 0xd1, 0xeb, 0x8b, 0x00, 0x19, 0x66,
-	0xd1, 0x15, 0x83, 0x98, 0x83, 0x2b, 0xc2, 0x24, 0xd6, 0x34, 0x5a, 0xf5, 0x40, 0x40, 0x9d, 0x34,
-	0x48, 0xeb, 0xb7, 0xad, 0xf6, 0x5a, 0x97, 0xfe, 0x0f, 0xc0, 0x1d, 0x4d, 0x9c, 0xbb, 0x30, 0x77,
-	0x5c, 0xc8, 0x38, 0x3a, 0xe5, 0xef, 0xf5, 0x2f, 0x0d, 0xd2, 0xfa, 0x69, 0xff, 0x55, 0x74, 0x10,
-	0xe6, 0xbd, 0x82, 0xf5, 0x14, 0x3a, 0xdd, 0x9b, 0xae, 0x66, 0xed, 0x2d, 0x75, 0xcd, 0x09, 0x6d,
-	0x6c, 0xd7, 0x60, 0x23, 0xcf, 0xc6, 0x42, 0xdb, 0xa5, 0x3f, 0x7c, 0xe0, 0x4e, 0xc6, 0xd1, 0x53,
-	0x6a, 0xaa, 0x76, 0xcd, 0x07, 0x7e, 0xcd, 0xd1, 0x2b, 0x90, 0x8c, 0x1c, 0x64, 0x2c, 0x61, 0x6b,
-	0x09, 0x35, 0x19, 0xf5, 0x8b, 0xa3, 0x76, 0x40, 0x7f, 0x31, 0x14, 0x19, 0x8b, 0x1d, 0x65, 0xe3,
-	0xab, 0xb2, 0x41, 0xcb, 0xab, 0x73, 0x10, 0x70, 0xfc, 0x48, 0x28, 0xbd, 0xe0, 0xfe, 0x25, 0x32,
-	0x19, 0xba, 0xa8, 0xdd, 0xd3, 0x3f, 0x7d, 0x11, 0xbc, 0xef, 0xc0, 0x91, 0xf9, 0x51, 0x43, 0xcd,
-	0xed, 0x7a, 0xf5, 0x93, 0xcf, 0x56, 0x94, 0x0e, 0xf5, 0x6f, 0x0f, 0xab, 0x59, 0x9b, 0x9c, 0x0d,
-	0x9e, 0x17, 0x06, 0x99, 0x2f, 0x0c, 0xf2, 0xba, 0x30, 0xc8, 0xd3, 0xd2, 0xa8, 0xcc, 0x97, 0x46,
-	0xe5, 0x65, 0x69, 0x54, 0x6e, 0x0f, 0xd3, 0x91, 0x6f, 0x0e, 0x91, 0x81, 0x1b, 0x40, 0x18, 0x9b,
-	0x1e, 0x4a, 0x6b, 0x93, 0x85, 0xf5, 0x78, 0x73, 0x15, 0x0a, 0x31, 0x49, 0x91, 0x0f, 0xbf, 0xab,
-	0x01, 0x77, 0xdf, 0x02, 0x00, 0x00, 0xff, 0xff, 0x6b, 0x4d, 0x7b, 0x17, 0x30, 0x02, 0x00, 0x00,
+func init() { proto.RegisterFile("jinx/evm/v1alpha1/tx.proto", fileDescriptor_b9699353e9f39e07) }
+
+var fileDescriptor_b9699353e9f39e07 = []byte{
+	// 576 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xcf, 0x6e, 0xd3, 0x4e,
+	0x10, 0xc7, 0x6b, 0xc7, 0x6d, 0x7e, 0xd9, 0x5f, 0x95, 0x52, 0x83, 0x48, 0xea, 0x4a, 0x26, 0x8a,
+	0x38, 0xb4, 0x11, 0xd8, 0x0a, 0xb9, 0xf5, 0x06, 0x21, 0x88, 0x03, 0xbd, 0x18, 0x10, 0x12, 0x07,
+	0xac, 0xad, 0x3d, 0xb5, 0x4d, 0xfd, 0x4f, 0xbb, 0x6b, 0xcb, 0xb9, 0xa1, 0x5e, 0x10, 0x37, 0x2e,
+	0xbc, 0x47, 0x1f, 0x83, 0x1b, 0x3d, 0x72, 0x44, 0xc9, 0xa1, 0xaf, 0x81, 0xbc, 0x8e, 0xa3, 0xa4,
+	0x89, 0x09, 0x9c, 0xbc, 0x3b, 0x33, 0xbb, 0xf3, 0x9d, 0x8f, 0x67, 0x07, 0x29, 0x1f, 0xbd, 0x30,
+	0xd3, 0x21, 0x0d, 0xf4, 0xb4, 0x8f, 0xfd, 0xd8, 0xc5, 0x7d, 0x9d, 0x65, 0x5a, 0x4c, 0x22, 0x16,
+	0xc9, 0xfb, 0xb9, 0x4f, 0x83, 0x34, 0xd0, 0x4a, 0x9f, 0xd2, 0xb2, 0x22, 0x1a, 0x44, 0x54, 0x0f,
+	0xa8, 0xa3, 0xa7, 0xfd, 0xfc, 0x53, 0xc4, 0x76, 0x2f, 0x05, 0xa4, 0xbc, 0x23, 0x38, 0x8e, 0xc1,
+	0x1e, 0x31, 0x17, 0x08, 0x24, 0xc1, 0x1b, 0x82, 0x43, 0x8a, 0x2d, 0xe6, 0x45, 0xa1, 0x2c, 0x23,
+	0xc9, 0xc6, 0x0c, 0xb7, 0x85, 0x8e, 0x70, 0xb4, 0x6b, 0xf0, 0xb5, 0x3c, 0x40, 0xf7, 0x5d, 0x6c,
+	0x5d, 0x8c, 0xcd, 0x73, 0x2f, 0x33, 0x2d, 0x9c, 0x50, 0x30, 0x8b, 0xdb, 0xdb, 0x62, 0x47, 0x38,
+	0x6a, 0x18, 0x77, 0xb9, 0xf7, 0x85, 0x97, 0x0d, 0x73, 0xdf, 0x90, 0xbb, 0x4e, 0x0e, 0x2f, 0x6f,
+	0xae, 0x7a, 0x15, 0xe7, 0xba, 0x63, 0xd4, 0xa9, 0xd6, 0x60, 0x00, 0x4d, 0x7c, 0x26, 0x1f, 0xa0,
+	0xff, 0x1c, 0x4c, 0xcd, 0x84, 0x82, 0xcd, 0xd5, 0x48, 0x46, 0xdd, 0xc1, 0xf4, 0x2d, 0x05, 0x3b,
+	0x77, 0xa5, 0x81, 0x09, 0x84, 0x44, 0x64, 0x26, 0xa1, 0x9e, 0x06, 0xa3, 0x7c, 0x2b, 0x3f, 0x40,
+	0xff, 0x13, 0x60, 0x09, 0x09, 0x4d, 0x5e, 0x46, 0x8d, 0x97, 0x81, 0x0a, 0xd3, 0x73, 0xcc, 0x70,
+	0xf7, 0xb3, 0x80, 0xf6, 0x4e, 0xa9, 0x53, 0xe6, 0x1d, 0x62, 0xdf, 0xcf, 0x8b, 0x3e, 0x27, 0x51,
+	0xc0, 0xd3, 0x34, 0x0c, 0xbe, 0x96, 0x9b, 0x48, 0x64, 0xd1, 0xec, 0x76, 0x91, 0x45, 0x73, 0x30,
+	0xb5, 0x05, 0x30, 0xf7, 0xd0, 0x76, 0x8a, 0xfd, 0x04, 0xda, 0x12, 0x0f, 0x2b, 0x36, 0xf2, 0x21,
+	0x6a, 0xe4, 0xc2, 0x7d, 0x2f, 0xf0, 0x58, 0x7b, 0x9b, 0x2b, 0xcf, 0x2b, 0x79, 0x95, 0xef, 0x4f,
+	0x1a, 0x39, 0x16, 0x9e, 0xa1, 0xfb, 0x45, 0x40, 0xad, 0x5b, 0x4a, 0x0c, 0xa0, 0x71, 0x14, 0x52,
+	0x90, 0x5b, 0xa8, 0xce, 0x32, 0xd3, 0xc5, 0xd4, 0x9d, 0x89, 0xda, 0x61, 0xd9, 0x4b, 0x4c, 0xdd,
+	0x25, 0x2a, 0x62, 0x35, 0x95, 0xda, 0x1f, 0xa9, 0x48, 0x2b, 0x54, 0xc6, 0x68, 0x7f, 0x51, 0x0a,
+	0x01, 0xcc, 0x60, 0x2d, 0x96, 0x12, 0x83, 0xb8, 0x0e, 0x43, 0xad, 0x12, 0x83, 0x54, 0x8d, 0xe1,
+	0x9b, 0x80, 0x0e, 0x56, 0x72, 0x6f, 0x06, 0x71, 0x8c, 0xee, 0x58, 0x51, 0xc8, 0x08, 0xb6, 0x98,
+	0x89, 0x6d, 0x9b, 0x00, 0x2d, 0xdb, 0x71, 0xaf, 0xb4, 0x3f, 0x2d, 0xcc, 0x4b, 0xcc, 0x6a, 0xd5,
+	0xcc, 0xa4, 0x25, 0x66, 0x4f, 0x7e, 0x88, 0x08, 0x9d, 0x52, 0xe7, 0x35, 0x90, 0xd4, 0xb3, 0x40,
+	0x66, 0xa8, 0x39, 0x62, 0xee, 0xe2, 0x53, 0x79, 0xac, 0xad, 0x3c, 0x3b, 0xad, 0xba, 0xab, 0x95,
+	0xc1, 0x3f, 0x85, 0xcf, 0x1e, 0xc1, 0x07, 0xb4, 0xbb, 0xd4, 0xa9, 0xdd, 0x35, 0x97, 0xdc, 0xea,
+	0x21, 0xa5, 0xb7, 0x39, 0x66, 0x8e, 0xd7, 0x46, 0xcd, 0xb9, 0xbd, 0xf8, 0xe9, 0x0f, 0x37, 0x9c,
+	0xe6, 0x51, 0xca, 0xa3, 0xbf, 0x89, 0x2a, 0xb3, 0x28, 0xdb, 0x9f, 0x6e, 0xae, 0x7a, 0xc2, 0xb3,
+	0xe1, 0xf7, 0x89, 0x2a, 0x5c, 0x4f, 0x54, 0xe1, 0xd7, 0x44, 0x15, 0xbe, 0x4e, 0xd5, 0xad, 0xeb,
+	0xa9, 0xba, 0xf5, 0x73, 0xaa, 0x6e, 0xbd, 0x3f, 0x8e, 0x2f, 0x1c, 0xed, 0x0c, 0x08, 0xb6, 0x5c,
+	0xec, 0x85, 0x9a, 0x0d, 0xa9, 0xce, 0xc7, 0xdd, 0x6c, 0x88, 0x15, 0x73, 0x8f, 0x8d, 0x63, 0xa0,
+	0x67, 0x3b, 0x7c, 0x8c, 0x0d, 0x7e, 0x0f, 0x00, 0xc1, 0x3c, 0xed, 0x27, 0x10, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -195,6 +515,10 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgServiceClient interface {
 	// EthTransaction defines a method submitting Ethereum transactions.
 	EthTransaction(ctx context.Context, in *WrappedEthereumTransaction, opts ...grpc.CallOption) (*WrappedEthereumTransactionResult, error)
+	// EthereumCall defines a method for Cosmos accounts to call EVM contracts.
+	EthereumCall(ctx context.Context, in *MsgEthereumCall, opts ...grpc.CallOption) (*MsgEthereumCallResponse, error)
+	// EthereumCreate defines a method for Cosmos accounts to deploy EVM contracts.
+	EthereumCreate(ctx context.Context, in *MsgEthereumCreate, opts ...grpc.CallOption) (*MsgEthereumCreateResponse, error)
 }
 
 type msgServiceClient struct {
@@ -214,10 +538,32 @@ func (c *msgServiceClient) EthTransaction(ctx context.Context, in *WrappedEthere
 	return out, nil
 }
 
+func (c *msgServiceClient) EthereumCall(ctx context.Context, in *MsgEthereumCall, opts ...grpc.CallOption) (*MsgEthereumCallResponse, error) {
+	out := new(MsgEthereumCallResponse)
+	err := c.cc.Invoke(ctx, "/jinx.evm.v1alpha1.MsgService/EthereumCall", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgServiceClient) EthereumCreate(ctx context.Context, in *MsgEthereumCreate, opts ...grpc.CallOption) (*MsgEthereumCreateResponse, error) {
+	out := new(MsgEthereumCreateResponse)
+	err := c.cc.Invoke(ctx, "/jinx.evm.v1alpha1.MsgService/EthereumCreate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServiceServer is the server API for MsgService service.
 type MsgServiceServer interface {
 	// EthTransaction defines a method submitting Ethereum transactions.
 	EthTransaction(context.Context, *WrappedEthereumTransaction) (*WrappedEthereumTransactionResult, error)
+	// EthereumCall defines a method for Cosmos accounts to call EVM contracts.
+	EthereumCall(context.Context, *MsgEthereumCall) (*MsgEthereumCallResponse, error)
+	// EthereumCreate defines a method for Cosmos accounts to deploy EVM contracts.
+	EthereumCreate(context.Context, *MsgEthereumCreate) (*MsgEthereumCreateResponse, error)
 }
 
 // UnimplementedMsgServiceServer can be embedded to have forward compatible implementations.
@@ -227,6 +573,12 @@ type UnimplementedMsgServiceServer struct {
 func (*UnimplementedMsgServiceServer) EthTransaction(ctx context.Context, req *WrappedEthereumTransaction) (*WrappedEthereumTransactionResult, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EthTransaction not implemented")
 }
+func (*UnimplementedMsgServiceServer) EthereumCall(ctx context.Context, req *MsgEthereumCall) (*MsgEthereumCallResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EthereumCall not implemented")
+}
+func (*UnimplementedMsgServiceServer) EthereumCreate(ctx context.Context, req *MsgEthereumCreate) (*MsgEthereumCreateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EthereumCreate not implemented")
+}
 
 func RegisterMsgServiceServer(s grpc1.Server, srv MsgServiceServer) {
 	s.RegisterService(&_MsgService_serviceDesc, srv)
@@ -250,6 +602,42 @@ func _MsgService_EthTransaction_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MsgService_EthereumCall_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgEthereumCall)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).EthereumCall(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jinx.evm.v1alpha1.MsgService/EthereumCall",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).EthereumCall(ctx, req.(*MsgEthereumCall))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_EthereumCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgEthereumCreate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).EthereumCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/jinx.evm.v1alpha1.MsgService/EthereumCreate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).EthereumCreate(ctx, req.(*MsgEthereumCreate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _MsgService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "jinx.evm.v1alpha1.MsgService",
 	HandlerType: (*MsgServiceServer)(nil),
@@ -258,6 +646,14 @@ var _MsgService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "EthTransaction",
 			Handler:    _MsgService_EthTransaction_Handler,
 		},
+		{
+			MethodName: "EthereumCall",
+			Handler:    _MsgService_EthereumCall_Handler,
+		},
+		{
+			MethodName: "EthereumCreate",
+			Handler:    _MsgService_EthereumCreate_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "jinx/evm/v1alpha1/tx.proto",
@@ -342,61 +738,981 @@ func (m *WrappedEthereumTransactionResult) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgEthereumCall) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *WrappedEthereumTransaction) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgEthereumCall) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEthereumCall) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.GasLimit != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgEthereumCallResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEthereumCallResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEthereumCallResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ReturnData) > 0 {
+		i -= len(m.ReturnData)
+		copy(dAtA[i:], m.ReturnData)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ReturnData)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.VmError) > 0 {
+		i -= len(m.VmError)
+		copy(dAtA[i:], m.VmError)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VmError)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgEthereumCreate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEthereumCreate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEthereumCreate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasLimit != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgEthereumCreateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEthereumCreateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEthereumCreateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VmError) > 0 {
+		i -= len(m.VmError)
+		copy(dAtA[i:], m.VmError)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VmError)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *WrappedEthereumTransaction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
 	}
 	l = len(m.HackyFixCauseCosmos)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	return n
-}
+	return n
+}
+
+func (m *WrappedEthereumTransactionResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GasUsed != 0 {
+		n += 1 + sovTx(uint64(m.GasUsed))
+	}
+	l = len(m.VmError)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ReturnData)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgEthereumCall) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovTx(uint64(m.GasLimit))
+	}
+	return n
+}
+
+func (m *MsgEthereumCallResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovTx(uint64(m.GasUsed))
+	}
+	l = len(m.VmError)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ReturnData)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgEthereumCreate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovTx(uint64(m.GasLimit))
+	}
+	return n
+}
+
+func (m *MsgEthereumCreateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovTx(uint64(m.GasUsed))
+	}
+	l = len(m.VmError)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *WrappedEthereumTransaction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WrappedEthereumTransaction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WrappedEthereumTransaction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HackyFixCauseCosmos", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HackyFixCauseCosmos = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WrappedEthereumTransactionResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WrappedEthereumTransactionResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WrappedEthereumTransactionResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VmError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VmError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReturnData", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReturnData = append(m.ReturnData[:0], dAtA[iNdEx:postIndex]...)
+			if m.ReturnData == nil {
+				m.ReturnData = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEthereumCall) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEthereumCall: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEthereumCall: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEthereumCallResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEthereumCallResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEthereumCallResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VmError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VmError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReturnData", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReturnData = append(m.ReturnData[:0], dAtA[iNdEx:postIndex]...)
+			if m.ReturnData == nil {
+				m.ReturnData = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *WrappedEthereumTransactionResult) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.GasUsed != 0 {
-		n += 1 + sovTx(uint64(m.GasUsed))
-	}
-	l = len(m.VmError)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ReturnData)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *WrappedEthereumTransaction) Unmarshal(dAtA []byte) error {
+func (m *MsgEthereumCreate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -419,13 +1735,45 @@ func (m *WrappedEthereumTransaction) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WrappedEthereumTransaction: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgEthereumCreate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WrappedEthereumTransaction: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgEthereumCreate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
@@ -459,9 +1807,9 @@ func (m *WrappedEthereumTransaction) Unmarshal(dAtA []byte) error {
 				m.Data = []byte{}
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HackyFixCauseCosmos", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -489,8 +1837,27 @@ func (m *WrappedEthereumTransaction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HackyFixCauseCosmos = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -512,7 +1879,7 @@ func (m *WrappedEthereumTransaction) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WrappedEthereumTransactionResult) Unmarshal(dAtA []byte) error {
+func (m *MsgEthereumCreateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -535,17 +1902,17 @@ func (m *WrappedEthereumTransactionResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WrappedEthereumTransactionResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgEthereumCreateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WrappedEthereumTransactionResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgEthereumCreateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
 			}
-			m.GasUsed = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -555,14 +1922,27 @@ func (m *WrappedEthereumTransactionResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GasUsed |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VmError", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -590,13 +1970,32 @@ func (m *WrappedEthereumTransactionResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VmError = string(dAtA[iNdEx:postIndex])
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReturnData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VmError", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -606,25 +2005,23 @@ func (m *WrappedEthereumTransactionResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReturnData = append(m.ReturnData[:0], dAtA[iNdEx:postIndex]...)
-			if m.ReturnData == nil {
-				m.ReturnData = []byte{}
-			}
+			m.VmError = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
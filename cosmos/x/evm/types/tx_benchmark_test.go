@@ -0,0 +1,108 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Blackchain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	coretypes "pkg.berachain.dev/jinx/eth/core/types"
+	"pkg.berachain.dev/jinx/eth/crypto"
+	"pkg.berachain.dev/jinx/eth/params"
+)
+
+const (
+	// blockSize is the number of transactions in the benchmarked block.
+	blockSize = 10000
+	// lookupsPerTx is the number of times a transaction is decoded and its sender recovered in its
+	// lifecycle, i.e. by the ante handler, the mempool insert, the pending iteration, the proposal,
+	// the msg server and the mempool removal.
+	lookupsPerTx = 6
+)
+
+// The benchmarks below decode the transactions of a `blockSize` tx block and recover their senders
+// `lookupsPerTx` times each, without and with the decoded tx cache. They can be compared with:
+//
+//	go test -run=^$ -bench=TxLifecycle -benchmem ./x/evm/types
+
+func BenchmarkUncachedTxLifecycle(b *testing.B) {
+	etrs := newBenchmarkBlock(b)
+	b.ResetTimer()
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		for _, etr := range etrs {
+			for l := 0; l < lookupsPerTx; l++ {
+				tx := new(coretypes.Transaction)
+				if err := tx.UnmarshalBinary(etr.Data); err != nil {
+					b.Fatal(err)
+				}
+				if _, err := coretypes.LatestSignerForChainID(tx.ChainId()).Sender(tx); err != nil {
+					b.Fatal(err)
+				}
+			}
+		}
+	}
+}
+
+func BenchmarkCachedTxLifecycle(b *testing.B) {
+	etrs := newBenchmarkBlock(b)
+	b.ResetTimer()
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		// Every block starts with a cold cache.
+		decodedTxCache.Purge()
+		for _, etr := range etrs {
+			for l := 0; l < lookupsPerTx; l++ {
+				if etr.AsTransaction() == nil {
+					b.Fatal("failed to decode tx")
+				}
+				if _, err := etr.GetSender(); err != nil {
+					b.Fatal(err)
+				}
+			}
+		}
+	}
+}
+
+// newBenchmarkBlock returns `blockSize` signed and wrapped Ethereum transactions.
+func newBenchmarkBlock(b *testing.B) []*WrappedEthereumTransaction {
+	b.Helper()
+	key, err := crypto.GenerateEthKey()
+	if err != nil {
+		b.Fatal(err)
+	}
+	signer := coretypes.LatestSignerForChainID(params.DefaultChainConfig.ChainID)
+
+	etrs := make([]*WrappedEthereumTransaction, blockSize)
+	for i := range etrs {
+		etrs[i] = NewFromTransaction(coretypes.MustSignNewTx(key, signer, &coretypes.DynamicFeeTx{
+			ChainID:   params.DefaultChainConfig.ChainID,
+			Nonce:     uint64(i),
+			Gas:       21000,
+			GasTipCap: big.NewInt(1),
+			GasFeeCap: big.NewInt(2),
+			Value:     big.NewInt(1),
+		}))
+	}
+	return etrs
+}
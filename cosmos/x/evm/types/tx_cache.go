@@ -0,0 +1,81 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Blackchain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"sync"
+
+	lru "github.com/ethereum/go-ethereum/common/lru"
+
+	"pkg.berachain.dev/jinx/eth/common"
+	coretypes "pkg.berachain.dev/jinx/eth/core/types"
+	"pkg.berachain.dev/jinx/eth/crypto"
+)
+
+// decodedTxCacheSize is the number of decoded transactions kept in the cache, which should fit
+// at least the transactions of a full block and the mempool.
+const decodedTxCacheSize = 16384
+
+// decodedTxCache caches the decoded Ethereum transactions by the hash of their binary encoding,
+// which is the transaction hash. As the key is derived from the content, entries never have to be
+// invalidated. It is safe for concurrent use, so it is shared between CheckTx and DeliverTx.
+var decodedTxCache = lru.NewCache[common.Hash, *decodedTx](decodedTxCacheSize)
+
+// decodedTx is a decoded Ethereum transaction with its memoized sender public key. The sender
+// address is memoized by the transaction itself, through `coretypes.Sender`.
+type decodedTx struct {
+	tx *coretypes.Transaction
+
+	pubKeyOnce sync.Once
+	pubKey     []byte
+	pubKeyErr  error
+}
+
+// sender returns the sender of the transaction, recovering it only once.
+func (dtx *decodedTx) sender() (common.Address, error) {
+	return coretypes.Sender(coretypes.LatestSignerForChainID(dtx.tx.ChainId()), dtx.tx)
+}
+
+// senderPubKey returns the uncompressed public key of the sender of the transaction, recovering
+// it only once.
+func (dtx *decodedTx) senderPubKey() ([]byte, error) {
+	dtx.pubKeyOnce.Do(func() {
+		dtx.pubKey, dtx.pubKeyErr = coretypes.LatestSignerForChainID(dtx.tx.ChainId()).PubKey(dtx.tx)
+	})
+	return dtx.pubKey, dtx.pubKeyErr
+}
+
+// decodeTx returns the decoded transaction of the given binary encoding from the cache, decoding
+// and caching it if required. It returns nil if the encoding is invalid.
+func decodeTx(bz []byte) *decodedTx {
+	hash := crypto.Keccak256Hash(bz)
+	if dtx, found := decodedTxCache.Get(hash); found {
+		return dtx
+	}
+
+	tx := new(coretypes.Transaction)
+	if err := tx.UnmarshalBinary(bz); err != nil {
+		return nil
+	}
+	dtx := &decodedTx{tx: tx}
+	decodedTxCache.Add(hash, dtx)
+	return dtx
+}
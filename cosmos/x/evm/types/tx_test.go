@@ -62,6 +62,21 @@ var _ = Describe("WrappedEthereumTransaction", func() {
 			_, err := etr.GetSignature()
 			Expect(err).ToNot(HaveOccurred())
 		})
+
+		It("should decode the transaction only once", func() {
+			tx := etr.AsTransaction()
+			Expect(tx).ToNot(BeNil())
+			Expect(etr.AsTransaction()).To(BeIdenticalTo(tx))
+			Expect(types.NewFromTransaction(tx).AsTransaction()).To(BeIdenticalTo(tx))
+		})
+
+		It("should fail on invalid transaction data", func() {
+			invalid := &types.WrappedEthereumTransaction{Data: []byte("invalid")}
+			Expect(invalid.AsTransaction()).To(BeNil())
+			_, err := invalid.GetSender()
+			Expect(err).To(MatchError(types.ErrInvalidTxData))
+			Expect(invalid.GetSigners()).To(BeNil())
+		})
 	})
 
 	When("it is a dynamic fee tx", func() {
@@ -36,6 +36,7 @@ type (
 	Arguments          = abi.Arguments
 	Event              = abi.Event
 	Method             = abi.Method
+	Type               = abi.Type
 )
 
 var (
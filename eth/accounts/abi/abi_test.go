@@ -98,4 +98,39 @@ var _ = Describe("ABI Test Suite", func() {
 			Expect(abi.ToUnderScore("creation_height")).To(Equal("creation_height"))
 		})
 	})
+
+	Describe("Test MarshalJSON", func() {
+		It("should encode methods and events that unmarshal back to the same ABI", func() {
+			original := abi.MustUnmarshalJSON(`[
+				{"type":"function","name":"send","stateMutability":"payable",
+				 "inputs":[{"name":"to","type":"address"},{"name":"coins","type":"tuple[]",
+				   "components":[{"name":"amount","type":"uint256"},{"name":"denom","type":"string"}]}],
+				 "outputs":[{"name":"","type":"bool"}]},
+				{"type":"function","name":"balances","stateMutability":"view",
+				 "inputs":[{"name":"accounts","type":"address[2]"}],
+				 "outputs":[{"name":"","type":"uint256[2]"}]},
+				{"type":"event","name":"Sent","anonymous":false,
+				 "inputs":[{"name":"to","type":"address","indexed":true},
+				   {"name":"amount","type":"uint256","indexed":false}]}
+			]`)
+
+			bz, err := abi.MarshalJSON(original.Methods, original.Events)
+			Expect(err).ToNot(HaveOccurred())
+			decoded := abi.MustUnmarshalJSON(string(bz))
+
+			Expect(decoded.Methods).To(HaveLen(len(original.Methods)))
+			for name, method := range original.Methods {
+				Expect(decoded.Methods[name].Sig).To(Equal(method.Sig))
+				Expect(decoded.Methods[name].ID).To(Equal(method.ID))
+				Expect(decoded.Methods[name].StateMutability).To(Equal(method.StateMutability))
+				Expect(decoded.Methods[name].Inputs).To(Equal(method.Inputs))
+				Expect(decoded.Methods[name].Outputs).To(Equal(method.Outputs))
+			}
+			Expect(decoded.Events).To(HaveLen(len(original.Events)))
+			for name, event := range original.Events {
+				Expect(decoded.Events[name].ID).To(Equal(event.ID))
+				Expect(decoded.Events[name].Inputs).To(Equal(event.Inputs))
+			}
+		})
+	})
 })
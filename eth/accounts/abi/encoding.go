@@ -20,7 +20,13 @@
 
 package abi
 
-import "github.com/ethereum/go-ethereum/accounts/abi"
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
 
 // MustUnmarshalJSON is a helper function that wraps abi.ABI.UnmarshalJSON and panics on error.
 func MustUnmarshalJSON(bz string) abi.ABI {
@@ -30,3 +36,89 @@ func MustUnmarshalJSON(bz string) abi.ABI {
 	}
 	return ret
 }
+
+// entryJSON is a method or an event in the JSON format of an ABI.
+type entryJSON struct {
+	Type            string         `json:"type"`
+	Name            string         `json:"name"`
+	Inputs          []argumentJSON `json:"inputs"`
+	Outputs         []argumentJSON `json:"outputs,omitempty"`
+	StateMutability string         `json:"stateMutability,omitempty"`
+	Anonymous       bool           `json:"anonymous,omitempty"`
+}
+
+// argumentJSON is an argument of a method or an event in the JSON format of an ABI.
+type argumentJSON struct {
+	Name       string         `json:"name"`
+	Type       string         `json:"type"`
+	Components []argumentJSON `json:"components,omitempty"`
+	Indexed    bool           `json:"indexed,omitempty"`
+}
+
+// MarshalJSON encodes the given methods and events in the JSON format of an ABI, ordered by name,
+// so that it can be unmarshaled back to the same methods and events.
+func MarshalJSON(methods map[string]abi.Method, events map[string]abi.Event) ([]byte, error) {
+	entries := make([]entryJSON, 0, len(methods)+len(events))
+	for _, name := range sortedKeys(methods) {
+		method := methods[name]
+		entries = append(entries, entryJSON{
+			Type:            "function",
+			Name:            method.RawName,
+			Inputs:          argumentsToJSON(method.Inputs),
+			Outputs:         argumentsToJSON(method.Outputs),
+			StateMutability: method.StateMutability,
+		})
+	}
+	for _, name := range sortedKeys(events) {
+		event := events[name]
+		entries = append(entries, entryJSON{
+			Type:      "event",
+			Name:      event.RawName,
+			Inputs:    argumentsToJSON(event.Inputs),
+			Anonymous: event.Anonymous,
+		})
+	}
+	return json.Marshal(entries)
+}
+
+// argumentsToJSON converts the given arguments to the JSON format of an ABI.
+func argumentsToJSON(args abi.Arguments) []argumentJSON {
+	ret := make([]argumentJSON, len(args))
+	for i := range args {
+		ret[i] = argumentToJSON(args[i].Name, &args[i].Type, args[i].Indexed)
+	}
+	return ret
+}
+
+// argumentToJSON converts the argument with the given name and type to the JSON format of an ABI,
+// in which tuples are described by their components.
+func argumentToJSON(name string, typ *abi.Type, indexed bool) argumentJSON {
+	arg := argumentJSON{Name: name, Indexed: indexed}
+	switch typ.T {
+	case abi.TupleTy:
+		arg.Type = "tuple"
+		arg.Components = make([]argumentJSON, len(typ.TupleElems))
+		for i, elem := range typ.TupleElems {
+			arg.Components[i] = argumentToJSON(typ.TupleRawNames[i], elem, false)
+		}
+	case abi.SliceTy:
+		arg = argumentToJSON(name, typ.Elem, indexed)
+		arg.Type += "[]"
+	case abi.ArrayTy:
+		arg = argumentToJSON(name, typ.Elem, indexed)
+		arg.Type += fmt.Sprintf("[%d]", typ.Size)
+	default:
+		arg.Type = typ.String()
+	}
+	return arg
+}
+
+// sortedKeys returns the keys of the given map in ascending order.
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
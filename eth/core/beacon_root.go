@@ -0,0 +1,72 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Blackchain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"math/big"
+
+	"pkg.berachain.dev/jinx/eth/common"
+	"pkg.berachain.dev/jinx/lib/errors"
+)
+
+// beaconRootsGasLimit is the gas limit of the system call that stores the beacon root.
+const beaconRootsGasLimit = 30_000_000
+
+var (
+	// BeaconRootsAddress is the address of the EIP-4788 beacon roots contract.
+	BeaconRootsAddress = common.HexToAddress("0x000F3df6D732807Ef1319fB7B8bB8522d0Beac02")
+	// BeaconRootsSystemAddress is the only sender allowed to store roots in the beacon roots
+	// contract.
+	BeaconRootsSystemAddress = common.HexToAddress("0xfffffffffffffffffffffffffffffffffffffffe")
+	// beaconRootsCode is the runtime bytecode of the beacon roots contract, as specified by
+	// EIP-4788. It keeps the roots of the last 8191 timestamps in a ring buffer.
+	beaconRootsCode = common.FromHex(
+		"0x3373fffffffffffffffffffffffffffffffffffffffe14604d57602036146024575f5ffd5b5f358015604957" +
+			"62001fff810690815414603c575f5ffd5b62001fff01545f5260205ff35b5f5ffd5b62001fff42064281555f" +
+			"359062001fff015500",
+	)
+)
+
+// ProcessBeaconRoot stores the given root in the EIP-4788 beacon roots contract, keyed by the
+// timestamp of the current block. The contract is deployed the first time a root is stored, so
+// that no genesis allocation is needed when Cancun is activated. It is a no-op before Cancun.
+func (sp *StateProcessor) ProcessBeaconRoot(root common.Hash) error {
+	if !sp.chainConfig.Rules(sp.header.Number, true, sp.header.Time).IsCancun {
+		return nil
+	}
+
+	if sp.statedb.GetCodeSize(BeaconRootsAddress) == 0 {
+		sp.statedb.SetNonce(BeaconRootsAddress, 1)
+		sp.statedb.SetCode(BeaconRootsAddress, beaconRootsCode)
+	}
+
+	result, _, err := sp.ProcessSystemCall(
+		BeaconRootsSystemAddress, &BeaconRootsAddress, root.Bytes(), new(big.Int),
+		beaconRootsGasLimit, true,
+	)
+	if err != nil {
+		return err
+	}
+	if result.Failed() {
+		return errors.Wrap(result.Err, "failed to store beacon root")
+	}
+	return nil
+}
@@ -58,6 +58,10 @@ type blockchain struct {
 	gp GasPlugin
 	sp StatePlugin
 	tp TxPoolPlugin
+	// ph is the OPTIONAL support of the host chain for executing transactions in parallel.
+	ph ParallelHost
+	// fh is the OPTIONAL support of the host chain for reporting the finality of blocks.
+	fh FinalityHost
 
 	// StateProcessor is the canonical, persistent state processor that runs the EVM.
 	processor *StateProcessor
@@ -68,8 +72,6 @@ type blockchain struct {
 
 	// currentBlock is the current/pending block.
 	currentBlock atomic.Pointer[types.Block]
-	// finalizedBlock is the finalized/latest block.
-	finalizedBlock atomic.Pointer[types.Block]
 	// currentReceipts is the current/pending receipts.
 	currentReceipts atomic.Value
 	// currentLogs is the current/pending logs.
@@ -121,12 +123,13 @@ func NewChain(host JinxHostChain) *blockchain { //nolint:revive // only used as
 		scope:          event.SubscriptionScope{},
 		logger:         log.Root(),
 	}
+	bc.ph, _ = host.(ParallelHost)
+	bc.fh, _ = host.(FinalityHost)
 	bc.statedb = state.NewStateDB(bc.sp)
 	bc.processor = NewStateProcessor(
 		bc.cp, bc.gp, host.GetPrecompilePlugin(), bc.statedb, bc.vmConfig,
 	)
 	bc.currentBlock.Store(nil)
-	bc.finalizedBlock.Store(nil)
 
 	return bc
 }
@@ -135,3 +138,8 @@ func NewChain(host JinxHostChain) *blockchain { //nolint:revive // only used as
 func (bc *blockchain) Config() *params.ChainConfig {
 	return bc.cp.ChainConfig()
 }
+
+// ConfigAtHeight returns the Ethereum chain config that was active at the given block number.
+func (bc *blockchain) ConfigAtHeight(number uint64) *params.ChainConfig {
+	return bc.cp.ChainConfigAtHeight(number)
+}
@@ -33,9 +33,12 @@ func (bc *blockchain) deriveReceipts(receipts types.Receipts, blockHash common.H
 		return nil, ErrBlockNotFound
 	}
 
-	// Derive receipts from block.
+	// Derive receipts from block. The senders of host authorized transactions are cached when the
+	// block is built or loaded, so the contract addresses of their receipts are not rewritten.
+	types.CacheHostAuthorizedSenders(block.Transactions())
 	if err := receipts.DeriveFields(
-		bc.Config(), block.Hash(), block.Number().Uint64(), block.Time(), block.BaseFee(), block.Transactions(),
+		bc.ConfigAtHeight(block.NumberU64()), block.Hash(), block.Number().Uint64(), block.Time(),
+		block.BaseFee(), block.Transactions(),
 	); err != nil {
 		return nil, err
 	}
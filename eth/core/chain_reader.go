@@ -23,6 +23,8 @@ package core
 import (
 	"math/big"
 
+	"github.com/ethereum/go-ethereum"
+
 	"pkg.berachain.dev/jinx/eth/common"
 	"pkg.berachain.dev/jinx/eth/core/types"
 	"pkg.berachain.dev/jinx/lib/utils"
@@ -32,6 +34,7 @@ import (
 type ChainReader interface {
 	ChainBlockReader
 	ChainTxPoolReader
+	ChainNetworkReader
 	ChainSubscriber
 }
 
@@ -49,6 +52,7 @@ type ChainBlockReader interface {
 	GetBlockByNumber(uint64) *types.Block
 	GetTransactionLookup(common.Hash) *types.TxLookupEntry
 	GetTd(common.Hash, uint64) *big.Int
+	EarliestBlockNumber() uint64
 
 	// THIS SHOULD BE MOVED TO A "MINER" TYPE THING
 	PendingBlockAndReceipts() (*types.Block, types.Receipts)
@@ -65,6 +69,14 @@ type ChainTxPoolReader interface {
 	GetPoolContentFrom(addr common.Address) (types.Transactions, types.Transactions)
 }
 
+// ChainNetworkReader defines methods that are used to read information about the networking
+// state of the host chain node.
+type ChainNetworkReader interface {
+	SyncProgress() ethereum.SyncProgress
+	PeerCount() uint64
+	Listening() bool
+}
+
 // =========================================================================
 // BlockReader
 // =========================================================================
@@ -96,23 +108,43 @@ func (bc *blockchain) CurrentSnapBlock() *types.Header {
 	return nil
 }
 
-// GetHeadersFrom returns a contiguous segment of headers, in rlp-form, going
-// backwards from the given number.
+// CurrentFinalBlock returns the header of the last block committed by the host chain. Blocks are
+// final as soon as they are committed, as the consensus of the host chain provides instant
+// finality. If the host chain does not report finality, the latest executed block is assumed to
+// be final. It returns nil if no block is final yet.
 func (bc *blockchain) CurrentFinalBlock() *types.Header {
-	fb, ok := utils.GetAs[*types.Block](bc.finalizedBlock.Load())
-	if fb == nil || !ok {
+	if bc.fh == nil {
+		return bc.CurrentBlock()
+	}
+	number, ok := bc.fh.FinalizedBlockNumber()
+	if !ok {
 		return nil
 	}
-	bc.blockNumCache.Add(fb.Number().Uint64(), fb)
-	bc.blockHashCache.Add(fb.Hash(), fb)
-	return fb.Header()
+	return bc.cachedHeaderByNumber(number)
 }
 
-// CurrentSafeBlock retrieves the current safe block of the canonical
-// chain. The block is retrieved from the blockchain's internal cache.
+// CurrentSafeBlock returns the header of the last block with a quorum of attestations of the
+// validators of the host chain, which is never after the finalized block. If the host chain does
+// not report finality, the finalized block is also the safe block. It returns nil if no block is
+// safe yet.
 func (bc *blockchain) CurrentSafeBlock() *types.Header {
-	// TODO: determine the difference between safe and final in jinx.
-	return bc.CurrentFinalBlock()
+	if bc.fh == nil {
+		return bc.CurrentFinalBlock()
+	}
+	number, ok := bc.fh.SafeBlockNumber()
+	if !ok {
+		return nil
+	}
+	return bc.cachedHeaderByNumber(number)
+}
+
+// cachedHeaderByNumber returns the header of the block with the given number, from the block
+// cache if the block has been cached.
+func (bc *blockchain) cachedHeaderByNumber(number uint64) *types.Header {
+	if block, ok := bc.blockNumCache.Get(number); ok {
+		return block.Header()
+	}
+	return bc.GetHeaderByNumber(number)
 }
 
 // PendingBlockAndReceipts returns the pending block and receipts of the blockchain.
@@ -325,6 +357,16 @@ func (bc *blockchain) GetTd(hash common.Hash, number uint64) *big.Int {
 	return block.Difficulty()
 }
 
+// EarliestBlockNumber returns the number of the oldest block whose historical data (body,
+// receipts, and transactions) is still available. Returns 0 if the host chain does not prune
+// its historical data.
+func (bc *blockchain) EarliestBlockNumber() uint64 {
+	if bc.hp == nil {
+		return 0
+	}
+	return bc.hp.EarliestBlockNumber()
+}
+
 // =========================================================================
 // TransactionPoolReader
 // =========================================================================
@@ -368,3 +410,22 @@ func (bc *blockchain) GetPoolContentFrom(addr common.Address) (
 ) {
 	return bc.tp.ContentFrom(addr)
 }
+
+// =========================================================================
+// NetworkReader
+// =========================================================================
+
+// SyncProgress returns the sync progress of the host chain node.
+func (bc *blockchain) SyncProgress() ethereum.SyncProgress {
+	return bc.tp.SyncProgress()
+}
+
+// PeerCount returns the number of peers the host chain node is connected to.
+func (bc *blockchain) PeerCount() uint64 {
+	return bc.tp.PeerCount()
+}
+
+// Listening returns whether the host chain node is listening for network connections.
+func (bc *blockchain) Listening() bool {
+	return bc.tp.Listening()
+}
@@ -27,6 +27,7 @@ import (
 	"pkg.berachain.dev/jinx/eth/core/state"
 	"pkg.berachain.dev/jinx/eth/core/types"
 	"pkg.berachain.dev/jinx/eth/core/vm"
+	"pkg.berachain.dev/jinx/eth/params"
 )
 
 // ChainResources is the interface that defines functions for code paths within the chain to acquire
@@ -35,6 +36,8 @@ type ChainResources interface {
 	StateAtBlockNumber(uint64) (vm.GethStateDB, error)
 	GetVMConfig() *vm.Config
 	GetEVM(context.Context, vm.TxContext, vm.JinxStateDB, *types.Header, *vm.Config) *vm.GethEVM
+	NewEVM(vm.BlockContext, vm.TxContext, vm.JinxStateDB, *vm.Config) *vm.GethEVM
+	ConfigAtHeight(uint64) *params.ChainConfig
 	NewEVMBlockContext(header *types.Header) *vm.BlockContext
 }
 
@@ -55,13 +58,23 @@ func (bc *blockchain) GetEVM(
 	_ context.Context, txContext vm.TxContext, state vm.JinxStateDB,
 	header *types.Header, vmConfig *vm.Config,
 ) *vm.GethEVM {
-	chainCfg := bc.processor.cp.ChainConfig() // TODO: get chain config at height.
+	return bc.NewEVM(*bc.NewEVMBlockContext(header), txContext, state, vmConfig)
+}
+
+// NewEVM returns an EVM executing in the given block context, which may differ from the one of any
+// header, e.g. when simulating calls with block overrides.
+func (bc *blockchain) NewEVM(
+	blockCtx vm.BlockContext, txContext vm.TxContext, state vm.JinxStateDB, vmConfig *vm.Config,
+) *vm.GethEVM {
+	// The EVM must run under the rules of the block it executes in, which may be a past one.
+	chainCfg := bc.ConfigAtHeight(blockCtx.BlockNumber.Uint64())
 	return vm.NewGethEVMWithPrecompiles(
-		*bc.NewEVMBlockContext(header), txContext, state, chainCfg, *vmConfig, bc.processor.pp,
+		blockCtx, txContext, state, chainCfg, *vmConfig, bc.processor.pp,
 	)
 }
 
-// NewEVMBlockContext creates a new block context for use in the EVM.
+// NewEVMBlockContext creates a new block context for use in the EVM. As the difficulty of Jinx
+// blocks is zero, the mix hash of the header is used as the randomness (PREVRANDAO) of the block.
 func (bc *blockchain) NewEVMBlockContext(header *types.Header) *vm.BlockContext {
 	if header = types.CopyHeader(header); header.Difficulty == nil {
 		header.Difficulty = new(big.Int)
@@ -27,7 +27,9 @@ import (
 	"github.com/ethereum/go-ethereum/consensus/misc"
 	"github.com/ethereum/go-ethereum/core/vm"
 
+	"pkg.berachain.dev/jinx/eth/common"
 	"pkg.berachain.dev/jinx/eth/core/types"
+	"pkg.berachain.dev/jinx/lib/errors"
 )
 
 // ChainWriter defines methods that are used to perform state and block transitions.
@@ -38,6 +40,30 @@ type ChainWriter interface {
 	// ProcessTransaction processes the given transaction and returns the receipt after applying
 	// the state transition. This method is called for each tx in the block.
 	ProcessTransaction(context.Context, *types.Transaction) (*ExecutionResult, error)
+	// ProcessTransactionFrom processes the given transaction from the given sender, which
+	// authorized it on the host chain instead of signing it.
+	ProcessTransactionFrom(context.Context, *types.Transaction, common.Address) (*ExecutionResult, error)
+	// ProcessTransactionsParallel processes the given transactions in parallel on the given
+	// number of workers, with the same outcome as processing them in order. It returns
+	// `ErrParallelExecution` if they must be processed one by one instead.
+	ProcessTransactionsParallel(context.Context, types.Transactions, int) ([]*ExecutionResult, error)
+	// SetPostTxHook sets the hook that is called with the receipt of every successful transaction
+	// before it is added to the block. A transaction is rejected if the hook returns an error.
+	SetPostTxHook(PostTxHook)
+	// ProcessSystemCall executes a call, or a contract creation if the recipient is nil, from the
+	// given sender with the given data, value and gas limit, outside of any transaction. If commit
+	// is true, the state changes are kept and the logs are added to the block.
+	ProcessSystemCall(
+		ctx context.Context, sender common.Address, to *common.Address, data []byte,
+		value *big.Int, gasLimit uint64, commit bool,
+	) (*ExecutionResult, common.Address, error)
+	// ProcessBeaconRoot stores the given root of the host chain in the EIP-4788 beacon roots
+	// contract, once Cancun is active.
+	ProcessBeaconRoot(context.Context, common.Hash) error
+	// ProcessSyntheticLogs adds the given logs of host chain events, which were not emitted by an
+	// EVM transaction, to the block in a synthetic receipt. The source identifies the events
+	// within the block, e.g. the block hook that emitted them.
+	ProcessSyntheticLogs(context.Context, []byte, []*types.Log)
 	// Finalize is called after the last tx in the block.
 	Finalize(context.Context) error
 	// SendTx sends the given transaction to the tx pool.
@@ -60,16 +86,17 @@ func (bc *blockchain) Prepare(ctx context.Context, number uint64) {
 		bc.hp.Prepare(ctx)
 	}
 
-	coinbase, timestamp := bc.bp.GetNewBlockMetadata(number)
+	coinbase, timestamp, random := bc.bp.GetNewBlockMetadata(number)
 
 	// Build the new block header.
-	parent := bc.CurrentFinalBlock()
+	parent := bc.CurrentBlock()
 	if number >= 1 && parent == nil {
 		parent = bc.GetHeaderByNumber(number - 1)
 	}
 
-	// Jinx does not set Ethereum state root (Root), mix hash (MixDigest), extra data (Extra),
-	// and block nonce (Nonce) on the new header.
+	// Jinx does not set Ethereum state root (Root), extra data (Extra), and block nonce (Nonce) on
+	// the new header. The mix hash (MixDigest) carries the randomness of the host chain, which the
+	// EVM exposes as PREVRANDAO.
 	header := &types.Header{
 		// Used in Jinx.
 		ParentHash: parent.Hash(),
@@ -77,13 +104,16 @@ func (bc *blockchain) Prepare(ctx context.Context, number uint64) {
 		Number:     new(big.Int).SetUint64(number),
 		GasLimit:   bc.gp.BlockGasLimit(),
 		Time:       timestamp,
-		BaseFee:    misc.CalcBaseFee(bc.Config(), parent),
+		BaseFee:    misc.CalcBaseFee(bc.ConfigAtHeight(number), parent),
+		MixDigest:  random,
 	}
 
 	bc.logger.Info("preparing evm block", "seal_hash", header.Hash())
 
-	// We update the base fee in the txpool to the next base fee.
+	// We update the base fee in the txpool to the next base fee and let it promote (or demote)
+	// transactions against the account nonces of the new block.
 	bc.tp.SetBaseFee(header.BaseFee)
+	bc.tp.Prepare(ctx)
 
 	// Prepare the State Processor, StateDB and the EVM for the block.
 	bc.processor.Prepare(
@@ -103,6 +133,75 @@ func (bc *blockchain) ProcessTransaction(ctx context.Context, tx *types.Transact
 	return bc.processor.ProcessTransaction(ctx, tx)
 }
 
+// ProcessTransactionFrom processes the given transaction from the given sender.
+func (bc *blockchain) ProcessTransactionFrom(
+	ctx context.Context, tx *types.Transaction, from common.Address,
+) (*ExecutionResult, error) {
+	bc.logger.Debug("processing host authorized evm transaction", "tx_hash", tx.Hash(), "from", from)
+
+	// Reset the Gas and State plugins for the tx.
+	bc.gp.Reset(ctx)
+	bc.sp.Reset(ctx)
+
+	return bc.processor.ProcessTransactionFrom(ctx, tx, from)
+}
+
+// ProcessTransactionsParallel processes the given transactions in parallel, if the host chain
+// supports it.
+func (bc *blockchain) ProcessTransactionsParallel(
+	ctx context.Context, txs types.Transactions, workers int,
+) ([]*ExecutionResult, error) {
+	if bc.ph == nil {
+		return nil, errors.Wrap(ErrParallelExecution, "host chain does not support it")
+	}
+	bc.logger.Debug("processing evm transactions in parallel", "num_txs", len(txs), "workers", workers)
+
+	// Reset the Gas and State plugins for the block, whose state the coinbase is credited in.
+	bc.gp.Reset(ctx)
+	bc.sp.Reset(ctx)
+
+	return bc.processor.ProcessTransactionsParallel(ctx, bc.ph, txs, workers)
+}
+
+// ProcessSystemCall executes the given system call on top of the state of the current block.
+func (bc *blockchain) ProcessSystemCall(
+	ctx context.Context, sender common.Address, to *common.Address, data []byte,
+	value *big.Int, gasLimit uint64, commit bool,
+) (*ExecutionResult, common.Address, error) {
+	bc.logger.Debug("processing system call", "sender", sender, "to", to, "commit", commit)
+
+	// Reset the State plugin for the call.
+	bc.sp.Reset(ctx)
+
+	return bc.processor.ProcessSystemCall(sender, to, data, value, gasLimit, commit)
+}
+
+// ProcessBeaconRoot stores the given root in the beacon roots contract.
+func (bc *blockchain) ProcessBeaconRoot(ctx context.Context, root common.Hash) error {
+	bc.logger.Debug("processing beacon root", "root", root)
+
+	// Reset the State plugin for the system call.
+	bc.sp.Reset(ctx)
+
+	return bc.processor.ProcessBeaconRoot(root)
+}
+
+// SetPostTxHook sets the hook that is called after every successful transaction.
+func (bc *blockchain) SetPostTxHook(hook PostTxHook) {
+	bc.processor.SetPostTxHook(hook)
+}
+
+// ProcessSyntheticLogs adds the given logs to the block in a synthetic receipt. It is a no-op if
+// there are no logs.
+func (bc *blockchain) ProcessSyntheticLogs(_ context.Context, source []byte, logs []*types.Log) {
+	if len(logs) == 0 {
+		return
+	}
+
+	bc.logger.Debug("processing synthetic logs", "source", string(source), "num_logs", len(logs))
+	bc.processor.ProcessSyntheticLogs(source, logs)
+}
+
 // Finalize finalizes the current block.
 func (bc *blockchain) Finalize(ctx context.Context) error {
 	block, receipts, logs, err := bc.processor.Finalize(ctx)
@@ -139,7 +238,6 @@ func (bc *blockchain) Finalize(ctx context.Context) error {
 	// mark the current block, receipts, and logs
 	if block != nil {
 		bc.currentBlock.Store(block)
-		bc.finalizedBlock.Store(block)
 
 		// Todo: nuke these caches.
 		bc.blockNumCache.Add(blockNum, block)
@@ -23,9 +23,11 @@ package core
 import "errors"
 
 var (
-	ErrBlockOutOfGas    = errors.New("block is out of gas")
-	ErrBlockNotFound    = errors.New("block not found")
-	ErrHeaderNotFound   = errors.New("header not found")
-	ErrReceiptsNotFound = errors.New("receipts not found")
-	ErrTxNotFound       = errors.New("transaction not found")
+	ErrBlockOutOfGas     = errors.New("block is out of gas")
+	ErrBlockNotFound     = errors.New("block not found")
+	ErrHeaderNotFound    = errors.New("header not found")
+	ErrReceiptsNotFound  = errors.New("receipts not found")
+	ErrTxNotFound        = errors.New("transaction not found")
+	ErrPruned            = errors.New("historical data has been pruned")
+	ErrParallelExecution = errors.New("transactions cannot be executed in parallel")
 )
@@ -23,6 +23,7 @@ package core
 import (
 	"math/big"
 
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/event"
 
 	"pkg.berachain.dev/jinx/eth/common"
@@ -64,9 +65,10 @@ type (
 		// BlockPlugin implements `libtypes.Preparable`. Calling `Prepare` should reset the
 		// BlockPlugin to a default state.
 		libtypes.Preparable
-		// GetNewBlockMetadata returns a new block metadata (coinbase, timestamp) for the given
-		// block number.
-		GetNewBlockMetadata(uint64) (common.Address, uint64)
+		// GetNewBlockMetadata returns a new block metadata (coinbase, timestamp, randomness) for
+		// the given block number. The randomness is exposed to the EVM as PREVRANDAO, so it
+		// must be deterministic and should not be trivially chosen by a single party.
+		GetNewBlockMetadata(uint64) (common.Address, uint64, common.Hash)
 		// GetHeaderByNumber returns the block header at the given block number.
 		GetHeaderByNumber(uint64) (*types.Header, error)
 		// GetHeaderByHash returns the block header with the given block hash.
@@ -85,6 +87,9 @@ type (
 		libtypes.Preparable
 		// ChainConfig returns the current chain configuration of the Jinx EVM.
 		ChainConfig() *params.ChainConfig
+		// ChainConfigAtHeight returns the chain configuration of the Jinx EVM that was active at
+		// the given block number, so that past blocks can be re-executed under their own rules.
+		ChainConfigAtHeight(uint64) *params.ChainConfig
 	}
 
 	// GasPlugin is an interface that allows the Jinx EVM to consume gas on the host chain.
@@ -122,6 +127,9 @@ type (
 	// TxPoolPlugin defines the methods that the chain running Jinx EVM should implement to
 	// support the transaction pool.
 	TxPoolPlugin interface {
+		// TxPoolPlugin implements `libtypes.Preparable`. Calling `Prepare` should refresh the
+		// account nonces that the transaction pool uses to split pending and queued transactions.
+		libtypes.Preparable
 		// SetBaseFee sets the base fee of the transaction pool.
 		SetBaseFee(*big.Int)
 		// SendTx submits the tx to the transaction pool.
@@ -142,6 +150,13 @@ type (
 		// ContentFrom retrieves the data content of the transaction pool, returning the pending
 		// as well as queued transactions of this address, grouped by nonce.
 		ContentFrom(addr common.Address) (types.Transactions, types.Transactions)
+		// SyncProgress returns the sync progress of the host chain node, estimating the highest
+		// block of the network if the node is still catching up.
+		SyncProgress() ethereum.SyncProgress
+		// PeerCount returns the number of peers the host chain node is connected to.
+		PeerCount() uint64
+		// Listening returns whether the host chain node is listening for network connections.
+		Listening() bool
 	}
 )
 
@@ -173,6 +188,9 @@ type (
 		StoreReceipts(common.Hash, types.Receipts) error
 		// StoreTransactions stores the transactions for the given block hash.
 		StoreTransactions(uint64, common.Hash, types.Transactions) error
+		// EarliestBlockNumber returns the number of the oldest block whose historical data has
+		// not been pruned.
+		EarliestBlockNumber() uint64
 	}
 
 	// PrecompilePlugin defines the methods that the chain running Jinx EVM should implement
@@ -180,3 +198,16 @@ type (
 	// plugin is optional.
 	PrecompilePlugin = precompile.Plugin
 )
+
+// FinalityHost is an OPTIONAL interface of the Jinx host chain, which reports the finality of the
+// blocks it has executed. With it, the "latest" block is the last executed block, the "finalized"
+// block is the last block committed by the host chain and the "safe" block is the last block that
+// the host chain has attested to with a quorum of its validators, e.g. through vote extensions.
+type FinalityHost interface {
+	// FinalizedBlockNumber returns the number of the last committed block and false if no block
+	// has been committed yet.
+	FinalizedBlockNumber() (uint64, bool)
+	// SafeBlockNumber returns the number of the last block with a quorum of attestations and
+	// false if there is no such block yet.
+	SafeBlockNumber() (uint64, bool)
+}
@@ -48,6 +48,8 @@ type (
 var (
 	// ApplyTransactionWithEVM applies a transaction to the current state of the blockchain.
 	ApplyTransactionWithEVMWithResult = core.ApplyTransactionWithEVMWithResult
+	// ApplyMessage applies a message to the current state of the blockchain.
+	ApplyMessage = core.ApplyMessage
 	// NewEVMTxContext creates a new context for use in the EVM.
 	NewEVMTxContext = core.NewEVMTxContext
 	// NewEVMBlockContext creates a new block context for a given header.
@@ -56,6 +58,8 @@ var (
 	GetHashFn = core.GetHashFn
 	// TransactionToMessage converts a transaction to a message.
 	TransactionToMessage = core.TransactionToMessage
+	// IntrinsicGas computes the 'intrinsic gas' for a message with the given data.
+	IntrinsicGas = core.IntrinsicGas
 
 	Transfer    = core.Transfer
 	CanTransfer = core.CanTransfer
@@ -64,4 +68,19 @@ var (
 var (
 	// ErrInsufficientBalanceForGas is the error return when gas required to execute a transaction overflows.
 	ErrGasUintOverflow = core.ErrGasUintOverflow
+	// ErrNonceTooLow is returned if the nonce of a transaction is lower than the one present in
+	// the local chain.
+	ErrNonceTooLow = core.ErrNonceTooLow
+	// ErrNonceTooHigh is returned if the nonce of a transaction is higher than the next one
+	// expected based on the local chain.
+	ErrNonceTooHigh = core.ErrNonceTooHigh
+	// ErrIntrinsicGas is returned if the transaction is specified to use less gas than required
+	// to start the invocation.
+	ErrIntrinsicGas = core.ErrIntrinsicGas
+	// ErrFeeCapTooLow is returned if the transaction fee cap is less than the base fee of the
+	// block.
+	ErrFeeCapTooLow = core.ErrFeeCapTooLow
+	// ErrInsufficientFunds is returned if the total cost of executing a transaction is higher
+	// than the balance of the user's account.
+	ErrInsufficientFunds = core.ErrInsufficientFunds
 )
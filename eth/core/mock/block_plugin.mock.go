@@ -31,7 +31,7 @@ var _ core.BlockPlugin = &BlockPluginMock{}
 //			GetHeaderByNumberFunc: func(v uint64) (*types.Header, error) {
 //				panic("mock out the GetHeaderByNumber method")
 //			},
-//			GetNewBlockMetadataFunc: func(v uint64) (common.Address, uint64) {
+//			GetNewBlockMetadataFunc: func(v uint64) (common.Address, uint64, common.Hash) {
 //				panic("mock out the GetNewBlockMetadata method")
 //			},
 //			PrepareFunc: func(contextMoqParam context.Context)  {
@@ -57,7 +57,7 @@ type BlockPluginMock struct {
 	GetHeaderByNumberFunc func(v uint64) (*types.Header, error)
 
 	// GetNewBlockMetadataFunc mocks the GetNewBlockMetadata method.
-	GetNewBlockMetadataFunc func(v uint64) (common.Address, uint64)
+	GetNewBlockMetadataFunc func(v uint64) (common.Address, uint64, common.Hash)
 
 	// PrepareFunc mocks the Prepare method.
 	PrepareFunc func(contextMoqParam context.Context)
@@ -196,7 +196,7 @@ func (mock *BlockPluginMock) GetHeaderByNumberCalls() []struct {
 }
 
 // GetNewBlockMetadata calls GetNewBlockMetadataFunc.
-func (mock *BlockPluginMock) GetNewBlockMetadata(v uint64) (common.Address, uint64) {
+func (mock *BlockPluginMock) GetNewBlockMetadata(v uint64) (common.Address, uint64, common.Hash) {
 	if mock.GetNewBlockMetadataFunc == nil {
 		panic("BlockPluginMock.GetNewBlockMetadataFunc: method is nil but BlockPlugin.GetNewBlockMetadata was just called")
 	}
@@ -36,6 +36,9 @@ func NewConfigurationPluginMock() *ConfigurationPluginMock {
 		ChainConfigFunc: func() *params.ChainConfig {
 			return params.DefaultChainConfig
 		},
+		ChainConfigAtHeightFunc: func(uint64) *params.ChainConfig {
+			return params.DefaultChainConfig
+		},
 		PrepareFunc: func(contextMoqParam context.Context) {
 			// no-op
 		},
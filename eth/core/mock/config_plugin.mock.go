@@ -23,6 +23,9 @@ var _ core.ConfigurationPlugin = &ConfigurationPluginMock{}
 //			ChainConfigFunc: func() *params.ChainConfig {
 //				panic("mock out the ChainConfig method")
 //			},
+//			ChainConfigAtHeightFunc: func(v uint64) *params.ChainConfig {
+//				panic("mock out the ChainConfigAtHeight method")
+//			},
 //			PrepareFunc: func(contextMoqParam context.Context)  {
 //				panic("mock out the Prepare method")
 //			},
@@ -36,6 +39,9 @@ type ConfigurationPluginMock struct {
 	// ChainConfigFunc mocks the ChainConfig method.
 	ChainConfigFunc func() *params.ChainConfig
 
+	// ChainConfigAtHeightFunc mocks the ChainConfigAtHeight method.
+	ChainConfigAtHeightFunc func(v uint64) *params.ChainConfig
+
 	// PrepareFunc mocks the Prepare method.
 	PrepareFunc func(contextMoqParam context.Context)
 
@@ -44,14 +50,20 @@ type ConfigurationPluginMock struct {
 		// ChainConfig holds details about calls to the ChainConfig method.
 		ChainConfig []struct {
 		}
+		// ChainConfigAtHeight holds details about calls to the ChainConfigAtHeight method.
+		ChainConfigAtHeight []struct {
+			// V is the v argument value.
+			V uint64
+		}
 		// Prepare holds details about calls to the Prepare method.
 		Prepare []struct {
 			// ContextMoqParam is the contextMoqParam argument value.
 			ContextMoqParam context.Context
 		}
 	}
-	lockChainConfig sync.RWMutex
-	lockPrepare     sync.RWMutex
+	lockChainConfig         sync.RWMutex
+	lockChainConfigAtHeight sync.RWMutex
+	lockPrepare             sync.RWMutex
 }
 
 // ChainConfig calls ChainConfigFunc.
@@ -81,6 +93,38 @@ func (mock *ConfigurationPluginMock) ChainConfigCalls() []struct {
 	return calls
 }
 
+// ChainConfigAtHeight calls ChainConfigAtHeightFunc.
+func (mock *ConfigurationPluginMock) ChainConfigAtHeight(v uint64) *params.ChainConfig {
+	if mock.ChainConfigAtHeightFunc == nil {
+		panic("ConfigurationPluginMock.ChainConfigAtHeightFunc: method is nil but ConfigurationPlugin.ChainConfigAtHeight was just called")
+	}
+	callInfo := struct {
+		V uint64
+	}{
+		V: v,
+	}
+	mock.lockChainConfigAtHeight.Lock()
+	mock.calls.ChainConfigAtHeight = append(mock.calls.ChainConfigAtHeight, callInfo)
+	mock.lockChainConfigAtHeight.Unlock()
+	return mock.ChainConfigAtHeightFunc(v)
+}
+
+// ChainConfigAtHeightCalls gets all the calls that were made to ChainConfigAtHeight.
+// Check the length with:
+//
+//	len(mockedConfigurationPlugin.ChainConfigAtHeightCalls())
+func (mock *ConfigurationPluginMock) ChainConfigAtHeightCalls() []struct {
+	V uint64
+} {
+	var calls []struct {
+		V uint64
+	}
+	mock.lockChainConfigAtHeight.RLock()
+	calls = mock.calls.ChainConfigAtHeight
+	mock.lockChainConfigAtHeight.RUnlock()
+	return calls
+}
+
 // Prepare calls PrepareFunc.
 func (mock *ConfigurationPluginMock) Prepare(contextMoqParam context.Context) {
 	if mock.PrepareFunc == nil {
@@ -22,6 +22,9 @@ var _ core.HistoricalPlugin = &HistoricalPluginMock{}
 //
 //		// make and configure a mocked core.HistoricalPlugin
 //		mockedHistoricalPlugin := &HistoricalPluginMock{
+//			EarliestBlockNumberFunc: func() uint64 {
+//				panic("mock out the EarliestBlockNumber method")
+//			},
 //			GetBlockByHashFunc: func(hash common.Hash) (*ethereumcoretypes.Block, error) {
 //				panic("mock out the GetBlockByHash method")
 //			},
@@ -53,6 +56,9 @@ var _ core.HistoricalPlugin = &HistoricalPluginMock{}
 //
 //	}
 type HistoricalPluginMock struct {
+	// EarliestBlockNumberFunc mocks the EarliestBlockNumber method.
+	EarliestBlockNumberFunc func() uint64
+
 	// GetBlockByHashFunc mocks the GetBlockByHash method.
 	GetBlockByHashFunc func(hash common.Hash) (*ethereumcoretypes.Block, error)
 
@@ -79,6 +85,9 @@ type HistoricalPluginMock struct {
 
 	// calls tracks calls to the methods.
 	calls struct {
+		// EarliestBlockNumber holds details about calls to the EarliestBlockNumber method.
+		EarliestBlockNumber []struct {
+		}
 		// GetBlockByHash holds details about calls to the GetBlockByHash method.
 		GetBlockByHash []struct {
 			// Hash is the hash argument value.
@@ -126,6 +135,7 @@ type HistoricalPluginMock struct {
 			Transactions ethereumcoretypes.Transactions
 		}
 	}
+	lockEarliestBlockNumber  sync.RWMutex
 	lockGetBlockByHash       sync.RWMutex
 	lockGetBlockByNumber     sync.RWMutex
 	lockGetReceiptsByHash    sync.RWMutex
@@ -136,6 +146,33 @@ type HistoricalPluginMock struct {
 	lockStoreTransactions    sync.RWMutex
 }
 
+// EarliestBlockNumber calls EarliestBlockNumberFunc.
+func (mock *HistoricalPluginMock) EarliestBlockNumber() uint64 {
+	if mock.EarliestBlockNumberFunc == nil {
+		panic("HistoricalPluginMock.EarliestBlockNumberFunc: method is nil but HistoricalPlugin.EarliestBlockNumber was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockEarliestBlockNumber.Lock()
+	mock.calls.EarliestBlockNumber = append(mock.calls.EarliestBlockNumber, callInfo)
+	mock.lockEarliestBlockNumber.Unlock()
+	return mock.EarliestBlockNumberFunc()
+}
+
+// EarliestBlockNumberCalls gets all the calls that were made to EarliestBlockNumber.
+// Check the length with:
+//
+//	len(mockedHistoricalPlugin.EarliestBlockNumberCalls())
+func (mock *HistoricalPluginMock) EarliestBlockNumberCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockEarliestBlockNumber.RLock()
+	calls = mock.calls.EarliestBlockNumber
+	mock.lockEarliestBlockNumber.RUnlock()
+	return calls
+}
+
 // GetBlockByHash calls GetBlockByHashFunc.
 func (mock *HistoricalPluginMock) GetBlockByHash(hash common.Hash) (*ethereumcoretypes.Block, error) {
 	if mock.GetBlockByHashFunc == nil {
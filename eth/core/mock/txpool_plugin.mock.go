@@ -4,6 +4,8 @@
 package mock
 
 import (
+	"context"
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
 	ethereumcore "github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/types"
@@ -32,12 +34,21 @@ var _ ethcore.TxPoolPlugin = &TxPoolPluginMock{}
 //			GetFunc: func(hash common.Hash) *types.Transaction {
 //				panic("mock out the Get method")
 //			},
+//			ListeningFunc: func() bool {
+//				panic("mock out the Listening method")
+//			},
 //			NonceFunc: func(address common.Address) uint64 {
 //				panic("mock out the Nonce method")
 //			},
+//			PeerCountFunc: func() uint64 {
+//				panic("mock out the PeerCount method")
+//			},
 //			PendingFunc: func(b bool) map[common.Address]types.Transactions {
 //				panic("mock out the Pending method")
 //			},
+//			PrepareFunc: func(contextMoqParam context.Context)  {
+//				panic("mock out the Prepare method")
+//			},
 //			SendTxFunc: func(tx *types.Transaction) error {
 //				panic("mock out the SendTx method")
 //			},
@@ -50,6 +61,9 @@ var _ ethcore.TxPoolPlugin = &TxPoolPluginMock{}
 //			SubscribeNewTxsEventFunc: func(ch chan<- ethereumcore.NewTxsEvent) event.Subscription {
 //				panic("mock out the SubscribeNewTxsEvent method")
 //			},
+//			SyncProgressFunc: func() ethereum.SyncProgress {
+//				panic("mock out the SyncProgress method")
+//			},
 //		}
 //
 //		// use mockedTxPoolPlugin in code that requires ethcore.TxPoolPlugin
@@ -66,12 +80,21 @@ type TxPoolPluginMock struct {
 	// GetFunc mocks the Get method.
 	GetFunc func(hash common.Hash) *types.Transaction
 
+	// ListeningFunc mocks the Listening method.
+	ListeningFunc func() bool
+
 	// NonceFunc mocks the Nonce method.
 	NonceFunc func(address common.Address) uint64
 
+	// PeerCountFunc mocks the PeerCount method.
+	PeerCountFunc func() uint64
+
 	// PendingFunc mocks the Pending method.
 	PendingFunc func(b bool) map[common.Address]types.Transactions
 
+	// PrepareFunc mocks the Prepare method.
+	PrepareFunc func(contextMoqParam context.Context)
+
 	// SendTxFunc mocks the SendTx method.
 	SendTxFunc func(tx *types.Transaction) error
 
@@ -84,6 +107,9 @@ type TxPoolPluginMock struct {
 	// SubscribeNewTxsEventFunc mocks the SubscribeNewTxsEvent method.
 	SubscribeNewTxsEventFunc func(ch chan<- ethereumcore.NewTxsEvent) event.Subscription
 
+	// SyncProgressFunc mocks the SyncProgress method.
+	SyncProgressFunc func() ethereum.SyncProgress
+
 	// calls tracks calls to the methods.
 	calls struct {
 		// Content holds details about calls to the Content method.
@@ -99,16 +125,27 @@ type TxPoolPluginMock struct {
 			// Hash is the hash argument value.
 			Hash common.Hash
 		}
+		// Listening holds details about calls to the Listening method.
+		Listening []struct {
+		}
 		// Nonce holds details about calls to the Nonce method.
 		Nonce []struct {
 			// Address is the address argument value.
 			Address common.Address
 		}
+		// PeerCount holds details about calls to the PeerCount method.
+		PeerCount []struct {
+		}
 		// Pending holds details about calls to the Pending method.
 		Pending []struct {
 			// B is the b argument value.
 			B bool
 		}
+		// Prepare holds details about calls to the Prepare method.
+		Prepare []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+		}
 		// SendTx holds details about calls to the SendTx method.
 		SendTx []struct {
 			// Tx is the tx argument value.
@@ -127,16 +164,23 @@ type TxPoolPluginMock struct {
 			// Ch is the ch argument value.
 			Ch chan<- ethereumcore.NewTxsEvent
 		}
+		// SyncProgress holds details about calls to the SyncProgress method.
+		SyncProgress []struct {
+		}
 	}
 	lockContent              sync.RWMutex
 	lockContentFrom          sync.RWMutex
 	lockGet                  sync.RWMutex
+	lockListening            sync.RWMutex
 	lockNonce                sync.RWMutex
+	lockPeerCount            sync.RWMutex
 	lockPending              sync.RWMutex
+	lockPrepare              sync.RWMutex
 	lockSendTx               sync.RWMutex
 	lockSetBaseFee           sync.RWMutex
 	lockStats                sync.RWMutex
 	lockSubscribeNewTxsEvent sync.RWMutex
+	lockSyncProgress         sync.RWMutex
 }
 
 // Content calls ContentFunc.
@@ -230,6 +274,33 @@ func (mock *TxPoolPluginMock) GetCalls() []struct {
 	return calls
 }
 
+// Listening calls ListeningFunc.
+func (mock *TxPoolPluginMock) Listening() bool {
+	if mock.ListeningFunc == nil {
+		panic("TxPoolPluginMock.ListeningFunc: method is nil but TxPoolPlugin.Listening was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockListening.Lock()
+	mock.calls.Listening = append(mock.calls.Listening, callInfo)
+	mock.lockListening.Unlock()
+	return mock.ListeningFunc()
+}
+
+// ListeningCalls gets all the calls that were made to Listening.
+// Check the length with:
+//
+//	len(mockedTxPoolPlugin.ListeningCalls())
+func (mock *TxPoolPluginMock) ListeningCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockListening.RLock()
+	calls = mock.calls.Listening
+	mock.lockListening.RUnlock()
+	return calls
+}
+
 // Nonce calls NonceFunc.
 func (mock *TxPoolPluginMock) Nonce(address common.Address) uint64 {
 	if mock.NonceFunc == nil {
@@ -262,6 +333,33 @@ func (mock *TxPoolPluginMock) NonceCalls() []struct {
 	return calls
 }
 
+// PeerCount calls PeerCountFunc.
+func (mock *TxPoolPluginMock) PeerCount() uint64 {
+	if mock.PeerCountFunc == nil {
+		panic("TxPoolPluginMock.PeerCountFunc: method is nil but TxPoolPlugin.PeerCount was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockPeerCount.Lock()
+	mock.calls.PeerCount = append(mock.calls.PeerCount, callInfo)
+	mock.lockPeerCount.Unlock()
+	return mock.PeerCountFunc()
+}
+
+// PeerCountCalls gets all the calls that were made to PeerCount.
+// Check the length with:
+//
+//	len(mockedTxPoolPlugin.PeerCountCalls())
+func (mock *TxPoolPluginMock) PeerCountCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockPeerCount.RLock()
+	calls = mock.calls.PeerCount
+	mock.lockPeerCount.RUnlock()
+	return calls
+}
+
 // Pending calls PendingFunc.
 func (mock *TxPoolPluginMock) Pending(b bool) map[common.Address]types.Transactions {
 	if mock.PendingFunc == nil {
@@ -294,6 +392,38 @@ func (mock *TxPoolPluginMock) PendingCalls() []struct {
 	return calls
 }
 
+// Prepare calls PrepareFunc.
+func (mock *TxPoolPluginMock) Prepare(contextMoqParam context.Context) {
+	if mock.PrepareFunc == nil {
+		panic("TxPoolPluginMock.PrepareFunc: method is nil but TxPoolPlugin.Prepare was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam context.Context
+	}{
+		ContextMoqParam: contextMoqParam,
+	}
+	mock.lockPrepare.Lock()
+	mock.calls.Prepare = append(mock.calls.Prepare, callInfo)
+	mock.lockPrepare.Unlock()
+	mock.PrepareFunc(contextMoqParam)
+}
+
+// PrepareCalls gets all the calls that were made to Prepare.
+// Check the length with:
+//
+//	len(mockedTxPoolPlugin.PrepareCalls())
+func (mock *TxPoolPluginMock) PrepareCalls() []struct {
+	ContextMoqParam context.Context
+} {
+	var calls []struct {
+		ContextMoqParam context.Context
+	}
+	mock.lockPrepare.RLock()
+	calls = mock.calls.Prepare
+	mock.lockPrepare.RUnlock()
+	return calls
+}
+
 // SendTx calls SendTxFunc.
 func (mock *TxPoolPluginMock) SendTx(tx *types.Transaction) error {
 	if mock.SendTxFunc == nil {
@@ -416,3 +546,30 @@ func (mock *TxPoolPluginMock) SubscribeNewTxsEventCalls() []struct {
 	mock.lockSubscribeNewTxsEvent.RUnlock()
 	return calls
 }
+
+// SyncProgress calls SyncProgressFunc.
+func (mock *TxPoolPluginMock) SyncProgress() ethereum.SyncProgress {
+	if mock.SyncProgressFunc == nil {
+		panic("TxPoolPluginMock.SyncProgressFunc: method is nil but TxPoolPlugin.SyncProgress was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockSyncProgress.Lock()
+	mock.calls.SyncProgress = append(mock.calls.SyncProgress, callInfo)
+	mock.lockSyncProgress.Unlock()
+	return mock.SyncProgressFunc()
+}
+
+// SyncProgressCalls gets all the calls that were made to SyncProgress.
+// Check the length with:
+//
+//	len(mockedTxPoolPlugin.SyncProgressCalls())
+func (mock *TxPoolPluginMock) SyncProgressCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockSyncProgress.RLock()
+	calls = mock.calls.SyncProgress
+	mock.lockSyncProgress.RUnlock()
+	return calls
+}
@@ -0,0 +1,340 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Blackchain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"context"
+	"math/big"
+	"sync"
+
+	"pkg.berachain.dev/jinx/eth/common"
+	"pkg.berachain.dev/jinx/eth/core/precompile"
+	"pkg.berachain.dev/jinx/eth/core/state"
+	"pkg.berachain.dev/jinx/eth/core/types"
+	"pkg.berachain.dev/jinx/eth/core/vm"
+	"pkg.berachain.dev/jinx/lib/blockstm"
+	"pkg.berachain.dev/jinx/lib/errors"
+)
+
+// ParallelHost is an OPTIONAL interface of the Jinx host chain, which supports executing the
+// transactions of a block in parallel. Every worker executes transactions with its own state and
+// precompile plugins, as plugins are not thread safe, on top of a versioned view of the state of
+// the block instead of the state itself.
+type ParallelHost interface {
+	// NewWorker returns new state and precompile plugins for a worker. The precompile plugin may
+	// be nil if the host chain has no precompiles.
+	NewWorker() (StatePlugin, PrecompilePlugin)
+	// ViewContext returns the given context of the block, with its state read from and written
+	// to the given view. It must not share any mutable state with other contexts, e.g. its
+	// event manager and gas meter.
+	ViewContext(context.Context, *blockstm.View) context.Context
+	// Commit writes the final state written by the transactions of the block, as returned by
+	// `blockstm.Execute`, to the state of the given context.
+	Commit(context.Context, []blockstm.KVPair)
+	// EmitEvents emits the events of a transaction, emitted in the given context returned by
+	// `ViewContext`, to the given context of the block. It is called for every transaction in
+	// order, once the state of the block is committed.
+	EmitEvents(ctx, txCtx context.Context)
+	// BalanceKeys returns the prefixes of the keys of the views that hold the balance of the given
+	// account, as read and written by the contexts returned by `ViewContext`.
+	BalanceKeys(common.Address) []string
+}
+
+// parallelWorker is the state plugin and EVM that a worker executes transactions with.
+type parallelWorker struct {
+	sp  *coinbasePlugin
+	evm *vm.GethEVM
+}
+
+// parallelOutcome is the outcome of the final incarnation of a transaction executed in parallel.
+type parallelOutcome struct {
+	receipt *types.Receipt
+	result  *ExecutionResult
+	err     error
+	// ctx is the context that the transaction was executed in, which holds its events.
+	ctx context.Context
+	// credited is the amount the transaction credited to the coinbase.
+	credited *big.Int
+	// observed is whether the transaction accessed the coinbase other than by crediting it.
+	observed bool
+}
+
+// ProcessTransactionsParallel applies the given transactions to the current state of the block,
+// executing them in parallel on the given number of workers with Block-STM. Transactions run
+// speculatively on versioned views of the state, and those that read state which a lower
+// transaction has since written are executed again, so that the outcome is the one of applying
+// them in order with `ProcessTransaction`: it returns the execution result of every transaction,
+// and the events they emitted on the host chain are emitted in order. As the transactions do not
+// run within host chain transactions, the gas they use is not consumed from the gas plugin, but it
+// is accounted for in the gas used by the block.
+//
+// Every transaction credits its fees to the coinbase of the block, which would make all of them
+// conflict, so the credits are deferred and applied once the block is committed instead. It
+// returns `ErrParallelExecution`, without changing the state, if a transaction otherwise accesses
+// the coinbase, through the state plugin or by accessing the keys of its balance in the state of
+// the host chain, e.g. in a precompile, or if the transactions may run out of block gas, as the order in which they
+// consume it would make them conflict as well. It also does if a transaction is rejected, as the
+// host chain must then reject it the way it does when the transaction is processed on its own. The
+// transactions must then be processed one by one.
+func (sp *StateProcessor) ProcessTransactionsParallel(
+	ctx context.Context, ph ParallelHost, txs types.Transactions, workers int,
+) ([]*ExecutionResult, error) {
+	// All transactions must fit in the gas remaining in the block, so that none of them depends
+	// on the gas used by the others.
+	gasRemaining := uint64(0)
+	if blockGasConsumed := sp.gp.BlockGasConsumed(); blockGasConsumed < sp.header.GasLimit {
+		gasRemaining = sp.header.GasLimit - blockGasConsumed
+	}
+	totalGas := uint64(0)
+	for _, tx := range txs {
+		if totalGas += tx.Gas(); totalGas < tx.Gas() || totalGas > gasRemaining {
+			return nil, errors.Wrap(ErrParallelExecution, "transactions may run out of block gas")
+		}
+	}
+
+	// Build the workers, which take turns in executing the transactions.
+	if workers < 1 {
+		workers = 1
+	}
+	blockCtx := sp.evm.Context
+	blockCtx.GetHash = syncGetHash(blockCtx.GetHash)
+	coinbaseKeys := ph.BalanceKeys(sp.header.Coinbase)
+	pool := make(chan *parallelWorker, workers)
+	for i := 0; i < workers; i++ {
+		pool <- sp.newParallelWorker(ph, blockCtx)
+	}
+
+	outcomes := make([]*parallelOutcome, len(txs))
+	writes, err := blockstm.Execute(len(txs), workers, func(txIdx int, view *blockstm.View) {
+		w := <-pool
+		defer func() { pool <- w }()
+		view.Watch(coinbaseKeys...)
+		outcomes[txIdx] = sp.applyParallelTransaction(ctx, ph, w, view, txs[txIdx], gasRemaining)
+	})
+	if err != nil {
+		return nil, errors.Wrap(ErrParallelExecution, err.Error())
+	}
+	for txIdx, outcome := range outcomes {
+		switch {
+		case outcome.observed:
+			return nil, errors.Wrapf(ErrParallelExecution, "transaction %d accesses the coinbase", txIdx)
+		case outcome.err != nil:
+			return nil, errors.Wrapf(ErrParallelExecution, "transaction %d: %v", txIdx, outcome.err)
+		}
+	}
+
+	// Commit the state of the block and add the transactions to it, in order, along with their
+	// events.
+	ph.Commit(ctx, writes)
+	results := make([]*ExecutionResult, len(txs))
+	credited := new(big.Int)
+	for txIdx, outcome := range outcomes {
+		results[txIdx] = outcome.result
+		credited.Add(credited, outcome.credited)
+		ph.EmitEvents(ctx, outcome.ctx)
+
+		receipt, txIndex := outcome.receipt, uint(len(sp.txs))
+		sp.header.GasUsed += receipt.GasUsed
+		receipt.CumulativeGasUsed = sp.header.GasUsed
+		receipt.TransactionIndex = txIndex
+		for _, log := range receipt.Logs {
+			log.TxIndex = txIndex
+		}
+		sp.txs = append(sp.txs, txs[txIdx])
+		sp.receipts = append(sp.receipts, receipt)
+	}
+
+	// Apply the deferred credits to the coinbase.
+	sp.statedb.AddBalance(sp.header.Coinbase, credited)
+	sp.statedb.Finalise(true)
+	return results, nil
+}
+
+// newParallelWorker builds a worker with new plugins from the host chain and an EVM for the
+// current block.
+func (sp *StateProcessor) newParallelWorker(ph ParallelHost, blockCtx vm.BlockContext) *parallelWorker {
+	statePlugin, pp := ph.NewWorker()
+	if pp == nil {
+		pp = precompile.NewDefaultPlugin()
+	}
+	rules := sp.chainConfig.Rules(sp.header.Number, true, sp.header.Time)
+	buildAndRegisterPrecompiles(pp, pp.GetPrecompiles(&rules))
+	buildAndRegisterPrecompiles(pp, precompile.GetDefaultPrecompiles(&rules))
+
+	w := &parallelWorker{sp: newCoinbasePlugin(statePlugin, sp.header.Coinbase)}
+	w.evm = vm.NewGethEVMWithPrecompiles(
+		blockCtx, vm.TxContext{}, state.NewStateDB(w.sp), sp.chainConfig, sp.evm.Config, pp,
+	)
+	return w
+}
+
+// applyParallelTransaction applies an incarnation of the transaction on the given worker, on top
+// of the given view, and calls the post transaction hook. If the transaction is rejected, the
+// writes of the incarnation are discarded. As an incarnation may be aborted at any point, it
+// runs on a new statedb.
+func (sp *StateProcessor) applyParallelTransaction(
+	ctx context.Context, ph ParallelHost, w *parallelWorker, view *blockstm.View,
+	tx *types.Transaction, gasRemaining uint64,
+) *parallelOutcome {
+	txCtx := ph.ViewContext(ctx, view)
+	w.sp.Reset(txCtx)
+	statedb := state.NewStateDB(w.sp)
+	statedb.SetTxContext(tx.Hash(), len(sp.txs)+view.TxIndex())
+
+	gasUsed := uint64(0)
+	receipt, result, err := ApplyTransactionWithEVMWithResult(
+		w.evm, sp.chainConfig, new(GasPool).AddGas(gasRemaining), statedb, sp.header.BaseFee,
+		sp.header.Number, sp.sealhash, sp.header.Time, tx, &gasUsed,
+	)
+	if err != nil {
+		err = errors.Wrapf(err, "could not apply transaction [%s]", tx.Hash().Hex())
+	} else if sp.postTxHook != nil && receipt.Status == types.ReceiptStatusSuccessful {
+		if err = sp.postTxHook(txCtx, tx, receipt); err != nil {
+			err = errors.Wrapf(err, "post transaction hook failed [%s]", tx.Hash().Hex())
+		}
+	}
+
+	outcome := &parallelOutcome{observed: w.sp.observed || view.Touched()}
+	if err != nil {
+		view.Discard()
+		outcome.err = err
+		return outcome
+	}
+	outcome.receipt, outcome.result, outcome.credited = receipt, result, w.sp.credited
+	outcome.ctx = txCtx
+	return outcome
+}
+
+// syncGetHash returns the given function to get block hashes, synchronized for the workers, as it
+// caches the hashes.
+func syncGetHash(getHash vm.GetHashFunc) vm.GetHashFunc {
+	var mu sync.Mutex
+	return func(n uint64) common.Hash {
+		mu.Lock()
+		defer mu.Unlock()
+		return getHash(n)
+	}
+}
+
+// coinbasePlugin is the state plugin of a worker, which defers the credits to the coinbase. As
+// they are not applied to the state, the balance of the coinbase cannot be read, which is
+// recorded as an observation of the coinbase.
+type coinbasePlugin struct {
+	StatePlugin
+	coinbase common.Address
+
+	// credited is the amount credited to the coinbase in the current transaction.
+	credited *big.Int
+	// observed is whether the coinbase was accessed in the current transaction.
+	observed bool
+	// snapshots are the amounts credited at every snapshot, along with the snapshot of the
+	// underlying plugin.
+	snapshots []coinbaseSnapshot
+}
+
+// coinbaseSnapshot is a snapshot of the `coinbasePlugin`.
+type coinbaseSnapshot struct {
+	id       int
+	credited *big.Int
+}
+
+// newCoinbasePlugin returns a plugin which defers the credits to the given coinbase.
+func newCoinbasePlugin(sp StatePlugin, coinbase common.Address) *coinbasePlugin {
+	return &coinbasePlugin{StatePlugin: sp, coinbase: coinbase, credited: new(big.Int)}
+}
+
+// Reset implements `libtypes.Resettable`.
+func (cp *coinbasePlugin) Reset(ctx context.Context) {
+	cp.StatePlugin.Reset(ctx)
+	cp.credited = new(big.Int)
+	cp.observed = false
+	cp.snapshots = cp.snapshots[:0]
+}
+
+// Snapshot implements `libtypes.Snapshottable`.
+func (cp *coinbasePlugin) Snapshot() int {
+	cp.snapshots = append(cp.snapshots, coinbaseSnapshot{
+		id: cp.StatePlugin.Snapshot(), credited: new(big.Int).Set(cp.credited),
+	})
+	return len(cp.snapshots) - 1
+}
+
+// RevertToSnapshot implements `libtypes.Snapshottable`.
+func (cp *coinbasePlugin) RevertToSnapshot(id int) {
+	snapshot := cp.snapshots[id]
+	cp.StatePlugin.RevertToSnapshot(snapshot.id)
+	cp.credited = snapshot.credited
+	cp.snapshots = cp.snapshots[:id]
+}
+
+// Finalize implements `libtypes.Controllable`.
+func (cp *coinbasePlugin) Finalize() {
+	cp.StatePlugin.Finalize()
+	cp.snapshots = cp.snapshots[:0]
+}
+
+// AddBalance implements `state.Plugin`, deferring the credits to the coinbase.
+func (cp *coinbasePlugin) AddBalance(addr common.Address, amount *big.Int) {
+	if addr == cp.coinbase {
+		cp.credited.Add(cp.credited, amount)
+		return
+	}
+	cp.StatePlugin.AddBalance(addr, amount)
+}
+
+// GetBalance implements `state.Plugin`.
+func (cp *coinbasePlugin) GetBalance(addr common.Address) *big.Int {
+	cp.observe(addr)
+	return cp.StatePlugin.GetBalance(addr)
+}
+
+// SetBalance implements `state.Plugin`.
+func (cp *coinbasePlugin) SetBalance(addr common.Address, amount *big.Int) {
+	cp.observe(addr)
+	cp.StatePlugin.SetBalance(addr, amount)
+}
+
+// SubBalance implements `state.Plugin`.
+func (cp *coinbasePlugin) SubBalance(addr common.Address, amount *big.Int) {
+	cp.observe(addr)
+	cp.StatePlugin.SubBalance(addr, amount)
+}
+
+// Empty implements `state.Plugin`.
+func (cp *coinbasePlugin) Empty(addr common.Address) bool {
+	cp.observe(addr)
+	return cp.StatePlugin.Empty(addr)
+}
+
+// DeleteAccounts implements `state.Plugin`.
+func (cp *coinbasePlugin) DeleteAccounts(addrs []common.Address) {
+	for _, addr := range addrs {
+		cp.observe(addr)
+	}
+	cp.StatePlugin.DeleteAccounts(addrs)
+}
+
+// observe records an access to the balance of the given account, if it is the coinbase.
+func (cp *coinbasePlugin) observe(addr common.Address) {
+	if addr == cp.coinbase {
+		cp.observed = true
+	}
+}
@@ -0,0 +1,123 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Blackchain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package precompile
+
+import (
+	"encoding/json"
+	"path"
+	"reflect"
+	"sort"
+
+	"pkg.berachain.dev/jinx/eth/accounts/abi"
+	"pkg.berachain.dev/jinx/eth/common"
+	"pkg.berachain.dev/jinx/eth/common/hexutil"
+	"pkg.berachain.dev/jinx/lib/utils"
+)
+
+// Info describes a stateful precompile, so that tooling like explorers and debuggers can decode
+// the calls to it and the logs it emits.
+type Info struct {
+	// Address is the address of the precompile.
+	Address common.Address `json:"address"`
+	// Name is the name of the precompile.
+	Name string `json:"name"`
+	// ABI is the JSON ABI that the precompile serves.
+	ABI json.RawMessage `json:"abi"`
+	// MethodSelectors are the selectors of the methods of the precompile.
+	MethodSelectors []Signature `json:"methodSelectors"`
+	// EventTopics are the topics of the events of the precompile.
+	EventTopics []Signature `json:"eventTopics"`
+}
+
+// Signature is the signature of a method or an event with its ID, which is the selector of a
+// method and the topic of an event.
+type Signature struct {
+	Signature string        `json:"signature"`
+	ID        hexutil.Bytes `json:"id"`
+}
+
+// NewInfo returns the description of the given stateful precompile, which is generated from the
+// same ABI methods and events as the stateful container built by the `StatefulFactory`.
+func NewInfo(sci StatefulImpl) (*Info, error) {
+	methods, events := sci.ABIMethods(), sci.ABIEvents()
+	abiJSON, err := abi.MarshalJSON(methods, events)
+	if err != nil {
+		return nil, err
+	}
+
+	info := &Info{
+		Address:         sci.RegistryKey(),
+		Name:            nameOf(sci),
+		ABI:             abiJSON,
+		MethodSelectors: make([]Signature, 0, len(methods)),
+		EventTopics:     make([]Signature, 0, len(events)),
+	}
+	for _, method := range methods {
+		info.MethodSelectors = append(
+			info.MethodSelectors, Signature{Signature: method.Sig, ID: method.ID},
+		)
+	}
+	for _, event := range events {
+		info.EventTopics = append(
+			info.EventTopics, Signature{Signature: event.Sig, ID: event.ID.Bytes()},
+		)
+	}
+	sortSignatures(info.MethodSelectors)
+	sortSignatures(info.EventTopics)
+	return info, nil
+}
+
+// GetInfos returns the descriptions of the given precompiles that are stateful, in order.
+func GetInfos(precompiles []Registrable) ([]*Info, error) {
+	infos := make([]*Info, 0, len(precompiles))
+	for _, rp := range precompiles {
+		sci, ok := utils.GetAs[StatefulImpl](rp)
+		if !ok {
+			continue
+		}
+		info, err := NewInfo(sci)
+		if err != nil {
+			return nil, err
+		}
+		infos = append(infos, info)
+	}
+	return infos, nil
+}
+
+// nameOf returns the name of a dynamic precompile, or else the name of the package that
+// implements the precompile, e.g. `staking`.
+func nameOf(sci StatefulImpl) string {
+	if di, ok := utils.GetAs[DynamicImpl](sci); ok {
+		return di.Name()
+	}
+	t := reflect.TypeOf(sci)
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return path.Base(t.PkgPath())
+}
+
+// sortSignatures sorts the given signatures by signature.
+func sortSignatures(sigs []Signature) {
+	sort.Slice(sigs, func(i, j int) bool {
+		return sigs[i].Signature < sigs[j].Signature
+	})
+}
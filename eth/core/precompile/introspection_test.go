@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Blackchain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package precompile_test
+
+import (
+	"pkg.berachain.dev/jinx/eth/accounts/abi"
+	"pkg.berachain.dev/jinx/eth/common/hexutil"
+	"pkg.berachain.dev/jinx/eth/core/precompile"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Introspection", func() {
+	It("should describe the stateful precompiles with their ABI", func() {
+		infos, err := precompile.GetInfos([]precompile.Registrable{
+			&mockStateless{&mockBase{}},
+			&mockStateful{&mockBase{}},
+			&mockDynamic{&mockStateful{&mockBase{}}},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(infos).To(HaveLen(2))
+
+		info := infos[0]
+		Expect(info.Address).To(Equal((&mockBase{}).RegistryKey()))
+		Expect(info.Name).To(Equal("precompile_test"))
+		method := mockPrecompile.Methods["getOutput"]
+		Expect(info.MethodSelectors).To(Equal([]precompile.Signature{
+			{Signature: method.Sig, ID: hexutil.Bytes(method.ID)},
+		}))
+		Expect(info.EventTopics).To(BeEmpty())
+		decoded := abi.MustUnmarshalJSON(string(info.ABI))
+		Expect(decoded.Methods).To(HaveKey("getOutput"))
+		Expect(decoded.Methods["getOutput"].ID).To(Equal(method.ID))
+
+		Expect(infos[1].Name).To(Equal("mock"))
+	})
+})
+
+type mockDynamic struct {
+	*mockStateful
+}
+
+func (md *mockDynamic) Name() string {
+	return "mock"
+}
@@ -23,6 +23,7 @@ package core
 import (
 	"context"
 	"fmt"
+	"math/big"
 	"sync"
 
 	"github.com/ethereum/go-ethereum/trie"
@@ -31,6 +32,8 @@ import (
 	"pkg.berachain.dev/jinx/eth/core/precompile"
 	"pkg.berachain.dev/jinx/eth/core/types"
 	"pkg.berachain.dev/jinx/eth/core/vm"
+	"pkg.berachain.dev/jinx/eth/crypto"
+	"pkg.berachain.dev/jinx/eth/params"
 	"pkg.berachain.dev/jinx/lib/errors"
 	"pkg.berachain.dev/jinx/lib/utils"
 )
@@ -38,6 +41,13 @@ import (
 // initialTxsCapacity is the initial capacity of the transactions and receipts slice.
 const initialTxsCapacity = 256
 
+// systemCallSourcePrefix is the prefix of the source of the synthetic receipts of system calls.
+const systemCallSourcePrefix = "system_call_"
+
+// PostTxHook is called with the receipt of every transaction that executed successfully, before
+// the transaction is added to the block. If it returns an error, the transaction is rejected.
+type PostTxHook func(ctx context.Context, tx *types.Transaction, receipt *types.Receipt) error
+
 // StateProcessor is responsible for processing blocks, transactions, and updating the state.
 type StateProcessor struct {
 	// mtx is used to make sure we don't try to prepare a new block before finalizing the
@@ -55,6 +65,8 @@ type StateProcessor struct {
 	// signer is the signer used to verify transaction signatures. We need this in order to to
 	// extract the underlying message from a transaction object in `ProcessTransaction`.
 	signer types.Signer
+	// chainConfig is the chain config that is active at the block being processed.
+	chainConfig *params.ChainConfig
 
 	// evm is the EVM that is used to process transactions. We re-use a single EVM for processing
 	// the entire block. This is done in order to reduce memory allocs.
@@ -63,6 +75,8 @@ type StateProcessor struct {
 	statedb vm.JinxStateDB
 	// vmConfig is the configuration for the EVM.
 	vmConfig *vm.Config
+	// postTxHook is called after every successful transaction, if set.
+	postTxHook PostTxHook
 
 	// We store information about the current block being processed so that we can access it
 	// during the processing of transactions. This allows us to utilize this information to
@@ -100,6 +114,11 @@ func NewStateProcessor(
 	return sp
 }
 
+// SetPostTxHook sets the hook that is called after every successful transaction.
+func (sp *StateProcessor) SetPostTxHook(hook PostTxHook) {
+	sp.postTxHook = hook
+}
+
 // ==============================================================================
 // Block, Tx Lifecycle
 // ==============================================================================
@@ -122,12 +141,12 @@ func (sp *StateProcessor) Prepare(evm *vm.GethEVM, header *types.Header) {
 	}
 
 	// We must re-create the signer since we are processing a new block and the block number has
-	// increased.
-	chainConfig := sp.cp.ChainConfig()
-	sp.signer = types.MakeSigner(chainConfig, sp.header.Number, sp.header.Time)
+	// increased. The chain config may have changed as well.
+	sp.chainConfig = sp.cp.ChainConfigAtHeight(sp.header.Number.Uint64())
+	sp.signer = types.MakeSigner(sp.chainConfig, sp.header.Number, sp.header.Time)
 
 	// Setup the EVM for this block.
-	rules := chainConfig.Rules(sp.header.Number, true, sp.header.Time)
+	rules := sp.chainConfig.Rules(sp.header.Number, true, sp.header.Time)
 
 	// We re-register the default geth precompiles every block, this isn't optimal, but since
 	// *technically* the precompiles change based on the chain config rules, to be fully correct,
@@ -138,7 +157,7 @@ func (sp *StateProcessor) Prepare(evm *vm.GethEVM, header *types.Header) {
 
 // ProcessTransaction applies a transaction to the current state of the blockchain.
 func (sp *StateProcessor) ProcessTransaction(
-	_ context.Context, tx *types.Transaction,
+	ctx context.Context, tx *types.Transaction,
 ) (*ExecutionResult, error) {
 	// We set the gasPool = gasLimit - gasUsed.
 	gasPool := new(GasPool).AddGas(sp.header.GasLimit - sp.gp.BlockGasConsumed())
@@ -148,18 +167,100 @@ func (sp *StateProcessor) ProcessTransaction(
 	sp.statedb.SetTxContext(tx.Hash(), len(sp.txs))
 
 	// Inshallah we will be able to apply the transaction.
+	gasUsed := sp.header.GasUsed
 	receipt, result, err := ApplyTransactionWithEVMWithResult(
-		sp.evm, sp.cp.ChainConfig(), gasPool, sp.statedb, sp.header.BaseFee,
+		sp.evm, sp.chainConfig, gasPool, sp.statedb, sp.header.BaseFee,
 		sp.header.Number, sp.sealhash, sp.header.Time, tx, &sp.header.GasUsed,
 	)
 	if err != nil {
 		return nil, errors.Wrapf(err, "could not apply transaction [%s]", tx.Hash().Hex())
 	}
 
-	// Consume the gas used by the state transition. In both the out of block gas as well as out of
-	// gas on the plugin cases, the line below will consume the remaining gas for the block and
-	// transaction respectively.
-	if err = sp.gp.ConsumeGas(receipt.GasUsed); err != nil {
+	return sp.addTransaction(ctx, tx, receipt, result, gasUsed)
+}
+
+// ProcessTransactionFrom applies a transaction from the given sender, which authorized it on the
+// host chain instead of signing it, to the current state of the blockchain. Its nonce must be the
+// nonce of the sender. No gas fees are charged, as the host chain charges them.
+func (sp *StateProcessor) ProcessTransactionFrom(
+	ctx context.Context, tx *types.Transaction, from common.Address,
+) (*ExecutionResult, error) {
+	// We set the gasPool = gasLimit - gasUsed.
+	gasPool := new(GasPool).AddGas(sp.header.GasLimit - sp.gp.BlockGasConsumed())
+
+	// Set the transaction context in the state database.
+	// This clears the logs and sets the transaction info.
+	txHash, blockNumber := tx.Hash(), sp.header.Number.Uint64()
+	sp.statedb.SetTxContext(txHash, len(sp.txs))
+
+	// Apply the message of the transaction, without the base fee, as it does not pay a gas price.
+	msg := &Message{
+		To:        tx.To(),
+		From:      from,
+		Nonce:     tx.Nonce(),
+		Value:     tx.Value(),
+		GasLimit:  tx.Gas(),
+		GasPrice:  new(big.Int),
+		GasFeeCap: new(big.Int),
+		GasTipCap: new(big.Int),
+		Data:      tx.Data(),
+	}
+	sp.evm.Config.NoBaseFee = true
+	defer func() { sp.evm.Config.NoBaseFee = false }()
+	sp.evm.Reset(NewEVMTxContext(msg), sp.statedb)
+	result, err := ApplyMessage(sp.evm, msg, gasPool)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not apply transaction [%s]", txHash.Hex())
+	}
+	sp.statedb.Finalise(true)
+
+	// Build the receipt of the transaction.
+	gasUsed := sp.header.GasUsed
+	sp.header.GasUsed += result.UsedGas
+	receipt := &types.Receipt{
+		Type:              tx.Type(),
+		Status:            types.ReceiptStatusSuccessful,
+		CumulativeGasUsed: sp.header.GasUsed,
+		TxHash:            txHash,
+		GasUsed:           result.UsedGas,
+		Logs:              sp.statedb.GetLogs(txHash, blockNumber, sp.sealhash),
+		BlockHash:         sp.sealhash,
+		BlockNumber:       new(big.Int).Set(sp.header.Number),
+		TransactionIndex:  uint(len(sp.txs)),
+	}
+	if result.Failed() {
+		receipt.Status = types.ReceiptStatusFailed
+	}
+	if msg.To == nil {
+		receipt.ContractAddress = crypto.CreateAddress(from, tx.Nonce())
+	}
+	receipt.Bloom = types.CreateBloom(types.Receipts{receipt})
+
+	return sp.addTransaction(ctx, tx, receipt, result, gasUsed)
+}
+
+// addTransaction adds the applied transaction and its receipt to the block, after calling the post
+// transaction hook and consuming its gas. If it fails, the gas used by the block is restored to
+// the given amount.
+func (sp *StateProcessor) addTransaction(
+	ctx context.Context, tx *types.Transaction, receipt *types.Receipt, result *ExecutionResult,
+	gasUsed uint64,
+) (*ExecutionResult, error) {
+	// Call the post transaction hook on success. If it fails, the transaction is rejected, so we
+	// remove its gas from the block.
+	if sp.postTxHook != nil && receipt.Status == types.ReceiptStatusSuccessful {
+		if err := sp.postTxHook(ctx, tx, receipt); err != nil {
+			sp.header.GasUsed = gasUsed
+			return nil, errors.Wrapf(err, "post transaction hook failed [%s]", tx.Hash().Hex())
+		}
+	}
+
+	// Consume the gas used by the state transition, which is net of the EIP-3529 refund, so that
+	// the gas used by the host chain transaction matches the receipt. In both the out of block gas
+	// and out of gas cases, the plugin returns an error without consuming any gas and the
+	// transaction is rejected, so we remove its gas from the block.
+	if err := sp.gp.ConsumeGas(receipt.GasUsed); err != nil {
+		sp.header.GasUsed = gasUsed
 		return nil, errors.Wrapf(err, "could not consume gas used %d [%s]", len(sp.txs), tx.Hash().Hex())
 	}
 
@@ -168,7 +269,84 @@ func (sp *StateProcessor) ProcessTransaction(
 	sp.receipts = append(sp.receipts, receipt)
 
 	// Return the execution result to the caller.
-	return result, err
+	return result, nil
+}
+
+// ProcessSystemCall executes a call to the given address, or a contract creation if it is nil, from
+// the given sender with the given gas limit on top of the current state of the block. It is not
+// part of any transaction, so no gas is consumed from the block and the sender's nonce is only
+// incremented by a contract creation. The state changes are only kept if commit is true, in which
+// case the logs of the call are added to the block in a synthetic receipt. Like for transactions,
+// a reverted call is reported in the error of the execution result.
+func (sp *StateProcessor) ProcessSystemCall(
+	sender common.Address, to *common.Address, data []byte, value *big.Int, gasLimit uint64, commit bool,
+) (*ExecutionResult, common.Address, error) {
+	// The system call is identified by its position in the block.
+	source := []byte(fmt.Sprintf("%s%d", systemCallSourcePrefix, len(sp.txs)))
+	txHash := types.NewSyntheticTx(sp.header.Number.Uint64(), source).Hash()
+	sp.statedb.SetTxContext(txHash, len(sp.txs))
+
+	// Setup the EVM and the state for a new message from the sender.
+	rules := sp.chainConfig.Rules(sp.header.Number, true, sp.header.Time)
+	sp.statedb.Prepare(rules, sender, sp.header.Coinbase, to, nil, nil)
+	sp.evm.Reset(vm.TxContext{Origin: sender, GasPrice: new(big.Int)}, sp.statedb)
+	snapshot := sp.statedb.Snapshot()
+
+	var (
+		ret          []byte
+		contractAddr common.Address
+		gasRemaining uint64
+		err          error
+	)
+	if to == nil {
+		ret, contractAddr, gasRemaining, err = sp.evm.Create(vm.AccountRef(sender), data, gasLimit, value)
+	} else {
+		ret, gasRemaining, err = sp.evm.Call(vm.AccountRef(sender), *to, data, gasLimit, value)
+	}
+	result := &ExecutionResult{UsedGas: gasLimit - gasRemaining, Err: err, ReturnData: ret}
+	if !commit || result.Failed() {
+		// Discard the state changes and clear the journals of the call (suicides, created
+		// accounts, refund, and transient storage), so that they do not leak into the next
+		// transaction of the block.
+		sp.statedb.RevertToSnapshot(snapshot)
+		sp.statedb.Finalise(true)
+		return result, contractAddr, nil
+	}
+
+	// Commit the state changes and add the logs to the block.
+	sp.statedb.Finalise(true)
+	if logs := sp.statedb.Logs(); len(logs) > 0 {
+		sp.ProcessSyntheticLogs(source, logs)
+	}
+	return result, contractAddr, nil
+}
+
+// ProcessSyntheticLogs appends a synthetic transaction and receipt, carrying the given logs of host
+// chain events from the given source, to the block. The source must be unique within the block,
+// as it determines the hash of the synthetic transaction. No gas is used.
+func (sp *StateProcessor) ProcessSyntheticLogs(source []byte, logs []*types.Log) {
+	tx := types.NewSyntheticTx(sp.header.Number.Uint64(), source)
+	txHash, txIndex := tx.Hash(), uint(len(sp.txs))
+
+	for _, log := range logs {
+		log.TxHash = txHash
+		log.TxIndex = txIndex
+		log.BlockNumber = sp.header.Number.Uint64()
+	}
+	receipt := &types.Receipt{
+		Type:              tx.Type(),
+		Status:            types.ReceiptStatusSuccessful,
+		CumulativeGasUsed: sp.header.GasUsed,
+		Logs:              logs,
+		TxHash:            txHash,
+		BlockNumber:       new(big.Int).Set(sp.header.Number),
+		TransactionIndex:  txIndex,
+	}
+	receipt.Bloom = types.CreateBloom(types.Receipts{receipt})
+
+	// Update the block information.
+	sp.txs = append(sp.txs, tx)
+	sp.receipts = append(sp.receipts, receipt)
 }
 
 // Finalize finalizes the block in the state processor and returns the receipts and bloom filter to
@@ -210,9 +388,15 @@ func (sp *StateProcessor) Finalize(
 
 // BuildPrecompiles builds the given precompiles and registers them with the precompile plugins.
 func (sp *StateProcessor) BuildAndRegisterPrecompiles(precompiles []precompile.Registrable) {
+	buildAndRegisterPrecompiles(sp.pp, precompiles)
+}
+
+// buildAndRegisterPrecompiles builds the given precompiles and registers them with the given
+// precompile plugin.
+func buildAndRegisterPrecompiles(pp PrecompilePlugin, precompiles []precompile.Registrable) {
 	for _, pc := range precompiles {
 		// skip registering precompiles that are already registered.
-		if sp.pp.Has(pc.RegistryKey()) {
+		if pp.Has(pc.RegistryKey()) {
 			continue
 		}
 
@@ -232,12 +416,12 @@ func (sp *StateProcessor) BuildAndRegisterPrecompiles(precompiles []precompile.R
 		}
 
 		// build the precompile container and register with the plugin
-		container, err := af.Build(pc, sp.pp)
+		container, err := af.Build(pc, pp)
 		if err != nil {
 			panic(err)
 		}
 		// TODO: set code on the statedb for every precompiled contract.
-		err = sp.pp.Register(container)
+		err = pp.Register(container)
 		if err != nil {
 			panic(err)
 		}
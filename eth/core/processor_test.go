@@ -22,6 +22,7 @@ package core_test
 
 import (
 	"context"
+	"errors"
 	"math/big"
 
 	bindings "pkg.berachain.dev/jinx/contracts/bindings/testing"
@@ -73,8 +74,8 @@ var _ = Describe("StateProcessor", func() {
 	BeforeEach(func() {
 		sdb = vmmock.NewEmptyStateDB()
 		_, bp, cp, gp, _, pp, _, _ = mock.NewMockHostAndPlugins()
-		bp.GetNewBlockMetadataFunc = func(n uint64) (common.Address, uint64) {
-			return common.BytesToAddress([]byte{2}), uint64(3)
+		bp.GetNewBlockMetadataFunc = func(n uint64) (common.Address, uint64, common.Hash) {
+			return common.BytesToAddress([]byte{2}), uint64(3), common.Hash{4}
 		}
 		pp.HasFunc = func(addr common.Address) bool {
 			return false
@@ -141,6 +142,87 @@ var _ = Describe("StateProcessor", func() {
 			Expect(logs).To(BeEmpty())
 		})
 
+		It("should reject a transaction if the post tx hook fails", func() {
+			signedTx := types.MustSignNewTx(key, signer, legacyTxData)
+			sdb.GetBalanceFunc = func(addr common.Address) *big.Int {
+				return big.NewInt(1000001)
+			}
+			sdb.FinaliseFunc = func(bool) {}
+			Expect(gp.SetTxGasLimit(1000002)).ToNot(HaveOccurred())
+			gasUsed := dummyHeader.GasUsed
+			var hookReceipt *types.Receipt
+			sp.SetPostTxHook(func(_ context.Context, tx *types.Transaction, receipt *types.Receipt) error {
+				Expect(tx.Hash()).To(Equal(signedTx.Hash()))
+				hookReceipt = receipt
+				return errors.New("hook failed")
+			})
+			result, err := sp.ProcessTransaction(context.Background(), signedTx)
+			Expect(err).To(HaveOccurred())
+			Expect(result).To(BeNil())
+			Expect(hookReceipt).ToNot(BeNil())
+			Expect(hookReceipt.TxHash).To(Equal(signedTx.Hash()))
+			block, receipts, _, err := sp.Finalize(context.Background())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(block.GasUsed()).To(Equal(gasUsed))
+			Expect(receipts).To(BeEmpty())
+		})
+
+		It("should reject a transaction if its gas cannot be consumed", func() {
+			signedTx := types.MustSignNewTx(key, signer, legacyTxData)
+			sdb.GetBalanceFunc = func(addr common.Address) *big.Int {
+				return big.NewInt(1000001)
+			}
+			sdb.FinaliseFunc = func(bool) {}
+			Expect(gp.SetTxGasLimit(1)).ToNot(HaveOccurred())
+			gasUsed := dummyHeader.GasUsed
+			result, err := sp.ProcessTransaction(context.Background(), signedTx)
+			Expect(err).To(HaveOccurred())
+			Expect(result).To(BeNil())
+			Expect(gp.GasConsumed()).To(BeZero())
+			block, receipts, _, err := sp.Finalize(context.Background())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(block.GasUsed()).To(Equal(gasUsed))
+			Expect(receipts).To(BeEmpty())
+		})
+
+		It("should add synthetic receipts", func() {
+			sp.ProcessSyntheticLogs([]byte("begin_block"), []*types.Log{{Address: common.Address{0x1}}})
+			block, receipts, logs, err := sp.Finalize(context.Background())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(block.Transactions()).To(HaveLen(1))
+			syntheticTx := block.Transactions()[0]
+			Expect(syntheticTx.Hash()).To(Equal(types.NewSyntheticTx(1, []byte("begin_block")).Hash()))
+			Expect(receipts).To(HaveLen(1))
+			Expect(receipts[0].TxHash).To(Equal(syntheticTx.Hash()))
+			Expect(receipts[0].Status).To(Equal(types.ReceiptStatusSuccessful))
+			Expect(logs).To(HaveLen(1))
+			Expect(logs[0].TxHash).To(Equal(syntheticTx.Hash()))
+			Expect(logs[0].BlockHash).To(Equal(block.Hash()))
+		})
+
+		It("should discard the state and the journals of a non-committed system call", func() {
+			var reverted []int
+			finalised := 0
+			sdb.SnapshotFunc = func() int { return 7 }
+			sdb.RevertToSnapshotFunc = func(id int) { reverted = append(reverted, id) }
+			sdb.FinaliseFunc = func(bool) { finalised++ }
+			sdb.LogsFunc = func() []*types.Log { return nil }
+			sender := common.BytesToAddress([]byte{0x5})
+
+			result, _, err := sp.ProcessSystemCall(sender, &dummyContract, nil, new(big.Int), 100000, false)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Failed()).To(BeFalse())
+			Expect(reverted).To(Equal([]int{7}))
+			Expect(finalised).To(Equal(1))
+
+			// a committed call keeps the state.
+			result, _, err = sp.ProcessSystemCall(sender, &dummyContract, nil, new(big.Int), 100000, true)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Failed()).To(BeFalse())
+			Expect(reverted).To(Equal([]int{7}))
+			Expect(finalised).To(Equal(2))
+		})
+
 		It("should handle", func() {
 			sdb.GetBalanceFunc = func(addr common.Address) *big.Int {
 				return big.NewInt(1000001)
@@ -185,14 +267,87 @@ var _ = Describe("StateProcessor", func() {
 			Expect(logs).To(BeEmpty())
 		})
 	})
+
+	Context("Beacon root", func() {
+		var (
+			header  *types.Header
+			code    map[common.Address][]byte
+			storage map[common.Hash]common.Hash
+		)
+
+		BeforeEach(func() {
+			_, _, _, err := sp.Finalize(context.Background())
+			Expect(err).ToNot(HaveOccurred())
+
+			code = make(map[common.Address][]byte)
+			storage = make(map[common.Hash]common.Hash)
+			sdb.GetCodeFunc = func(addr common.Address) []byte { return code[addr] }
+			sdb.GetCodeSizeFunc = func(addr common.Address) int { return len(code[addr]) }
+			sdb.GetCodeHashFunc = func(addr common.Address) common.Hash {
+				return crypto.Keccak256Hash(code[addr])
+			}
+			sdb.SetCodeFunc = func(addr common.Address, bz []byte) { code[addr] = bz }
+			sdb.ExistFunc = func(addr common.Address) bool { return code[addr] != nil }
+			sdb.GetStateFunc = func(_ common.Address, key common.Hash) common.Hash { return storage[key] }
+			sdb.SetStateFunc = func(_ common.Address, key, value common.Hash) { storage[key] = value }
+			sdb.LogsFunc = func() []*types.Log { return nil }
+
+			header = &types.Header{
+				Number:   big.NewInt(1),
+				BaseFee:  big.NewInt(1),
+				GasLimit: uint64(blockGasLimit),
+				Time:     1000,
+			}
+		})
+
+		prepare := func() {
+			evm = vm.NewGethEVMWithPrecompiles(
+				vm.BlockContext{
+					Transfer:    core.Transfer,
+					CanTransfer: core.CanTransfer,
+					BlockNumber: header.Number,
+					Time:        header.Time,
+					BaseFee:     header.BaseFee,
+				}, vm.TxContext{}, sdb, cp.ChainConfigAtHeight(header.Number.Uint64()),
+				vm.Config{}, pp,
+			)
+			sp.Prepare(evm, header)
+		}
+
+		It("should store the beacon root after Cancun", func() {
+			cancunTime := uint64(0)
+			chainConfig := *params.DefaultChainConfig
+			chainConfig.CancunTime = &cancunTime
+			cp.ChainConfigAtHeightFunc = func(number uint64) *params.ChainConfig {
+				Expect(number).To(Equal(header.Number.Uint64()))
+				return &chainConfig
+			}
+			prepare()
+			root := common.Hash{0x1}
+			Expect(sp.ProcessBeaconRoot(root)).To(Succeed())
+			Expect(code[core.BeaconRootsAddress]).ToNot(BeEmpty())
+
+			// The contract stores the timestamp and the root in a ring buffer of 8191 slots.
+			slot := new(big.Int).SetUint64(header.Time % 8191)
+			Expect(storage[common.BigToHash(slot)]).To(Equal(common.BigToHash(big.NewInt(1000))))
+			Expect(storage[common.BigToHash(slot.Add(slot, big.NewInt(8191)))]).To(Equal(root))
+		})
+
+		It("should not store the beacon root before Cancun", func() {
+			prepare()
+			Expect(sp.ProcessBeaconRoot(common.Hash{0x1})).To(Succeed())
+			Expect(code).To(BeEmpty())
+			Expect(storage).To(BeEmpty())
+		})
+	})
 })
 
 var _ = Describe("No precompile plugin provided", func() {
 	It("should use the default plugin if none is provided", func() {
 		_, bp, cp, gp, _, _, _, _ := mock.NewMockHostAndPlugins()
 		gp.SetBlockGasLimit(uint64(blockGasLimit))
-		bp.GetNewBlockMetadataFunc = func(n uint64) (common.Address, uint64) {
-			return common.BytesToAddress([]byte{2}), uint64(3)
+		bp.GetNewBlockMetadataFunc = func(n uint64) (common.Address, uint64, common.Hash) {
+			return common.BytesToAddress([]byte{2}), uint64(3), common.Hash{4}
 		}
 		sp := core.NewStateProcessor(cp, gp, nil, vmmock.NewEmptyStateDB(), &vm.Config{})
 		Expect(func() {
@@ -56,6 +56,14 @@ type Suicides interface {
 	HasSuicided(common.Address) bool
 	// GetSuicides returns all suicided addresses from the tx.
 	GetSuicides() []common.Address
+	// MarkCreated records that the given address was created in the current tx.
+	MarkCreated(common.Address)
+	// SetEIP6780 sets whether the EIP-6780 (Cancun) semantics of SELFDESTRUCT are enabled.
+	SetEIP6780(bool)
+	// Credited records that an account was credited with the given amount in the current call.
+	// The SELFDESTRUCT opcode transfers the balance of the contract to the beneficiary by
+	// crediting it right before calling Suicide.
+	Credited(*big.Int)
 }
 
 // Dirty tracking of suicided accounts, we have to keep track of these manually, in order for the
@@ -67,6 +75,14 @@ type suicides struct {
 	ssp     suicideStatePlugin
 	// lastSnapshot ensures that only 1 address is being suicided per snapshot
 	lastSnapshot int
+	// created is the set of addresses created in the current tx. A reverted creation is not
+	// removed, as the address has no code to suicide with afterwards.
+	created map[common.Address]struct{}
+	// eip6780 is whether only the contracts created in the current tx can be deleted.
+	eip6780 bool
+	// lastCredit is the amount of the last credit of the current call, nil if there is none. It is
+	// reset by every snapshot and revert, as every call starts with a snapshot.
+	lastCredit *big.Int
 }
 
 // NewSuicides returns a new suicides journal.
@@ -75,6 +91,7 @@ func NewSuicides(ssp suicideStatePlugin) Suicides {
 		journal:      stack.New[*common.Address](initCapacity),
 		ssp:          ssp,
 		lastSnapshot: -1,
+		created:      make(map[common.Address]struct{}),
 	}
 }
 
@@ -85,7 +102,8 @@ func (s *suicides) RegistryKey() string {
 
 // Suicide implements the JinxStateDB interface by marking the given address as suicided.
 // This clears the account balance, but the code and state of the address remains available
-// until after Commit is called.
+// until after Commit is called. With EIP-6780 enabled, a contract that was not created in the
+// current tx is not marked as suicided and only transfers its balance to the beneficiary.
 func (s *suicides) Suicide(addr common.Address) bool {
 	// ensure only one suicide per snapshot call
 	if s.journal.Size() > s.lastSnapshot {
@@ -99,7 +117,22 @@ func (s *suicides) Suicide(addr common.Address) bool {
 		return false
 	}
 
-	// Reduce it's balance to 0.
+	// The balance has already been credited to the beneficiary, by the last credit of the call.
+	transferred := s.lastCredit
+	s.lastCredit = nil
+
+	// EIP-6780: only contracts created in the same tx are deleted. The contract pays the amount
+	// credited to the beneficiary, which leaves a contract that is its own beneficiary with its
+	// balance. Without a credit, nothing was transferred and the balance is burnt.
+	if _, created := s.created[addr]; s.eip6780 && !created {
+		if transferred == nil {
+			transferred = s.ssp.GetBalance(addr)
+		}
+		s.ssp.SubBalance(addr, transferred)
+		return false
+	}
+
+	// Reduce it's balance to 0, burning what was credited to the contract itself.
 	s.ssp.SubBalance(addr, s.ssp.GetBalance(addr))
 
 	// add to journal.
@@ -127,20 +160,40 @@ func (s *suicides) GetSuicides() []common.Address {
 	return suicidalAddrs
 }
 
+// MarkCreated implements Suicides.
+func (s *suicides) MarkCreated(addr common.Address) {
+	s.created[addr] = struct{}{}
+}
+
+// SetEIP6780 implements Suicides.
+func (s *suicides) SetEIP6780(enabled bool) {
+	s.eip6780 = enabled
+}
+
+// Credited implements Suicides.
+func (s *suicides) Credited(amount *big.Int) {
+	s.lastCredit = new(big.Int).Set(amount)
+}
+
 // Snapshot implements libtypes.Controllable.
 func (s *suicides) Snapshot() int {
 	s.lastSnapshot = s.journal.Size()
+	s.lastCredit = nil
 	return s.lastSnapshot
 }
 
 // RevertToSnapshot implements libtypes.Controllable.
 func (s *suicides) RevertToSnapshot(id int) {
 	s.journal.PopToSize(id)
+	s.lastCredit = nil
 }
 
-// Finalize implements libtypes.Controllable.
+// Finalize implements libtypes.Controllable. The EIP-6780 flag is kept, as it depends on the
+// chain rules rather than on the tx.
 func (s *suicides) Finalize() {
+	eip6780 := s.eip6780
 	*s = *utils.MustGetAs[*suicides](NewSuicides(s.ssp))
+	s.eip6780 = eip6780
 }
 
 // Clone implements libtypes.Cloneable.
@@ -150,6 +203,9 @@ func (s *suicides) Clone() Suicides {
 		journal:      stack.New[*common.Address](size),
 		ssp:          s.ssp,
 		lastSnapshot: s.lastSnapshot,
+		created:      make(map[common.Address]struct{}, len(s.created)),
+		eip6780:      s.eip6780,
+		lastCredit:   s.lastCredit,
 	}
 
 	// copy every address from the journal
@@ -158,6 +214,9 @@ func (s *suicides) Clone() Suicides {
 		*cpy = *s.journal.PeekAt(i)
 		clone.journal.Push(cpy)
 	}
+	for addr := range s.created {
+		clone.created[addr] = struct{}{}
+	}
 
 	return clone
 }
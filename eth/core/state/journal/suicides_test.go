@@ -21,6 +21,8 @@
 package journal
 
 import (
+	"math/big"
+
 	"pkg.berachain.dev/jinx/eth/common"
 	"pkg.berachain.dev/jinx/eth/core/state/journal/mock"
 	"pkg.berachain.dev/jinx/lib/utils"
@@ -74,6 +76,72 @@ var _ = Describe("Suicides", func() {
 		Expect(s.HasSuicided(a1)).To(BeFalse())
 	})
 
+	It("should only suicide contracts created in the tx with EIP-6780", func() {
+		s.SetEIP6780(true)
+		s.MarkCreated(a3)
+
+		s.Snapshot()
+		Expect(s.Suicide(a1)).To(BeFalse())
+		Expect(s.HasSuicided(a1)).To(BeFalse())
+		Expect(s.Suicide(a3)).To(BeTrue())
+		Expect(s.HasSuicided(a3)).To(BeTrue())
+		Expect(s.GetSuicides()).To(ConsistOf(a3))
+
+		s.Finalize()
+		Expect(s.eip6780).To(BeTrue())
+		Expect(s.created).To(BeEmpty())
+		s.Snapshot()
+		Expect(s.Suicide(a3)).To(BeFalse())
+	})
+
+	It("should keep the balance of a contract that is its own beneficiary with EIP-6780", func() {
+		balances := map[common.Address]*big.Int{a1: big.NewInt(10)}
+		ssp := mock.NewSuicidesStatePluginMock()
+		ssp.GetBalanceFunc = func(addr common.Address) *big.Int {
+			if balances[addr] == nil {
+				return new(big.Int)
+			}
+			return new(big.Int).Set(balances[addr])
+		}
+		ssp.SubBalanceFunc = func(addr common.Address, amount *big.Int) {
+			balances[addr] = new(big.Int).Sub(ssp.GetBalance(addr), amount)
+		}
+		credit := func(addr common.Address, amount *big.Int) {
+			s.Credited(amount)
+			balances[addr] = new(big.Int).Add(ssp.GetBalance(addr), amount)
+		}
+		s = utils.MustGetAs[*suicides](NewSuicides(ssp))
+		s.SetEIP6780(true)
+
+		// SELFDESTRUCT credits the beneficiary with the balance before calling Suicide.
+		s.Snapshot()
+		credit(a1, ssp.GetBalance(a1))
+		Expect(s.Suicide(a1)).To(BeFalse())
+		Expect(s.HasSuicided(a1)).To(BeFalse())
+		Expect(balances[a1]).To(Equal(big.NewInt(10)))
+
+		// another beneficiary leaves the contract without balance.
+		credit(a2, ssp.GetBalance(a1))
+		Expect(s.Suicide(a1)).To(BeFalse())
+		Expect(balances[a1].Sign()).To(BeZero())
+		Expect(balances[a2]).To(Equal(big.NewInt(10)))
+
+		// a credit of a previous call is not a transfer of the balance, which is burnt.
+		credit(a1, big.NewInt(5))
+		s.Snapshot()
+		Expect(s.Suicide(a1)).To(BeFalse())
+		Expect(balances[a1].Sign()).To(BeZero())
+
+		// a contract created in the tx is credited before it suicides to itself, which burns its
+		// balance.
+		s.MarkCreated(a3)
+		credit(a3, big.NewInt(5))
+		s.Snapshot()
+		credit(a3, ssp.GetBalance(a3))
+		Expect(s.Suicide(a3)).To(BeTrue())
+		Expect(balances[a3].Sign()).To(BeZero())
+	})
+
 	It("should clone correctly", func() {
 		s.Snapshot()
 		Expect(s.Suicide(a1)).To(BeTrue())
@@ -21,6 +21,8 @@
 package state
 
 import (
+	"math/big"
+
 	"pkg.berachain.dev/jinx/eth/common"
 	"pkg.berachain.dev/jinx/eth/core/state/journal"
 	coretypes "pkg.berachain.dev/jinx/eth/core/types"
@@ -79,6 +81,25 @@ func newStateDBWithJournals(
 	}
 }
 
+// =============================================================================
+// Accounts
+// =============================================================================
+
+// CreateAccount implements vm.JinxStateDB by creating the account in the state plugin and
+// recording that it was created in the current transaction, as required by EIP-6780.
+func (sdb *stateDB) CreateAccount(addr common.Address) {
+	sdb.Plugin.CreateAccount(addr)
+	sdb.MarkCreated(addr)
+}
+
+// AddBalance implements vm.JinxStateDB by adding to the balance of the account in the state
+// plugin and recording the credit, as the SELFDESTRUCT opcode transfers the balance of the
+// contract by crediting the beneficiary right before calling Suicide.
+func (sdb *stateDB) AddBalance(addr common.Address, amount *big.Int) {
+	sdb.Credited(amount)
+	sdb.Plugin.AddBalance(addr, amount)
+}
+
 // =============================================================================
 // Snapshot
 // =============================================================================
@@ -118,6 +139,9 @@ func (sdb *stateDB) Commit(deleteEmptyObjects bool) (common.Hash, error) {
 // Prepare implements vm.JinxStateDB.
 func (sdb *stateDB) Prepare(rules params.Rules, sender, coinbase common.Address,
 	dest *common.Address, precompiles []common.Address, txAccesses coretypes.AccessList) {
+	// EIP-6780: SELFDESTRUCT only deletes contracts created in the same transaction.
+	sdb.SetEIP6780(rules.IsCancun)
+
 	if rules.IsBerlin {
 		// Clear out any leftover from previous executions
 		sdb.Accesslist = journal.NewAccesslist()
@@ -117,6 +117,60 @@ var _ = Describe("StateDB", func() {
 		Expect(sdb.HasSuicided(bob)).To(BeFalse())
 	})
 
+	It("should only delete contracts created in the same tx after Cancun", func() {
+		cancun := params.Rules{IsBerlin: true, IsShanghai: true, IsCancun: true}
+
+		// bob is a contract created before the tx.
+		sp.CreateAccount(bob)
+		sdb.SetCode(bob, []byte{1, 2, 3})
+
+		sp.AddBalance(bob, big.NewInt(10))
+
+		sdb.Prepare(cancun, alice, common.Address{}, &bob, nil, nil)
+		sdb.Snapshot()
+		sdb.SetTxContext(common.Hash{}, 0)
+		// SELFDESTRUCT credits the beneficiary with the balance before calling Suicide.
+		sdb.AddBalance(alice, sdb.GetBalance(bob))
+		Expect(sdb.Suicide(bob)).To(BeFalse())
+		Expect(sdb.GetBalance(bob).Uint64()).To(Equal(uint64(0)))
+		Expect(sdb.GetBalance(alice).Uint64()).To(Equal(uint64(10)))
+		Expect(sdb.HasSuicided(bob)).To(BeFalse())
+		sdb.Finalise(true)
+		Expect(sdb.GetCode(bob)).To(Equal([]byte{1, 2, 3}))
+
+		// bob keeps its balance if it is its own beneficiary.
+		sp.AddBalance(bob, big.NewInt(10))
+		sdb.Prepare(cancun, alice, common.Address{}, &bob, nil, nil)
+		sdb.Snapshot()
+		sdb.SetTxContext(common.Hash{2}, 2)
+		sdb.AddBalance(bob, sdb.GetBalance(bob))
+		Expect(sdb.Suicide(bob)).To(BeFalse())
+		Expect(sdb.GetBalance(bob).Uint64()).To(Equal(uint64(10)))
+		Expect(sdb.HasSuicided(bob)).To(BeFalse())
+		sdb.Finalise(true)
+		Expect(sdb.GetCode(bob)).To(Equal([]byte{1, 2, 3}))
+		Expect(sdb.GetBalance(bob).Uint64()).To(Equal(uint64(10)))
+
+		// alice is a contract created in the tx, which is credited before it suicides.
+		bobBalance := sdb.GetBalance(bob).Uint64()
+		sdb.Prepare(cancun, bob, common.Address{}, nil, nil, nil)
+		sdb.Snapshot()
+		sdb.SetTxContext(common.Hash{1}, 1)
+		sdb.CreateAccount(alice)
+		sdb.SetCode(alice, []byte{1, 2, 3})
+		sdb.AddBalance(alice, big.NewInt(5))
+		sdb.Snapshot()
+		transferred := sdb.GetBalance(alice).Uint64()
+		sdb.AddBalance(bob, sdb.GetBalance(alice))
+		Expect(sdb.Suicide(alice)).To(BeTrue())
+		Expect(sdb.HasSuicided(alice)).To(BeTrue())
+		Expect(sdb.GetBalance(alice).Sign()).To(BeZero())
+		Expect(sdb.GetBalance(bob).Uint64()).To(Equal(bobBalance + transferred))
+		sdb.Finalise(true)
+		calls := sp.DeleteAccountsCalls()
+		Expect(calls[len(calls)-1].Addresss).To(ConsistOf(alice))
+	})
+
 	It("should handle saved errors", func() {
 		sp.ErrorFunc = func() error {
 			return errors.New("mocked saved error")
@@ -0,0 +1,115 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Blackchain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"math/big"
+
+	"pkg.berachain.dev/jinx/eth/common"
+)
+
+// SyntheticTxRecipient is the recipient of synthetic transactions.
+var SyntheticTxRecipient = common.Address{}
+
+// NewSyntheticTx returns the synthetic transaction that carries the logs of the host chain events
+// from the given source, which were not emitted by an EVM transaction, in the block with the given
+// number. The transaction is unsigned, so it can never be executed, and its hash is deterministic.
+func NewSyntheticTx(number uint64, source []byte) *Transaction {
+	recipient := SyntheticTxRecipient
+	return NewTx(&LegacyTx{
+		Nonce: number,
+		To:    &recipient,
+		Data:  source,
+	})
+}
+
+// NewHostAuthorizedTx returns the transaction of an EVM call, or of a contract creation if to is
+// nil, from the given sender, which authorized it on the host chain instead of signing it. The
+// transaction is not signed, so the sender is encoded in its R value, with zero V and S values, to
+// make its hash unique and deterministic. Its gas price is zero, as the fees are charged by the
+// host chain. The sender is cached in the transaction, so that `Sender` returns it with any signer.
+func NewHostAuthorizedTx(
+	sender common.Address, nonce uint64, to *common.Address, value *big.Int, gas uint64, data []byte,
+) *Transaction {
+	tx := NewTx(&LegacyTx{
+		Nonce:    nonce,
+		GasPrice: new(big.Int),
+		Gas:      gas,
+		To:       to,
+		Value:    value,
+		Data:     data,
+		V:        new(big.Int),
+		R:        new(big.Int).SetBytes(sender.Bytes()),
+		S:        new(big.Int),
+	})
+	CacheHostAuthorizedSender(tx)
+	return tx
+}
+
+// HostAuthorizedSender returns the sender of the given transaction if it is host authorized, i.e.
+// if it is a legacy transaction with zero V and S values and a non-zero R value, which no valid
+// signature has.
+func HostAuthorizedSender(tx *Transaction) (common.Address, bool) {
+	if tx.Type() != LegacyTxType {
+		return common.Address{}, false
+	}
+	v, r, s := tx.RawSignatureValues()
+	if v.Sign() != 0 || s.Sign() != 0 || r.Sign() == 0 || r.BitLen() > 8*common.AddressLength {
+		return common.Address{}, false
+	}
+	return common.BigToAddress(r), true
+}
+
+// CacheHostAuthorizedSender caches the sender of the given transaction, if it is host authorized,
+// so that `Sender` returns it with any signer instead of failing to recover it. It must be called
+// on the host authorized transactions that are decoded, as the cache is not encoded.
+func CacheHostAuthorizedSender(tx *Transaction) {
+	if _, ok := HostAuthorizedSender(tx); ok {
+		_, _ = Sender(hostAuthorizedSigner{}, tx)
+	}
+}
+
+// CacheHostAuthorizedSenders calls `CacheHostAuthorizedSender` on each of the given transactions.
+func CacheHostAuthorizedSenders(txs Transactions) {
+	for _, tx := range txs {
+		CacheHostAuthorizedSender(tx)
+	}
+}
+
+// hostAuthorizedSigner is the signer that recovers the sender of host authorized transactions. It
+// is equal to every signer, as the transaction cache of the sender is only used if its signer is
+// equal to the signer that is used to recover the sender again.
+type hostAuthorizedSigner struct {
+	Signer
+}
+
+// Sender implements Signer.
+func (hostAuthorizedSigner) Sender(tx *Transaction) (common.Address, error) {
+	if sender, ok := HostAuthorizedSender(tx); ok {
+		return sender, nil
+	}
+	return common.Address{}, ErrInvalidSig
+}
+
+// Equal implements Signer.
+func (hostAuthorizedSigner) Equal(Signer) bool {
+	return true
+}
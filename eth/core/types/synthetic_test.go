@@ -0,0 +1,91 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Blackchain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types_test
+
+import (
+	"math/big"
+
+	"pkg.berachain.dev/jinx/eth/common"
+	"pkg.berachain.dev/jinx/eth/core/types"
+	"pkg.berachain.dev/jinx/eth/crypto"
+	"pkg.berachain.dev/jinx/eth/params"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Host authorized transactions", func() {
+	var (
+		sender = common.BytesToAddress([]byte{0x1, 0x2, 0x3})
+		config = params.DefaultChainConfig
+	)
+
+	It("should recover the sender with any signer", func() {
+		tx := types.NewHostAuthorizedTx(sender, 4, nil, big.NewInt(0), 100000, []byte{0x1})
+		from, ok := types.HostAuthorizedSender(tx)
+		Expect(ok).To(BeTrue())
+		Expect(from).To(Equal(sender))
+
+		for _, signer := range []types.Signer{
+			types.LatestSignerForChainID(config.ChainID),
+			types.MakeSigner(config, big.NewInt(1), 0),
+			types.NewEIP2930Signer(config.ChainID),
+		} {
+			from, err := types.Sender(signer, tx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(from).To(Equal(sender))
+		}
+	})
+
+	It("should recover the sender of a decoded transaction", func() {
+		tx := types.NewHostAuthorizedTx(sender, 4, nil, big.NewInt(0), 100000, []byte{0x1})
+		bz, err := (&types.TxLookupEntry{Tx: tx, BlockNum: 1}).MarshalBinary()
+		Expect(err).ToNot(HaveOccurred())
+		tle := &types.TxLookupEntry{}
+		Expect(tle.UnmarshalBinary(bz)).To(Succeed())
+		Expect(tle.Tx.Hash()).To(Equal(tx.Hash()))
+		from, err := types.Sender(types.LatestSignerForChainID(config.ChainID), tle.Tx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(from).To(Equal(sender))
+
+		// the contract address of the receipt is derived from the sender.
+		receipts := types.Receipts{{TxHash: tx.Hash(), ContractAddress: crypto.CreateAddress(sender, 4)}}
+		Expect(receipts.DeriveFields(
+			config, common.Hash{0x1}, 1, 0, big.NewInt(1), types.Transactions{tle.Tx},
+		)).To(Succeed())
+		Expect(receipts[0].ContractAddress).To(Equal(crypto.CreateAddress(sender, 4)))
+	})
+
+	It("should not recover a sender from other transactions", func() {
+		_, ok := types.HostAuthorizedSender(types.NewSyntheticTx(1, []byte("begin_block")))
+		Expect(ok).To(BeFalse())
+
+		key, _ := crypto.GenerateEthKey()
+		signer := types.LatestSignerForChainID(config.ChainID)
+		tx := types.MustSignNewTx(key, signer, &types.LegacyTx{Gas: 21000, GasPrice: big.NewInt(1)})
+		_, ok = types.HostAuthorizedSender(tx)
+		Expect(ok).To(BeFalse())
+		types.CacheHostAuthorizedSender(tx)
+		from, err := types.Sender(signer, tx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(from).To(Equal(crypto.PubkeyToAddress(key.PublicKey)))
+	})
+})
@@ -21,12 +21,26 @@
 package types
 
 import (
+	"errors"
+
 	"github.com/ethereum/go-ethereum/rlp"
 
 	"pkg.berachain.dev/jinx/eth/common"
 	"pkg.berachain.dev/jinx/eth/crypto"
 )
 
+// BlobTxType is the type of EIP-4844 blob transactions, which Jinx does not support, as the host
+// chain provides no data availability for blobs.
+const BlobTxType = 0x03
+
+// ErrBlobTxNotSupported is returned when a blob transaction is submitted.
+var ErrBlobTxNotSupported = errors.New("blob transactions are not supported")
+
+// IsBlobTx returns whether the given transaction is an EIP-4844 blob transaction.
+func IsBlobTx(tx *Transaction) bool {
+	return tx.Type() == BlobTxType
+}
+
 // TxLookupEntry is a positional metadata to help looking up a transaction by hash.
 //
 //go:generate rlpgen -type TxLookupEntry -out transaction.rlpgen.go -decoder
@@ -37,9 +51,14 @@ type TxLookupEntry struct {
 	BlockHash common.Hash
 }
 
-// UnmarshalBinary decodes a tx lookup entry from the Ethereum RLP format.
+// UnmarshalBinary decodes a tx lookup entry from the Ethereum RLP format. The sender of a host
+// authorized transaction is cached, so that it can be recovered.
 func (tle *TxLookupEntry) UnmarshalBinary(data []byte) error {
-	return rlp.DecodeBytes(data, tle)
+	if err := rlp.DecodeBytes(data, tle); err != nil {
+		return err
+	}
+	CacheHostAuthorizedSender(tle.Tx)
+	return nil
 }
 
 // MarshalBinary encodes the tx lookup enßtry into the Ethereum RLP format.
@@ -69,8 +88,9 @@ func PubkeyFromTx(signedTx *Transaction, signer Signer) ([]byte, error) {
 	return crypto.CompressPubkey(pubKey), nil
 }
 
-// GetSender returns the sender of the transaction.
+// GetSender returns the sender of the transaction. The sender is cached in the transaction, so it
+// is only recovered once.
 func GetSender(tx *Transaction) common.Address {
-	sender, _ := LatestSignerForChainID(tx.ChainId()).Sender(tx)
+	sender, _ := Sender(LatestSignerForChainID(tx.ChainId()), tx)
 	return sender
 }
@@ -25,7 +25,11 @@ import (
 )
 
 type (
-	EthBackend = ethapi.Backend
+	EthBackend      = ethapi.Backend
+	TransactionArgs = ethapi.TransactionArgs
+	StateOverride   = ethapi.StateOverride
+	OverrideAccount = ethapi.OverrideAccount
+	BlockOverrides  = ethapi.BlockOverrides
 )
 
 var (
@@ -35,4 +39,6 @@ var (
 	NewTransactionAPI = ethapi.NewTransactionAPI
 	NewTxPoolAPI      = ethapi.NewTxPoolAPI
 	NewDebugAPI       = ethapi.NewDebugAPI
+
+	NewRPCPendingTransaction = ethapi.NewRPCPendingTransaction
 )
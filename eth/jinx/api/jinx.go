@@ -0,0 +1,118 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Blackchain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package jinxapi
+
+import (
+	"pkg.berachain.dev/jinx/eth/common"
+	"pkg.berachain.dev/jinx/eth/common/hexutil"
+	"pkg.berachain.dev/jinx/eth/core/precompile"
+)
+
+// JinxBackend is the collection of methods required to satisfy the jinx
+// RPC API. They are served by the host chain.
+type JinxBackend interface {
+	JinxAPI
+}
+
+// JinxAPI is the collection of jinx RPC API methods, which answer queries about the host chain.
+type JinxAPI interface {
+	// Bech32Address returns the bech32 form of the given address on the host chain.
+	Bech32Address(addr common.Address) string
+	// HexAddress returns the hex form of the given bech32 (or hex) address.
+	HexAddress(addr string) (common.Address, error)
+	// GetCosmosTransaction returns the host chain transaction that included the Ethereum
+	// transaction with the given hash, or nil if it is not known.
+	GetCosmosTransaction(hash common.Hash) (*CosmosTransaction, error)
+	// GetDenomForToken returns the denomination of the coin that is mapped to the given ERC20
+	// token, or an empty string if there is none.
+	GetDenomForToken(token common.Address) (string, error)
+	// GetTokenForDenom returns the ERC20 token that is mapped to the coin with the given
+	// denomination, or the zero address if there is none.
+	GetTokenForDenom(denom string) (common.Address, error)
+	// IsPrecompile returns whether the given address is a precompile registered by the host
+	// chain.
+	IsPrecompile(addr common.Address) bool
+	// GetPrecompiles returns the descriptions of the stateful precompiles registered by the host
+	// chain, with the ABIs they serve.
+	GetPrecompiles() ([]*precompile.Info, error)
+	// GetPrecompile returns the description of the stateful precompile at the given address, or
+	// nil if there is none.
+	GetPrecompile(addr common.Address) (*precompile.Info, error)
+}
+
+// CosmosTransaction identifies the host chain transaction of an Ethereum transaction.
+type CosmosTransaction struct {
+	// Hash is the hash of the host chain transaction, in the upper case hex form of CometBFT.
+	Hash string `json:"hash"`
+	// Height is the height of the host chain block that included the transaction.
+	Height hexutil.Uint64 `json:"height"`
+}
+
+// jinxAPI offers host chain related RPC methods.
+type jinxAPI struct {
+	b JinxBackend
+}
+
+// NewJinxAPI creates a new jinx API instance.
+func NewJinxAPI(b JinxBackend) JinxAPI {
+	return &jinxAPI{b}
+}
+
+// Bech32Address returns the bech32 form of the given address on the host chain.
+func (api *jinxAPI) Bech32Address(addr common.Address) string {
+	return api.b.Bech32Address(addr)
+}
+
+// HexAddress returns the hex form of the given bech32 (or hex) address.
+func (api *jinxAPI) HexAddress(addr string) (common.Address, error) {
+	return api.b.HexAddress(addr)
+}
+
+// GetCosmosTransaction returns the host chain transaction that included the Ethereum transaction
+// with the given hash.
+func (api *jinxAPI) GetCosmosTransaction(hash common.Hash) (*CosmosTransaction, error) {
+	return api.b.GetCosmosTransaction(hash)
+}
+
+// GetDenomForToken returns the denomination of the coin that is mapped to the given ERC20 token.
+func (api *jinxAPI) GetDenomForToken(token common.Address) (string, error) {
+	return api.b.GetDenomForToken(token)
+}
+
+// GetTokenForDenom returns the ERC20 token that is mapped to the coin with the given denomination.
+func (api *jinxAPI) GetTokenForDenom(denom string) (common.Address, error) {
+	return api.b.GetTokenForDenom(denom)
+}
+
+// IsPrecompile returns whether the given address is a precompile registered by the host chain.
+func (api *jinxAPI) IsPrecompile(addr common.Address) bool {
+	return api.b.IsPrecompile(addr)
+}
+
+// GetPrecompiles returns the descriptions of the stateful precompiles registered by the host chain.
+func (api *jinxAPI) GetPrecompiles() ([]*precompile.Info, error) {
+	return api.b.GetPrecompiles()
+}
+
+// GetPrecompile returns the description of the stateful precompile at the given address.
+func (api *jinxAPI) GetPrecompile(addr common.Address) (*precompile.Info, error) {
+	return api.b.GetPrecompile(addr)
+}
@@ -0,0 +1,438 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Blackchain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package jinxapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/consensus/misc"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"pkg.berachain.dev/jinx/eth/common"
+	"pkg.berachain.dev/jinx/eth/common/hexutil"
+	"pkg.berachain.dev/jinx/eth/core"
+	"pkg.berachain.dev/jinx/eth/core/types"
+	"pkg.berachain.dev/jinx/eth/core/vm"
+	"pkg.berachain.dev/jinx/eth/params"
+)
+
+const (
+	// maxSimulateBlocks is the maximum number of blocks in a simulation.
+	maxSimulateBlocks = 256
+	// maxSimulateCalls is the maximum number of calls across all blocks of a simulation.
+	maxSimulateCalls = 256
+	// simulateTimestampIncrement is the default time between two simulated blocks.
+	simulateTimestampIncrement = 12
+
+	// errCodeReverted is the error code of a call that reverted.
+	errCodeReverted = 3
+	// errCodeVMError is the error code of a call that failed in the EVM.
+	errCodeVMError = -32015
+)
+
+var (
+	// ErrTooManyBlocks is returned when a simulation has more blocks than can be simulated.
+	ErrTooManyBlocks = errors.New("too many blocks in simulation")
+	// ErrTooManyCalls is returned when a simulation has more calls than can be simulated.
+	ErrTooManyCalls = errors.New("too many calls in simulation")
+	// ErrBlockNotIncreasing is returned when the number or the time of a simulated block does not
+	// increase over the one of its parent.
+	ErrBlockNotIncreasing = errors.New("block number or timestamp not increasing")
+	// ErrSimulateGasCapReached is returned when the calls of a simulation use up the RPC gas cap.
+	ErrSimulateGasCapReached = errors.New("simulation gas cap reached")
+	// ErrTraceTransfersUnsupported is returned when the transfers of a simulation are to be traced.
+	ErrTraceTransfersUnsupported = errors.New("tracing transfers is not supported")
+	// ErrConflictingStateOverride is returned when an account override sets both the full
+	// storage and a storage diff of the account.
+	ErrConflictingStateOverride = errors.New("account override has both state and stateDiff")
+)
+
+// SimulateBackend is the collection of methods required to satisfy the simulate
+// RPC API.
+type SimulateBackend interface {
+	ChainConfig() *params.ChainConfig
+	StateAndHeaderByNumberOrHash(
+		context.Context, rpc.BlockNumberOrHash,
+	) (vm.GethStateDB, *types.Header, error)
+	GetBlockContext(context.Context, *types.Header) *vm.BlockContext
+	GetEVM(context.Context, *core.Message, vm.GethStateDB, *types.Header, *vm.Config,
+		*vm.BlockContext) (*vm.GethEVM, func() error)
+	RPCGasCap() uint64
+	RPCEVMTimeout() time.Duration
+}
+
+// SimulateAPI is the collection of simulate RPC API methods.
+type SimulateAPI interface {
+	SimulateV1(
+		ctx context.Context, opts SimulateOpts, blockNrOrHash *rpc.BlockNumberOrHash,
+	) ([]*SimulateBlockResult, error)
+}
+
+// SimulateOpts are the options of a simulation.
+type SimulateOpts struct {
+	BlockStateCalls []SimulateBlock `json:"blockStateCalls"`
+	TraceTransfers  bool            `json:"traceTransfers"`
+	Validation      bool            `json:"validation"`
+}
+
+// SimulateBlock is a simulated block, with the state and block overrides to apply before
+// executing its calls.
+type SimulateBlock struct {
+	BlockOverrides *BlockOverrides   `json:"blockOverrides"`
+	StateOverrides *StateOverride    `json:"stateOverrides"`
+	Calls          []TransactionArgs `json:"calls"`
+}
+
+// SimulateBlockResult is the result of a simulated block.
+type SimulateBlockResult struct {
+	Number        hexutil.Uint64        `json:"number"`
+	Hash          common.Hash           `json:"hash"`
+	ParentHash    common.Hash           `json:"parentHash"`
+	Timestamp     hexutil.Uint64        `json:"timestamp"`
+	GasLimit      hexutil.Uint64        `json:"gasLimit"`
+	GasUsed       hexutil.Uint64        `json:"gasUsed"`
+	FeeRecipient  common.Address        `json:"miner"`
+	BaseFeePerGas *hexutil.Big          `json:"baseFeePerGas"`
+	PrevRandao    common.Hash           `json:"prevRandao"`
+	Calls         []*SimulateCallResult `json:"calls"`
+}
+
+// SimulateCallResult is the result of a call of a simulated block.
+type SimulateCallResult struct {
+	ReturnData hexutil.Bytes      `json:"returnData"`
+	Logs       []*types.Log       `json:"logs"`
+	GasUsed    hexutil.Uint64     `json:"gasUsed"`
+	Status     hexutil.Uint64     `json:"status"`
+	Error      *SimulateCallError `json:"error,omitempty"`
+}
+
+// SimulateCallError is the error of a call of a simulated block that failed.
+type SimulateCallError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Data    string `json:"data,omitempty"`
+}
+
+// simulationStateDB is the statedb the calls of a simulation are executed on.
+type simulationStateDB interface {
+	vm.GethStateDB
+	SetBalance(common.Address, *big.Int)
+	SetStorage(common.Address, map[common.Hash]common.Hash)
+	SetTxContext(common.Hash, int)
+	GetLogs(common.Hash, uint64, common.Hash) []*types.Log
+	Finalise(bool)
+}
+
+// simulateAPI offers simulation related RPC methods.
+type simulateAPI struct {
+	b SimulateBackend
+}
+
+// NewSimulateAPI creates a new simulate API instance.
+func NewSimulateAPI(b SimulateBackend) SimulateAPI {
+	return &simulateAPI{b}
+}
+
+// SimulateV1 executes the given blocks of calls in order on top of the state of the given block,
+// which defaults to the latest one. Every block applies its state and block overrides before its
+// calls, and every call sees the state changes of the calls before it. Unless overridden, a
+// simulated block follows its parent by one number and 12 seconds. It returns the result of every
+// block and call, including the logs emitted by precompiles. A call that reverts does not abort
+// the simulation, but a call that cannot be executed at all does. The calls of all blocks share
+// the RPC gas cap, and the state changes are never committed. Without validation, the base fee
+// is zero and the nonces and balances of the senders are not checked.
+func (api *simulateAPI) SimulateV1(
+	ctx context.Context, opts SimulateOpts, blockNrOrHash *rpc.BlockNumberOrHash,
+) ([]*SimulateBlockResult, error) {
+	if opts.TraceTransfers {
+		return nil, ErrTraceTransfersUnsupported
+	}
+	if len(opts.BlockStateCalls) > maxSimulateBlocks {
+		return nil, fmt.Errorf("%w: %d > %d",
+			ErrTooManyBlocks, len(opts.BlockStateCalls), maxSimulateBlocks)
+	}
+	var numCalls int
+	for _, block := range opts.BlockStateCalls {
+		numCalls += len(block.Calls)
+	}
+	if numCalls > maxSimulateCalls {
+		return nil, fmt.Errorf("%w: %d > %d", ErrTooManyCalls, numCalls, maxSimulateCalls)
+	}
+
+	if blockNrOrHash == nil {
+		latest := rpc.BlockNumberOrHashWithNumber(rpc.LatestBlockNumber)
+		blockNrOrHash = &latest
+	}
+	state, parent, err := api.b.StateAndHeaderByNumberOrHash(ctx, *blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	sdb, ok := state.(simulationStateDB)
+	if !ok {
+		return nil, fmt.Errorf("cannot simulate on statedb of type %T", state)
+	}
+
+	// Bound the execution of the whole simulation by the EVM timeout.
+	var cancel context.CancelFunc
+	timeout := api.b.RPCEVMTimeout()
+	if timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+	} else {
+		ctx, cancel = context.WithCancel(ctx)
+	}
+	defer cancel()
+
+	sim := &simulation{
+		api:        api,
+		sdb:        sdb,
+		validation: opts.Validation,
+		gasCap:     api.b.RPCGasCap(),
+	}
+	if sim.gasCap == 0 {
+		sim.gasCap = math.MaxUint64
+	}
+	results := make([]*SimulateBlockResult, len(opts.BlockStateCalls))
+	for i := range opts.BlockStateCalls {
+		var header *types.Header
+		if header, err = sim.makeHeader(parent, opts.BlockStateCalls[i].BlockOverrides); err != nil {
+			return nil, fmt.Errorf("block %d: %w", i, err)
+		}
+		if results[i], err = sim.processBlock(ctx, header, &opts.BlockStateCalls[i]); err != nil {
+			return nil, fmt.Errorf("block %d: %w", i, err)
+		}
+		if ctx.Err() != nil {
+			return nil, fmt.Errorf("execution aborted (timeout = %v)", timeout)
+		}
+		parent = header
+	}
+	return results, nil
+}
+
+// simulation is the state of a running simulation.
+type simulation struct {
+	api        *simulateAPI
+	sdb        simulationStateDB
+	validation bool
+	// gasCap is the gas left to the calls of the simulation.
+	gasCap uint64
+	// numCalls is the number of calls executed by the simulation.
+	numCalls int
+}
+
+// makeHeader returns the header of the simulated block on top of the given parent, with the given
+// block overrides applied.
+func (sim *simulation) makeHeader(
+	parent *types.Header, overrides *BlockOverrides,
+) (*types.Header, error) {
+	header := &types.Header{
+		ParentHash: parent.Hash(),
+		Coinbase:   parent.Coinbase,
+		Difficulty: new(big.Int),
+		Number:     new(big.Int).Add(parent.Number, big.NewInt(1)),
+		GasLimit:   parent.GasLimit,
+		Time:       parent.Time + simulateTimestampIncrement,
+		MixDigest:  parent.MixDigest,
+	}
+	if overrides != nil {
+		if overrides.Number != nil {
+			header.Number = overrides.Number.ToInt()
+		}
+		if overrides.Difficulty != nil {
+			header.Difficulty = overrides.Difficulty.ToInt()
+		}
+		if overrides.Time != nil {
+			header.Time = uint64(*overrides.Time)
+		}
+		if overrides.GasLimit != nil {
+			header.GasLimit = uint64(*overrides.GasLimit)
+		}
+		if overrides.Coinbase != nil {
+			header.Coinbase = *overrides.Coinbase
+		}
+		if overrides.Random != nil {
+			header.MixDigest = *overrides.Random
+		}
+		if overrides.BaseFee != nil {
+			header.BaseFee = overrides.BaseFee.ToInt()
+		}
+	}
+	if header.Number.Cmp(parent.Number) <= 0 || header.Time <= parent.Time {
+		return nil, fmt.Errorf("%w: block %d at %d after block %d at %d", ErrBlockNotIncreasing,
+			header.Number, header.Time, parent.Number, parent.Time)
+	}
+
+	// Without validation the calls pay no base fee, as with eth_call.
+	if header.BaseFee == nil {
+		header.BaseFee = new(big.Int)
+		if sim.validation {
+			header.BaseFee = misc.CalcBaseFee(sim.api.b.ChainConfig(), parent)
+		}
+	}
+	return header, nil
+}
+
+// processBlock applies the state overrides of the given simulated block and executes its calls
+// in the block with the given header.
+func (sim *simulation) processBlock(
+	ctx context.Context, header *types.Header, block *SimulateBlock,
+) (*SimulateBlockResult, error) {
+	if err := applyStateOverride(sim.sdb, block.StateOverrides); err != nil {
+		return nil, err
+	}
+
+	// The block context is built from the simulated header, so that the EVM runs under the chain
+	// rules of its number and time.
+	blockCtx := sim.api.b.GetBlockContext(ctx, header)
+	gp := new(core.GasPool).AddGas(header.GasLimit)
+	calls := make([]*SimulateCallResult, len(block.Calls))
+	for i := range block.Calls {
+		var err error
+		if calls[i], err = sim.processCall(ctx, header, blockCtx, gp, &block.Calls[i], i); err != nil {
+			return nil, fmt.Errorf("call %d: %w", i, err)
+		}
+		header.GasUsed += uint64(calls[i].GasUsed)
+	}
+
+	// The hash of the block is only known once all its calls are executed.
+	hash := header.Hash()
+	for _, call := range calls {
+		for _, log := range call.Logs {
+			log.BlockHash = hash
+		}
+	}
+	return &SimulateBlockResult{
+		Number:        hexutil.Uint64(header.Number.Uint64()),
+		Hash:          hash,
+		ParentHash:    header.ParentHash,
+		Timestamp:     hexutil.Uint64(header.Time),
+		GasLimit:      hexutil.Uint64(header.GasLimit),
+		GasUsed:       hexutil.Uint64(header.GasUsed),
+		FeeRecipient:  header.Coinbase,
+		BaseFeePerGas: (*hexutil.Big)(header.BaseFee),
+		PrevRandao:    header.MixDigest,
+		Calls:         calls,
+	}, nil
+}
+
+// processCall executes the given call of a simulated block on the statedb of the simulation.
+func (sim *simulation) processCall(
+	ctx context.Context, header *types.Header, blockCtx *vm.BlockContext, gp *core.GasPool,
+	args *TransactionArgs, index int,
+) (*SimulateCallResult, error) {
+	// A call gets at most the gas left to both its block and the simulation.
+	gasCap := sim.gasCap
+	if gp.Gas() < gasCap {
+		gasCap = gp.Gas()
+	}
+	if gasCap == 0 {
+		return nil, ErrSimulateGasCapReached
+	}
+	msg, err := args.ToMessage(gasCap, blockCtx.BaseFee)
+	if err != nil {
+		return nil, err
+	}
+	msg.SkipAccountChecks = !sim.validation
+	evm, vmError := sim.api.b.GetEVM(
+		ctx, msg, sim.sdb, header, &vm.Config{NoBaseFee: !sim.validation}, blockCtx,
+	)
+
+	// Stop the EVM once the simulation times out or the request is cancelled.
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			evm.Cancel()
+		case <-done:
+		}
+	}()
+
+	// The call has no transaction hash, so its logs are keyed by its position in the simulation.
+	sim.numCalls++
+	callHash := common.BigToHash(big.NewInt(int64(sim.numCalls)))
+	sim.sdb.SetTxContext(callHash, index)
+	result, err := core.ApplyMessage(evm, msg, gp)
+	if err != nil {
+		return nil, err
+	}
+	if err = vmError(); err != nil {
+		return nil, err
+	}
+	sim.gasCap -= result.UsedGas
+
+	res := &SimulateCallResult{
+		ReturnData: result.Return(),
+		Logs:       sim.sdb.GetLogs(callHash, header.Number.Uint64(), common.Hash{}),
+		GasUsed:    hexutil.Uint64(result.UsedGas),
+		Status:     hexutil.Uint64(types.ReceiptStatusSuccessful),
+	}
+	if result.Failed() {
+		res.Status = hexutil.Uint64(types.ReceiptStatusFailed)
+		res.Error = &SimulateCallError{Code: errCodeVMError, Message: result.Err.Error()}
+		if errors.Is(result.Err, vm.ErrExecutionReverted) {
+			res.ReturnData = result.Revert()
+			res.Error.Code = errCodeReverted
+			res.Error.Data = hexutil.Encode(result.Revert())
+		}
+	}
+	if res.Logs == nil {
+		res.Logs = []*types.Log{}
+	}
+
+	// Finalize the call so that the next one starts with clean journals.
+	sim.sdb.Finalise(true)
+	return res, nil
+}
+
+// applyStateOverride applies the given account overrides to the statedb.
+func applyStateOverride(sdb simulationStateDB, override *StateOverride) error {
+	if override == nil {
+		return nil
+	}
+	for addr, account := range *override {
+		if account.State != nil && account.StateDiff != nil {
+			return fmt.Errorf("%w: %s", ErrConflictingStateOverride, addr.Hex())
+		}
+		if account.Nonce != nil {
+			sdb.SetNonce(addr, uint64(*account.Nonce))
+		}
+		if account.Code != nil {
+			sdb.SetCode(addr, *account.Code)
+		}
+		if account.Balance != nil {
+			sdb.SetBalance(addr, (*big.Int)(*account.Balance))
+		}
+		if account.State != nil {
+			sdb.SetStorage(addr, *account.State)
+		}
+		if account.StateDiff != nil {
+			for key, value := range *account.StateDiff {
+				sdb.SetState(addr, key, value)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,240 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Blackchain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package jinxapi_test
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	gethstate "github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"pkg.berachain.dev/jinx/eth/common"
+	"pkg.berachain.dev/jinx/eth/common/hexutil"
+	"pkg.berachain.dev/jinx/eth/core"
+	"pkg.berachain.dev/jinx/eth/core/mock"
+	"pkg.berachain.dev/jinx/eth/core/types"
+	"pkg.berachain.dev/jinx/eth/core/vm"
+	jinxapi "pkg.berachain.dev/jinx/eth/jinx/api"
+	"pkg.berachain.dev/jinx/eth/params"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// simulateBackend is a simulate backend on top of an in-memory Go-Ethereum statedb.
+type simulateBackend struct {
+	chainConfig *params.ChainConfig
+	state       *gethstate.StateDB
+	header      *types.Header
+	pp          *mock.PrecompilePluginMock
+	gasCap      uint64
+}
+
+func (b *simulateBackend) ChainConfig() *params.ChainConfig {
+	return b.chainConfig
+}
+
+func (b *simulateBackend) StateAndHeaderByNumberOrHash(
+	context.Context, rpc.BlockNumberOrHash,
+) (vm.GethStateDB, *types.Header, error) {
+	return b.state, b.header, nil
+}
+
+func (b *simulateBackend) GetBlockContext(_ context.Context, header *types.Header) *vm.BlockContext {
+	blockCtx := core.NewEVMBlockContext(header, nil, &header.Coinbase)
+	return &blockCtx
+}
+
+func (b *simulateBackend) GetEVM(
+	_ context.Context, msg *core.Message, state vm.GethStateDB, _ *types.Header,
+	vmConfig *vm.Config, blockCtx *vm.BlockContext,
+) (*vm.GethEVM, func() error) {
+	return vm.NewGethEVMWithPrecompiles(
+		*blockCtx, core.NewEVMTxContext(msg), state, b.chainConfig, *vmConfig, b.pp,
+	), func() error { return nil }
+}
+
+func (b *simulateBackend) RPCGasCap() uint64 {
+	return b.gasCap
+}
+
+func (b *simulateBackend) RPCEVMTimeout() time.Duration {
+	return 0
+}
+
+var _ = Describe("SimulateV1", func() {
+	var (
+		// numberAndTime returns the number and the time of the block it is called in.
+		numberAndTime = common.HexToAddress("0x1")
+		// push0 returns empty data with the PUSH0 opcode, which is only valid after Shanghai.
+		push0 = common.HexToAddress("0x2")
+		// logger emits an empty log.
+		logger = common.HexToAddress("0x3")
+		eoa    = common.HexToAddress("0x4")
+
+		b   *simulateBackend
+		api jinxapi.SimulateAPI
+	)
+
+	BeforeEach(func() {
+		shanghaiTime := uint64(200)
+		chainConfig := *params.DefaultChainConfig
+		chainConfig.ShanghaiTime = &shanghaiTime
+		chainConfig.CancunTime = &shanghaiTime
+
+		state, err := gethstate.New(
+			common.Hash{}, gethstate.NewDatabase(rawdb.NewMemoryDatabase()), nil,
+		)
+		Expect(err).ToNot(HaveOccurred())
+		state.SetCode(numberAndTime, common.FromHex("0x436000524260205260406000f3"))
+		state.SetCode(push0, common.FromHex("0x5f5ff3"))
+		state.SetCode(logger, common.FromHex("0x60006000a000"))
+
+		pp := mock.NewPrecompilePluginMock()
+		pp.HasFunc = func(common.Address) bool {
+			return false
+		}
+		b = &simulateBackend{
+			chainConfig: &chainConfig,
+			state:       state,
+			header: &types.Header{
+				Number:     big.NewInt(1),
+				Time:       100,
+				GasLimit:   30_000_000,
+				BaseFee:    big.NewInt(1),
+				Difficulty: new(big.Int),
+			},
+			pp:     pp,
+			gasCap: 50_000_000,
+		}
+		api = jinxapi.NewSimulateAPI(b)
+	})
+
+	simulate := func(opts jinxapi.SimulateOpts) ([]*jinxapi.SimulateBlockResult, error) {
+		return api.SimulateV1(context.Background(), opts, nil)
+	}
+
+	It("should execute the calls in blocks following the base block", func() {
+		number, timestamp := hexutil.Big(*big.NewInt(10)), hexutil.Uint64(500)
+		results, err := simulate(jinxapi.SimulateOpts{
+			BlockStateCalls: []jinxapi.SimulateBlock{
+				{Calls: []jinxapi.TransactionArgs{{To: &numberAndTime}}},
+				{
+					BlockOverrides: &jinxapi.BlockOverrides{Number: &number, Time: &timestamp},
+					Calls:          []jinxapi.TransactionArgs{{To: &numberAndTime}},
+				},
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(results).To(HaveLen(2))
+
+		Expect(results[0].Number).To(Equal(hexutil.Uint64(2)))
+		Expect(results[0].Timestamp).To(Equal(hexutil.Uint64(112)))
+		Expect(results[0].ParentHash).To(Equal(b.header.Hash()))
+		ret := results[0].Calls[0].ReturnData
+		Expect(new(big.Int).SetBytes(ret[:32]).Uint64()).To(Equal(uint64(2)))
+		Expect(new(big.Int).SetBytes(ret[32:]).Uint64()).To(Equal(uint64(112)))
+
+		Expect(results[1].Number).To(Equal(hexutil.Uint64(10)))
+		Expect(results[1].Timestamp).To(Equal(hexutil.Uint64(500)))
+		Expect(results[1].ParentHash).To(Equal(results[0].Hash))
+		ret = results[1].Calls[0].ReturnData
+		Expect(new(big.Int).SetBytes(ret[:32]).Uint64()).To(Equal(uint64(10)))
+		Expect(new(big.Int).SetBytes(ret[32:]).Uint64()).To(Equal(uint64(500)))
+	})
+
+	It("should run the calls under the chain rules of the overridden block", func() {
+		timestamp := hexutil.Uint64(300)
+		results, err := simulate(jinxapi.SimulateOpts{
+			BlockStateCalls: []jinxapi.SimulateBlock{
+				{Calls: []jinxapi.TransactionArgs{{To: &push0}}},
+				{
+					BlockOverrides: &jinxapi.BlockOverrides{Time: &timestamp},
+					Calls:          []jinxapi.TransactionArgs{{To: &push0}},
+				},
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		// PUSH0 is an invalid opcode before Shanghai.
+		Expect(results[0].Calls[0].Status).To(Equal(hexutil.Uint64(types.ReceiptStatusFailed)))
+		Expect(results[0].Calls[0].Error).ToNot(BeNil())
+		Expect(results[0].Calls[0].Error.Code).To(Equal(-32015))
+		Expect(results[1].Calls[0].Status).To(Equal(hexutil.Uint64(types.ReceiptStatusSuccessful)))
+		Expect(results[1].Calls[0].Error).To(BeNil())
+	})
+
+	It("should apply the state overrides of blocks and keep the state across blocks", func() {
+		code := hexutil.Bytes(common.FromHex("0x60006000a000"))
+		results, err := simulate(jinxapi.SimulateOpts{
+			BlockStateCalls: []jinxapi.SimulateBlock{
+				{
+					StateOverrides: &jinxapi.StateOverride{eoa: jinxapi.OverrideAccount{Code: &code}},
+					Calls:          []jinxapi.TransactionArgs{{To: &logger}, {To: &eoa}},
+				},
+				{Calls: []jinxapi.TransactionArgs{{To: &eoa}}},
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		for _, call := range append(results[0].Calls, results[1].Calls...) {
+			Expect(call.Logs).To(HaveLen(1))
+		}
+		Expect(results[0].Calls[1].Logs[0].TxIndex).To(Equal(uint(1)))
+		Expect(results[0].Calls[1].Logs[0].BlockHash).To(Equal(results[0].Hash))
+		Expect(results[1].Calls[0].Logs[0].BlockNumber).To(Equal(uint64(3)))
+		Expect(results[1].Calls[0].Logs[0].BlockHash).To(Equal(results[1].Hash))
+		Expect(results[0].GasUsed).To(Equal(results[0].Calls[0].GasUsed + results[0].Calls[1].GasUsed))
+	})
+
+	It("should cap the gas of all calls by the RPC gas cap", func() {
+		b.gasCap = 2 * 21000
+		_, err := simulate(jinxapi.SimulateOpts{
+			BlockStateCalls: []jinxapi.SimulateBlock{
+				{Calls: []jinxapi.TransactionArgs{{To: &eoa}, {To: &eoa}}},
+				{Calls: []jinxapi.TransactionArgs{{To: &eoa}}},
+			},
+		})
+		Expect(err).To(MatchError(jinxapi.ErrSimulateGasCapReached))
+	})
+
+	It("should reject invalid simulations", func() {
+		calls := make([]jinxapi.TransactionArgs, 257)
+		_, err := simulate(jinxapi.SimulateOpts{
+			BlockStateCalls: []jinxapi.SimulateBlock{{Calls: calls}},
+		})
+		Expect(err).To(MatchError(jinxapi.ErrTooManyCalls))
+
+		number := hexutil.Big(*big.NewInt(1))
+		_, err = simulate(jinxapi.SimulateOpts{
+			BlockStateCalls: []jinxapi.SimulateBlock{
+				{BlockOverrides: &jinxapi.BlockOverrides{Number: &number}},
+			},
+		})
+		Expect(err).To(MatchError(jinxapi.ErrBlockNotIncreasing))
+
+		_, err = simulate(jinxapi.SimulateOpts{TraceTransfers: true})
+		Expect(err).To(MatchError(jinxapi.ErrTraceTransfersUnsupported))
+	})
+})
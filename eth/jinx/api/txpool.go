@@ -0,0 +1,139 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Blackchain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package jinxapi
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"pkg.berachain.dev/jinx/eth/common"
+	"pkg.berachain.dev/jinx/eth/core"
+	"pkg.berachain.dev/jinx/eth/core/types"
+	"pkg.berachain.dev/jinx/eth/params"
+)
+
+// newTxsChanSize is the size of the channel listening to new txs events, which matches the size
+// used by the filter system.
+const newTxsChanSize = 4096
+
+// TxPoolSubscriptionBackend is the collection of methods required to satisfy the txpool
+// subscription RPC API.
+type TxPoolSubscriptionBackend interface {
+	ChainConfig() *params.ChainConfig
+	CurrentHeader() *types.Header
+	SubscribeNewTxsEvent(chan<- core.NewTxsEvent) event.Subscription
+}
+
+// TxPoolSubscriptionAPI is the collection of txpool subscription RPC API methods.
+type TxPoolSubscriptionAPI interface {
+	PendingTransactions(
+		ctx context.Context, filter *PendingTransactionsFilter, fullTx *bool,
+	) (*rpc.Subscription, error)
+}
+
+// PendingTransactionsFilter selects the pending transactions that are sent to a subscriber. A
+// transaction matches if its sender is in From and its recipient is in To, where an empty list
+// matches any address. Contract creations only match an empty To.
+type PendingTransactionsFilter struct {
+	From []common.Address `json:"from"`
+	To   []common.Address `json:"to"`
+}
+
+// txPoolSubscriptionAPI offers txpool subscription RPC methods.
+type txPoolSubscriptionAPI struct {
+	b TxPoolSubscriptionBackend
+}
+
+// NewTxPoolSubscriptionAPI creates a new txpool subscription API instance.
+func NewTxPoolSubscriptionAPI(b TxPoolSubscriptionBackend) TxPoolSubscriptionAPI {
+	return &txPoolSubscriptionAPI{b}
+}
+
+// PendingTransactions creates a subscription that is triggered each time a transaction that
+// matches the filter enters the pending set of the transaction pool, either when it is inserted
+// or when it is promoted from the queued set. If fullTx is true the full transaction is sent,
+// otherwise only its hash.
+func (api *txPoolSubscriptionAPI) PendingTransactions(
+	ctx context.Context, filter *PendingTransactionsFilter, fullTx *bool,
+) (*rpc.Subscription, error) {
+	notifier, supported := rpc.NotifierFromContext(ctx)
+	if !supported {
+		return &rpc.Subscription{}, rpc.ErrNotificationsUnsupported
+	}
+	if filter == nil {
+		filter = &PendingTransactionsFilter{}
+	}
+
+	rpcSub := notifier.CreateSubscription()
+	go func() {
+		txs := make(chan core.NewTxsEvent, newTxsChanSize)
+		sub := api.b.SubscribeNewTxsEvent(txs)
+		defer sub.Unsubscribe()
+
+		for {
+			select {
+			case ev := <-txs:
+				header := api.b.CurrentHeader()
+				for _, tx := range ev.Txs {
+					if !filter.matches(tx) {
+						continue
+					}
+					if fullTx != nil && *fullTx {
+						_ = notifier.Notify(rpcSub.ID, NewRPCPendingTransaction(tx, header, api.b.ChainConfig()))
+					} else {
+						_ = notifier.Notify(rpcSub.ID, tx.Hash())
+					}
+				}
+			case <-sub.Err():
+				return
+			case <-rpcSub.Err():
+				return
+			case <-notifier.Closed():
+				return
+			}
+		}
+	}()
+
+	return rpcSub, nil
+}
+
+// matches returns true if the sender and recipient of the given transaction pass the filter.
+func (f *PendingTransactionsFilter) matches(tx *types.Transaction) bool {
+	if len(f.From) > 0 && !containsAddress(f.From, types.GetSender(tx)) {
+		return false
+	}
+	if len(f.To) > 0 && (tx.To() == nil || !containsAddress(f.To, *tx.To())) {
+		return false
+	}
+	return true
+}
+
+// containsAddress returns true if addr is in addrs.
+func containsAddress(addrs []common.Address, addr common.Address) bool {
+	for _, a := range addrs {
+		if a == addr {
+			return true
+		}
+	}
+	return false
+}
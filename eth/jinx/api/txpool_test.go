@@ -0,0 +1,186 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2023, Blackchain Foundation. All rights reserved.
+// Use of this software is govered by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package jinxapi_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"pkg.berachain.dev/jinx/eth/common"
+	"pkg.berachain.dev/jinx/eth/core"
+	"pkg.berachain.dev/jinx/eth/core/types"
+	"pkg.berachain.dev/jinx/eth/crypto"
+	jinxapi "pkg.berachain.dev/jinx/eth/jinx/api"
+	"pkg.berachain.dev/jinx/eth/params"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// txPoolBackend is a txpool subscription backend that announces the txs sent on its feed.
+type txPoolBackend struct {
+	txFeed event.Feed
+}
+
+func (b *txPoolBackend) ChainConfig() *params.ChainConfig {
+	return params.DefaultChainConfig
+}
+
+func (b *txPoolBackend) CurrentHeader() *types.Header {
+	return &types.Header{Number: big.NewInt(1), BaseFee: big.NewInt(1)}
+}
+
+func (b *txPoolBackend) SubscribeNewTxsEvent(ch chan<- core.NewTxsEvent) event.Subscription {
+	return b.txFeed.Subscribe(ch)
+}
+
+var _ = Describe("TxPool subscription API", func() {
+	var (
+		b      *txPoolBackend
+		server *rpc.Server
+		client *rpc.Client
+		signer = types.LatestSignerForChainID(params.DefaultChainConfig.ChainID)
+
+		key1, _ = crypto.GenerateEthKey()
+		addr1   = crypto.PubkeyToAddress(key1.PublicKey)
+		key2, _ = crypto.GenerateEthKey()
+		addr2   = crypto.PubkeyToAddress(key2.PublicKey)
+		to1     = common.HexToAddress("0x1")
+		to2     = common.HexToAddress("0x2")
+	)
+
+	signTx := func(key *ecdsa.PrivateKey, nonce uint64, to *common.Address) *types.Transaction {
+		return types.MustSignNewTx(key, signer, &types.DynamicFeeTx{
+			ChainID:   params.DefaultChainConfig.ChainID,
+			Nonce:     nonce,
+			To:        to,
+			Gas:       100000,
+			GasFeeCap: big.NewInt(2),
+			GasTipCap: big.NewInt(1),
+		})
+	}
+
+	// announce sends the txs once the subscription is listening to the feed.
+	announce := func(txs ...*types.Transaction) {
+		Eventually(func() int {
+			return b.txFeed.Send(core.NewTxsEvent{Txs: txs})
+		}).Should(Equal(1))
+	}
+
+	BeforeEach(func() {
+		b = &txPoolBackend{}
+		server = rpc.NewServer()
+		Expect(server.RegisterName("txpool", jinxapi.NewTxPoolSubscriptionAPI(b))).To(Succeed())
+		client = rpc.DialInProc(server)
+	})
+
+	AfterEach(func() {
+		client.Close()
+		server.Stop()
+	})
+
+	It("should require notifications support", func() {
+		_, err := jinxapi.NewTxPoolSubscriptionAPI(b).PendingTransactions(context.Background(), nil, nil)
+		Expect(err).To(MatchError(rpc.ErrNotificationsUnsupported))
+	})
+
+	It("should send the hashes of all pending txs without a filter", func() {
+		ch := make(chan common.Hash, 10)
+		sub, err := client.Subscribe(context.Background(), "txpool", ch, "pendingTransactions")
+		Expect(err).ToNot(HaveOccurred())
+		defer sub.Unsubscribe()
+
+		tx1, tx2, tx3 := signTx(key1, 0, &to1), signTx(key2, 0, &to2), signTx(key1, 1, nil)
+		announce(tx1, tx2, tx3)
+		Eventually(ch).Should(Receive(Equal(tx1.Hash())))
+		Eventually(ch).Should(Receive(Equal(tx2.Hash())))
+		Eventually(ch).Should(Receive(Equal(tx3.Hash())))
+		Consistently(ch).ShouldNot(Receive())
+	})
+
+	It("should filter the pending txs by sender", func() {
+		ch := make(chan common.Hash, 10)
+		filter := &jinxapi.PendingTransactionsFilter{From: []common.Address{addr2}}
+		sub, err := client.Subscribe(context.Background(), "txpool", ch, "pendingTransactions", filter)
+		Expect(err).ToNot(HaveOccurred())
+		defer sub.Unsubscribe()
+
+		tx1, tx2, tx3 := signTx(key1, 0, &to1), signTx(key2, 0, &to2), signTx(key2, 1, nil)
+		announce(tx1, tx2, tx3)
+		Eventually(ch).Should(Receive(Equal(tx2.Hash())))
+		Eventually(ch).Should(Receive(Equal(tx3.Hash())))
+		Consistently(ch).ShouldNot(Receive())
+	})
+
+	It("should filter the pending txs by recipient and skip contract creations", func() {
+		ch := make(chan common.Hash, 10)
+		filter := &jinxapi.PendingTransactionsFilter{To: []common.Address{to1, to2}}
+		sub, err := client.Subscribe(context.Background(), "txpool", ch, "pendingTransactions", filter)
+		Expect(err).ToNot(HaveOccurred())
+		defer sub.Unsubscribe()
+
+		tx1, tx2, tx3 := signTx(key1, 0, &to1), signTx(key1, 1, nil), signTx(key2, 0, &to2)
+		announce(tx1, tx2, tx3)
+		Eventually(ch).Should(Receive(Equal(tx1.Hash())))
+		Eventually(ch).Should(Receive(Equal(tx3.Hash())))
+		Consistently(ch).ShouldNot(Receive())
+	})
+
+	It("should require both the sender and the recipient to match", func() {
+		ch := make(chan common.Hash, 10)
+		filter := &jinxapi.PendingTransactionsFilter{
+			From: []common.Address{addr1},
+			To:   []common.Address{to2},
+		}
+		sub, err := client.Subscribe(context.Background(), "txpool", ch, "pendingTransactions", filter)
+		Expect(err).ToNot(HaveOccurred())
+		defer sub.Unsubscribe()
+
+		tx1, tx2, tx3 := signTx(key1, 0, &to1), signTx(key2, 0, &to2), signTx(key1, 1, &to2)
+		announce(tx1, tx2, tx3)
+		Eventually(ch).Should(Receive(Equal(tx3.Hash())))
+		Consistently(ch).ShouldNot(Receive())
+	})
+
+	It("should send the full pending txs if requested", func() {
+		ch := make(chan map[string]any, 10)
+		filter := &jinxapi.PendingTransactionsFilter{From: []common.Address{addr1}}
+		sub, err := client.Subscribe(
+			context.Background(), "txpool", ch, "pendingTransactions", filter, true,
+		)
+		Expect(err).ToNot(HaveOccurred())
+		defer sub.Unsubscribe()
+
+		tx1, tx2 := signTx(key1, 0, &to1), signTx(key2, 0, &to2)
+		announce(tx1, tx2)
+		var rpcTx map[string]any
+		Eventually(ch).Should(Receive(&rpcTx))
+		Expect(rpcTx["hash"]).To(Equal(tx1.Hash().Hex()))
+		Expect(common.HexToAddress(rpcTx["from"].(string))).To(Equal(addr1))
+		Expect(common.HexToAddress(rpcTx["to"].(string))).To(Equal(to1))
+		Expect(rpcTx["blockHash"]).To(BeNil())
+		Consistently(ch).ShouldNot(Receive())
+	})
+})
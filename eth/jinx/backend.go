@@ -53,6 +53,8 @@ type Backend interface {
 	jinxapi.EthBackend
 	jinxapi.NetBackend
 	jinxapi.Web3Backend
+	jinxapi.SimulateBackend
+	jinxapi.TxPoolSubscriptionBackend
 }
 
 // backend represents the backend for the JSON-RPC service.
@@ -81,10 +83,10 @@ func NewBackend(
 		logger:        log.Root(),
 	}
 
-	if cfg.GPO.Default == nil {
-		panic("cfg.GPO.Default is nil")
+	if cfg.RPCConfig.GPO.Default == nil {
+		panic("cfg.RPCConfig.GPO.Default is nil")
 	}
-	b.gpo = gasprice.NewOracle(b, *cfg.GPO)
+	b.gpo = gasprice.NewOracle(b, *cfg.RPCConfig.GPO)
 	return b
 }
 
@@ -108,14 +110,13 @@ func (b *backend) CurrentBlock() *types.Header {
 	return b.jinx.blockchain.CurrentHeader()
 }
 
-// SyncProgress returns the current progress of the sync algorithm.
+// SyncProgress returns the current progress of the sync algorithm, as reported by the host
+// chain node.
 func (b *backend) SyncProgress() ethereum.SyncProgress {
-	// Consider implementing this in the future.
-	b.logger.Warn("called eth.rpc.backend.SyncProgress", "sync_progress", "not implemented")
-	return ethereum.SyncProgress{
-		CurrentBlock: 0,
-		HighestBlock: 0,
-	}
+	progress := b.jinx.blockchain.SyncProgress()
+	b.logger.Debug("called eth.rpc.backend.SyncProgress",
+		"current_block", progress.CurrentBlock, "highest_block", progress.HighestBlock)
+	return progress
 }
 
 // SuggestGasTipCap returns the recommended gas tip cap for a new transaction.
@@ -149,17 +150,17 @@ func (b *backend) ExtRPCEnabled() bool {
 // RPCGasCap returns the global gas cap for eth_call over rpc: this is
 // if the user doesn't specify a cap.
 func (b *backend) RPCGasCap() uint64 {
-	return b.cfg.RPCGasCap
+	return b.cfg.RPCConfig.RPCGasCap
 }
 
 // RPCEVMTimeout returns the global timeout for eth_call over rpc.
 func (b *backend) RPCEVMTimeout() time.Duration {
-	return b.cfg.RPCEVMTimeout
+	return b.cfg.RPCConfig.RPCEVMTimeout
 }
 
 // RPCTxFeeCap returns the global gas price cap for transactions over rpc.
 func (b *backend) RPCTxFeeCap() float64 {
-	return b.cfg.RPCTxFeeCap
+	return b.cfg.RPCConfig.RPCTxFeeCap
 }
 
 // UnprotectedAllowed returns whether unprotected transactions are alloweds.
@@ -179,6 +180,11 @@ func (b *backend) SetHead(_ uint64) {
 	panic("not implemented")
 }
 
+// HeaderByNumber returns the header of the block with the given number. The block tags map to
+// the finality of the host chain: "latest" (and "pending") is the last executed block,
+// "finalized" is the last block committed by the host chain and "safe" is the last block with a
+// quorum of vote extensions of its validators. An error is returned if no block is finalized or
+// safe yet.
 func (b *backend) HeaderByNumber(_ context.Context, number rpc.BlockNumber) (*types.Header, error) {
 	switch number {
 	case rpc.PendingBlockNumber:
@@ -191,15 +197,13 @@ func (b *backend) HeaderByNumber(_ context.Context, number rpc.BlockNumber) (*ty
 	case rpc.LatestBlockNumber:
 		return b.jinx.blockchain.CurrentHeader(), nil
 	case rpc.FinalizedBlockNumber:
-		block := b.jinx.blockchain.CurrentFinalBlock()
-		if block != nil {
-			return block, nil
+		if header := b.jinx.blockchain.CurrentFinalBlock(); header != nil {
+			return header, nil
 		}
 		return nil, errors.New("finalized block not found")
 	case rpc.SafeBlockNumber:
-		block := b.jinx.blockchain.CurrentSafeBlock()
-		if block != nil {
-			return block, nil
+		if header := b.jinx.blockchain.CurrentSafeBlock(); header != nil {
+			return header, nil
 		}
 		return nil, errors.New("safe block not found")
 	case rpc.EarliestBlockNumber:
@@ -227,35 +231,28 @@ func (b *backend) HeaderByHash(_ context.Context, hash common.Hash) (*types.Head
 	return b.jinx.blockchain.GetHeaderByHash(hash), nil
 }
 
-// BlockByNumber returns the block with the given `number`.
-func (b *backend) BlockByNumber(_ context.Context, number rpc.BlockNumber) (*types.Block, error) {
-	// Pending block is only known by the miner
+// BlockByNumber returns the block with the given `number`. The block tags are resolved as in
+// HeaderByNumber.
+func (b *backend) BlockByNumber(ctx context.Context, number rpc.BlockNumber) (*types.Block, error) {
 	switch number {
-	case rpc.PendingBlockNumber:
-		// 	block := b.eth.miner.PendingBlock()
-		// 	return block, nil
-		//  TODO: handling pending in the miner.
-		header := b.jinx.blockchain.CurrentBlock()
-		return b.jinx.blockchain.GetBlock(header.Hash(), header.Number.Uint64()), nil
-
-	// Otherwise resolve and return the block
-	case rpc.LatestBlockNumber:
-		header := b.jinx.blockchain.CurrentBlock()
-		return b.jinx.blockchain.GetBlock(header.Hash(), header.Number.Uint64()), nil
-
-	case rpc.FinalizedBlockNumber:
-		header := b.jinx.blockchain.CurrentFinalBlock()
-		return b.jinx.blockchain.GetBlock(header.Hash(), header.Number.Uint64()), nil
-
-	case rpc.SafeBlockNumber:
-		header := b.jinx.blockchain.CurrentSafeBlock()
+	// Pending block is only known by the miner
+	// TODO: handling pending in the miner.
+	case rpc.PendingBlockNumber, rpc.LatestBlockNumber, rpc.FinalizedBlockNumber, rpc.SafeBlockNumber:
+		header, err := b.HeaderByNumber(ctx, number)
+		if header == nil || err != nil {
+			return nil, err
+		}
 		return b.jinx.blockchain.GetBlock(header.Hash(), header.Number.Uint64()), nil
 
 	case rpc.EarliestBlockNumber:
 		return b.jinx.blockchain.GetBlockByNumber(0), nil
 	}
 	// safe to assume number > 0
-	return b.jinx.blockchain.GetBlockByNumber(uint64(number)), nil
+	block := b.jinx.blockchain.GetBlockByNumber(uint64(number))
+	if block == nil && b.isPruned(uint64(number)) {
+		return nil, core.ErrPruned
+	}
+	return block, nil
 }
 
 // BlockByHash returns the block with the given `hash`.
@@ -264,6 +261,9 @@ func (b *backend) BlockByHash(_ context.Context, hash common.Hash) (*types.Block
 	b.logger.Debug("BlockByHash", "hash", hash, "block", block)
 	if block == nil {
 		b.logger.Error("eth.rpc.backend.BlockByHash", "hash", hash, "nil", true)
+		if b.isHashPruned(hash) {
+			return nil, core.ErrPruned
+		}
 		return nil, nil //nolint:nilnil // to match geth.
 	}
 	b.logger.Debug("called eth.rpc.backend.BlockByHash", "header", block.Header(),
@@ -278,6 +278,9 @@ func (b *backend) BlockByNumberOrHash(ctx context.Context, blockNrOrHash rpc.Blo
 	if hash, ok := blockNrOrHash.Hash(); ok {
 		block := b.jinx.blockchain.GetBlockByHash(hash)
 		if block == nil {
+			if b.isHashPruned(hash) {
+				return nil, core.ErrPruned
+			}
 			return nil, core.ErrBlockNotFound
 		}
 		// if blockNrOrHash.RequireCanonical && b.jinx.blockchain.GetCanonicalHash(header.Number.Uint64()) != hash {
@@ -377,7 +380,11 @@ func (b *backend) PendingBlockAndReceipts() (*types.Block, types.Receipts) {
 
 // GetReceipts returns the receipts for the given block hash.
 func (b *backend) GetReceipts(_ context.Context, hash common.Hash) (types.Receipts, error) {
-	return b.jinx.blockchain.GetReceiptsByHash(hash), nil
+	receipts := b.jinx.blockchain.GetReceiptsByHash(hash)
+	if receipts == nil && b.isHashPruned(hash) {
+		return nil, core.ErrPruned
+	}
+	return receipts, nil
 }
 
 // GetLogs returns the logs for the given block hash or number.
@@ -385,6 +392,9 @@ func (b *backend) GetLogs(
 	_ context.Context, blockHash common.Hash, number uint64,
 ) ([][]*types.Log, error) {
 	receipts := b.jinx.blockchain.GetReceiptsByHash(blockHash)
+	if receipts == nil && b.isPruned(number) {
+		return nil, core.ErrPruned
+	}
 	logs := make([][]*types.Log, len(receipts))
 	for i, receipt := range receipts {
 		logs[i] = receipt.Logs
@@ -393,6 +403,20 @@ func (b *backend) GetLogs(
 	return logs, nil
 }
 
+// isPruned returns whether the historical data of the block with the given number has been
+// pruned by the host chain.
+func (b *backend) isPruned(number uint64) bool {
+	return number < b.jinx.blockchain.EarliestBlockNumber()
+}
+
+// isHashPruned returns whether the historical data of the block with the given hash has been
+// pruned by the host chain. The block number is resolved from the header, so this is only known
+// for the blocks whose headers are still served by the host chain.
+func (b *backend) isHashPruned(hash common.Hash) bool {
+	header := b.jinx.blockchain.GetHeaderByHash(hash)
+	return header != nil && b.isPruned(header.Number.Uint64())
+}
+
 // GetTd returns the total difficulty of a block in the canonical chain.
 // This is hardcoded to 69, as it is only applicable in a PoW chain.
 func (b *backend) GetTd(_ context.Context, hash common.Hash) *big.Int {
@@ -402,17 +426,24 @@ func (b *backend) GetTd(_ context.Context, hash common.Hash) *big.Int {
 	return nil
 }
 
-// GetEVM returns a new EVM to be used for simulating a transaction, estimating gas etc.
-func (b *backend) GetEVM(ctx context.Context, msg *core.Message, state vm.GethStateDB,
-	header *types.Header, vmConfig *vm.Config, _ *vm.BlockContext,
+// GetEVM returns a new EVM to be used for simulating a transaction, estimating gas etc. If a
+// block context is given, e.g. with block overrides applied, the EVM runs in it instead of the
+// one of the header.
+func (b *backend) GetEVM(_ context.Context, msg *core.Message, state vm.GethStateDB,
+	header *types.Header, vmConfig *vm.Config, blockCtx *vm.BlockContext,
 ) (*vm.GethEVM, func() error) {
 	if vmConfig == nil {
 		b.logger.Debug("eth.rpc.backend.GetEVM", "vmConfig", "nil")
 		vmConfig = b.jinx.blockchain.GetVMConfig()
 	}
-	txContext := core.NewEVMTxContext(msg)
-	return b.jinx.blockchain.GetEVM(ctx, txContext,
-		utils.MustGetAs[vm.JinxStateDB](state), header, vmConfig), state.Error
+	// The EVM is built with the given block context, so that it also runs under the chain rules
+	// of the overridden block number and time.
+	if blockCtx == nil {
+		blockCtx = b.jinx.blockchain.NewEVMBlockContext(header)
+	}
+	evm := b.jinx.blockchain.NewEVM(*blockCtx, core.NewEVMTxContext(msg),
+		utils.MustGetAs[vm.JinxStateDB](state), vmConfig)
+	return evm, state.Error
 }
 
 // GetBlockContext returns a new block context to be used by a EVM.
@@ -544,14 +575,14 @@ func (b *backend) Version() string {
 	return chainID.String()
 }
 
+// Listening returns whether the host chain node is listening for network connections.
 func (b *backend) Listening() bool {
-	// TODO: Implement your code here
-	return true
+	return b.jinx.blockchain.Listening()
 }
 
+// PeerCount returns the number of peers the host chain node is connected to.
 func (b *backend) PeerCount() hexutil.Uint {
-	// TODO: Implement your code here
-	return 1
+	return hexutil.Uint(b.jinx.blockchain.PeerCount())
 }
 
 // ClientVersion returns the current client version.
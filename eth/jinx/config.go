@@ -21,15 +21,18 @@
 package jinx
 
 import (
+	"errors"
 	"fmt"
 	"math/big"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/BurntSushi/toml"
 
 	"github.com/ethereum/go-ethereum/eth/ethconfig"
 	"github.com/ethereum/go-ethereum/eth/gasprice"
+	"github.com/ethereum/go-ethereum/node"
 )
 
 const (
@@ -38,22 +41,85 @@ const (
 
 	// gpoDefault is the default gpo starting point.
 	gpoDefault = 1000000000
+
+	// defaultFilterTimeout is the default time after which an idle filter is uninstalled.
+	defaultFilterTimeout = 5 * time.Minute
+
+	// maxPort is the highest valid TCP port.
+	maxPort = 65535
 )
 
-// DefaultConfig returns the default JSON-RPC config.
+// DefaultConfig returns the default Jinx config.
 func DefaultConfig() *Config {
 	gpoConfig := ethconfig.FullNodeGPO
 	gpoConfig.Default = big.NewInt(gpoDefault)
 	return &Config{
-		GPO:           &gpoConfig,
-		RPCGasCap:     ethconfig.Defaults.RPCGasCap,
-		RPCTxFeeCap:   ethconfig.Defaults.RPCTxFeeCap,
-		RPCEVMTimeout: ethconfig.Defaults.RPCEVMTimeout,
+		NodeConfig: *DefaultGethNodeConfig(),
+		RPCConfig: RPCConfig{
+			GPO:           &gpoConfig,
+			RPCGasCap:     ethconfig.Defaults.RPCGasCap,
+			RPCTxFeeCap:   ethconfig.Defaults.RPCTxFeeCap,
+			RPCEVMTimeout: ethconfig.Defaults.RPCEVMTimeout,
+		},
+		GraphQLConfig: GraphQLConfig{
+			Enabled: false,
+		},
+		FilterConfig: FilterConfig{
+			LogCacheSize: ethconfig.Defaults.FilterLogCacheSize,
+			Timeout:      defaultFilterTimeout,
+		},
+		HistoryConfig: HistoryConfig{
+			Retention: 0,
+		},
+		ExecutionConfig: ExecutionConfig{
+			ParallelWorkers: 0,
+		},
 	}
 }
 
+// DefaultGethNodeConfig returns the default configuration of the networking stack. The data
+// directory is left empty, so that it can be set by the host chain.
+func DefaultGethNodeConfig() *node.Config {
+	nodeCfg := node.DefaultConfig
+	nodeCfg.DataDir = ""
+	nodeCfg.P2P.NoDiscovery = true
+	nodeCfg.P2P.MaxPeers = 0
+	nodeCfg.Name = clientIdentifier
+	nodeCfg.HTTPModules = append(nodeCfg.HTTPModules, "eth", "web3", "net", "jinx")
+	nodeCfg.WSModules = append(nodeCfg.WSModules, "eth", "txpool")
+	nodeCfg.HTTPHost = "0.0.0.0"
+	nodeCfg.WSHost = "0.0.0.0"
+	nodeCfg.WSOrigins = []string{"*"}
+	nodeCfg.HTTPCors = []string{"*"}
+	nodeCfg.HTTPVirtualHosts = []string{"*"}
+	return &nodeCfg
+}
+
 // Config represents the configurable parameters for Jinx.
 type Config struct {
+	// NodeConfig is the config of the networking stack that serves the JSON-RPC APIs, i.e. the
+	// HTTP and WebSocket servers, the API namespaces they expose, CORS, vhosts and timeouts.
+	NodeConfig node.Config
+
+	// RPCConfig is the config of the JSON-RPC APIs.
+	RPCConfig RPCConfig
+
+	// GraphQLConfig is the config of the GraphQL API.
+	GraphQLConfig GraphQLConfig
+
+	// FilterConfig is the config of the filter system that backs the filter APIs.
+	FilterConfig FilterConfig
+
+	// HistoryConfig is the config of the historical data (blocks, receipts and transactions)
+	// kept by the node.
+	HistoryConfig HistoryConfig
+
+	// ExecutionConfig is the config of the execution of the transactions of a block.
+	ExecutionConfig ExecutionConfig
+}
+
+// RPCConfig represents the configurable parameters of the JSON-RPC APIs.
+type RPCConfig struct {
 	// Gas Price Oracle config.
 	GPO *gasprice.Config
 
@@ -68,9 +134,46 @@ type Config struct {
 	RPCTxFeeCap float64 `toml:""`
 }
 
-// LoadConfigFromFilePath reads in a Jinx config file from the fileystem.
+// GraphQLConfig represents the configurable parameters of the GraphQL API. The CORS domains and
+// virtual hosts of the GraphQL API are set by `GraphQLCors` and `GraphQLVirtualHosts` in the
+// node config.
+type GraphQLConfig struct {
+	// Enabled is whether the GraphQL API is served on the HTTP server.
+	Enabled bool `toml:""`
+}
+
+// FilterConfig represents the configurable parameters of the filter system.
+type FilterConfig struct {
+	// LogCacheSize is the maximum number of cached blocks for the logs of the filter system.
+	LogCacheSize int `toml:""`
+
+	// Timeout is the time after which an idle filter is uninstalled.
+	Timeout time.Duration `toml:""`
+}
+
+// HistoryConfig represents the configurable parameters of the historical data kept by the node.
+// The historical data is node-local and not part of the consensus state, so every operator is
+// free to choose how much of it to keep.
+type HistoryConfig struct {
+	// Retention is the number of most recent blocks whose historical data is kept. Older data is
+	// pruned in the background. 0 keeps the historical data of every block (archive node).
+	Retention uint64 `toml:""`
+}
+
+// ExecutionConfig represents the configurable parameters of the execution of the transactions of
+// a block. The outcome of the execution does not depend on them, so every operator is free to
+// choose them.
+type ExecutionConfig struct {
+	// ParallelWorkers is the number of workers that execute the Ethereum transactions of a block
+	// in parallel. Parallel execution is disabled unless it is above one.
+	ParallelWorkers int `toml:""`
+}
+
+// LoadConfigFromFilePath reads in a Jinx config file from the fileystem. The values in the file
+// override the defaults, unknown keys are rejected and the resulting config is validated. Files
+// in the legacy flat layout, which only held the RPC config at the top level, are still loaded.
 func LoadConfigFromFilePath(filename string) (*Config, error) {
-	var config Config
+	config := DefaultConfig()
 
 	// Read the TOML file
 	bytes, err := os.ReadFile(filename) //#nosec: G304 // required.
@@ -79,9 +182,88 @@ func LoadConfigFromFilePath(filename string) (*Config, error) {
 	}
 
 	// Unmarshal the TOML data into a struct
-	if err = toml.Unmarshal(bytes, &config); err != nil {
+	md, err := toml.Decode(string(bytes), config)
+	if err != nil {
 		return nil, fmt.Errorf("error parsing TOML data: %w", err)
 	}
+	if undecoded := md.Undecoded(); len(undecoded) > 0 {
+		// The legacy flat layout held the RPC config at the top level.
+		var legacyMd toml.MetaData
+		if legacyMd, err = toml.Decode(string(bytes), &config.RPCConfig); err != nil {
+			return nil, fmt.Errorf("error parsing TOML data: %w", err)
+		}
+
+		var keys []string
+		for _, key := range undecoded {
+			if legacyMd.IsDefined(key...) || deprecatedKeys[key.String()] {
+				continue
+			}
+			keys = append(keys, key.String())
+		}
+		if len(keys) > 0 {
+			return nil, fmt.Errorf("unknown keys in %s: %s", filename, strings.Join(keys, ", "))
+		}
+	}
+
+	if err = config.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid config %s: %w", filename, err)
+	}
+	return config, nil
+}
+
+// deprecatedKeys are the keys of older config files that are no longer used, but still accepted.
+var deprecatedKeys = map[string]bool{
+	"NodeConfig.Name":    true,
+	"NodeConfig.Version": true,
+}
 
-	return &config, nil
+// Validate returns an error if the config is not usable by Jinx.
+func (c *Config) Validate() error {
+	for name, port := range map[string]int{
+		"NodeConfig.HTTPPort": c.NodeConfig.HTTPPort,
+		"NodeConfig.WSPort":   c.NodeConfig.WSPort,
+		"NodeConfig.AuthPort": c.NodeConfig.AuthPort,
+	} {
+		if port < 0 || port > maxPort {
+			return fmt.Errorf("%s must be between 0 and %d, got %d", name, maxPort, port)
+		}
+	}
+	if c.NodeConfig.HTTPTimeouts.ReadTimeout < 0 ||
+		c.NodeConfig.HTTPTimeouts.ReadHeaderTimeout < 0 ||
+		c.NodeConfig.HTTPTimeouts.WriteTimeout < 0 ||
+		c.NodeConfig.HTTPTimeouts.IdleTimeout < 0 {
+		return errors.New("NodeConfig.HTTPTimeouts must not be negative")
+	}
+
+	gpo := c.RPCConfig.GPO
+	switch {
+	case gpo == nil:
+		return errors.New("RPCConfig.GPO must be set")
+	case gpo.Default == nil || gpo.Default.Sign() <= 0:
+		return errors.New("RPCConfig.GPO.Default must be positive")
+	case gpo.Blocks <= 0:
+		return errors.New("RPCConfig.GPO.Blocks must be positive")
+	case gpo.Percentile < 0 || gpo.Percentile > 100: //nolint:gomnd // percentile.
+		return errors.New("RPCConfig.GPO.Percentile must be between 0 and 100")
+	case gpo.MaxPrice != nil && gpo.MaxPrice.Cmp(gpo.Default) < 0:
+		return errors.New("RPCConfig.GPO.MaxPrice must not be lower than RPCConfig.GPO.Default")
+	}
+	if c.RPCConfig.RPCEVMTimeout < 0 {
+		return errors.New("RPCConfig.RPCEVMTimeout must not be negative")
+	}
+	if c.RPCConfig.RPCTxFeeCap < 0 {
+		return errors.New("RPCConfig.RPCTxFeeCap must not be negative")
+	}
+
+	if c.FilterConfig.LogCacheSize < 0 {
+		return errors.New("FilterConfig.LogCacheSize must not be negative")
+	}
+	if c.FilterConfig.Timeout < 0 {
+		return errors.New("FilterConfig.Timeout must not be negative")
+	}
+
+	if c.ExecutionConfig.ParallelWorkers < 0 {
+		return errors.New("ExecutionConfig.ParallelWorkers must not be negative")
+	}
+	return nil
 }
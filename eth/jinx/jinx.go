@@ -25,8 +25,6 @@ import (
 	"os"
 	"time"
 
-	"github.com/ethereum/go-ethereum/cmd/utils"
-	"github.com/ethereum/go-ethereum/eth/ethconfig"
 	"github.com/ethereum/go-ethereum/eth/filters"
 	"github.com/ethereum/go-ethereum/graphql"
 
@@ -40,11 +38,6 @@ import (
 // abstracted away networking stack, by extension we will need to improve the registration
 // architecture.
 
-var defaultEthConfig = ethconfig.Config{
-	SyncMode:           0,
-	FilterLogCacheSize: 0,
-}
-
 // NetworkingStack defines methods that allow a Jinx chain to build and expose JSON-RPC apis.
 type NetworkingStack interface {
 	// IsExtRPCEnabled returns true if the networking stack is configured to expose JSON-RPC APIs.
@@ -74,6 +67,10 @@ type Jinx struct {
 	// backend is utilize by the api handlers as a middleware between the JSON-RPC APIs and the blockchain.
 	backend Backend
 
+	// host is the chain running the Jinx EVM, which serves the jinx JSON-RPC APIs if it
+	// implements them.
+	host core.JinxHostChain
+
 	// filterSystem is the filter system that is used by the filter API.
 	// TODO: relocate
 	filterSystem *filters.FilterSystem
@@ -88,6 +85,7 @@ func NewWithNetworkingStack(
 	pl := &Jinx{
 		cfg:        cfg,
 		blockchain: core.NewChain(host),
+		host:       host,
 		stack:      stack,
 	}
 	// When creating a Jinx EVM, we allow the implementing chain
@@ -112,8 +110,8 @@ func (pl *Jinx) APIs() []rpc.API {
 	// Grab a bunch of the apis from go-ethereum (thx bae)
 	apis := jinxapi.GethAPIs(pl.backend, pl.blockchain)
 
-	// Append all the local APIs and return
-	return append(apis, []rpc.API{
+	// Append all the local APIs
+	apis = append(apis, []rpc.API{
 		{
 			Namespace: "net",
 			Service:   jinxapi.NewNetAPI(pl.backend),
@@ -122,7 +120,24 @@ func (pl *Jinx) APIs() []rpc.API {
 			Namespace: "web3",
 			Service:   jinxapi.NewWeb3API(pl.backend),
 		},
+		{
+			Namespace: "eth",
+			Service:   jinxapi.NewSimulateAPI(pl.backend),
+		},
+		{
+			Namespace: "txpool",
+			Service:   jinxapi.NewTxPoolSubscriptionAPI(pl.backend),
+		},
 	}...)
+
+	// Append the jinx APIs if the host chain serves them and return
+	if jb, ok := pl.host.(jinxapi.JinxBackend); ok {
+		apis = append(apis, rpc.API{
+			Namespace: "jinx",
+			Service:   jinxapi.NewJinxAPI(jb),
+		})
+	}
+	return apis
 }
 
 // StartServices notifies the NetworkStack to spin up (i.e json-rpc).
@@ -131,12 +146,25 @@ func (pl *Jinx) StartServices() error {
 	pl.stack.RegisterAPIs(pl.APIs())
 
 	// Register the filter API separately in order to get access to the filterSystem
-	pl.filterSystem = utils.RegisterFilterAPI(pl.stack, pl.backend, &defaultEthConfig)
-
-	// Register the GraphQL API (todo update cors stuff)
-	// TODO: gate this behind a flag
-	if err := graphql.New(pl.stack, pl.backend, pl.filterSystem, []string{"*"}, []string{"*"}); err != nil {
-		return err
+	pl.filterSystem = filters.NewFilterSystem(pl.backend, filters.Config{
+		LogCacheSize: pl.cfg.FilterConfig.LogCacheSize,
+		Timeout:      pl.cfg.FilterConfig.Timeout,
+	})
+	pl.stack.RegisterAPIs([]rpc.API{
+		{
+			Namespace: "eth",
+			Service:   filters.NewFilterAPI(pl.filterSystem, false),
+		},
+	})
+
+	// Register the GraphQL API, if enabled.
+	if pl.cfg.GraphQLConfig.Enabled {
+		if err := graphql.New(
+			pl.stack, pl.backend, pl.filterSystem,
+			pl.cfg.NodeConfig.GraphQLCors, pl.cfg.NodeConfig.GraphQLVirtualHosts,
+		); err != nil {
+			return err
+		}
 	}
 
 	go func() {
@@ -22,7 +22,9 @@ package jinx
 
 import (
 	"context"
+	"math/big"
 
+	"pkg.berachain.dev/jinx/eth/common"
 	"pkg.berachain.dev/jinx/eth/core"
 	"pkg.berachain.dev/jinx/eth/core/types"
 )
@@ -39,6 +41,47 @@ func (pl *Jinx) ProcessTransaction(ctx context.Context, tx *types.Transaction) (
 	return pl.blockchain.ProcessTransaction(ctx, tx)
 }
 
+// ProcessTransactionFrom processes the given transaction from the given sender, which authorized
+// it on the host chain instead of signing it.
+func (pl *Jinx) ProcessTransactionFrom(
+	ctx context.Context, tx *types.Transaction, from common.Address,
+) (*core.ExecutionResult, error) {
+	return pl.blockchain.ProcessTransactionFrom(ctx, tx, from)
+}
+
+// ProcessTransactionsParallel processes the given transactions in parallel on the given number of
+// workers, with the same outcome as processing them in order.
+func (pl *Jinx) ProcessTransactionsParallel(
+	ctx context.Context, txs types.Transactions, workers int,
+) ([]*core.ExecutionResult, error) {
+	return pl.blockchain.ProcessTransactionsParallel(ctx, txs, workers)
+}
+
+// ProcessSystemCall executes a call, or a contract creation if the recipient is nil, from the given
+// sender outside of any transaction.
+func (pl *Jinx) ProcessSystemCall(
+	ctx context.Context, sender common.Address, to *common.Address, data []byte,
+	value *big.Int, gasLimit uint64, commit bool,
+) (*core.ExecutionResult, common.Address, error) {
+	return pl.blockchain.ProcessSystemCall(ctx, sender, to, data, value, gasLimit, commit)
+}
+
+// ProcessBeaconRoot stores the given root of the host chain in the EIP-4788 beacon roots contract.
+func (pl *Jinx) ProcessBeaconRoot(ctx context.Context, root common.Hash) error {
+	return pl.blockchain.ProcessBeaconRoot(ctx, root)
+}
+
+// SetPostTxHook sets the hook that is called with the receipt of every successful transaction.
+func (pl *Jinx) SetPostTxHook(hook core.PostTxHook) {
+	pl.blockchain.SetPostTxHook(hook)
+}
+
+// ProcessSyntheticLogs adds the given logs of host chain events to the current block in a
+// synthetic receipt.
+func (pl *Jinx) ProcessSyntheticLogs(ctx context.Context, source []byte, logs []*types.Log) {
+	pl.blockchain.ProcessSyntheticLogs(ctx, source, logs)
+}
+
 // Finalize finalizes the current block.
 func (pl *Jinx) Finalize(ctx context.Context) error {
 	return pl.blockchain.Finalize(ctx)
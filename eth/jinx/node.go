@@ -53,22 +53,3 @@ func (n *Node) Start() error {
 	// We then start the underlying node.
 	return n.Node.Start()
 }
-
-// DefaultConfig returns the default configuration for the provider.
-// TODO: DEPRECATE THIS
-func DefaultGethNodeConfig() *node.Config {
-	nodeCfg := node.DefaultConfig
-	nodeCfg.P2P.NoDiscovery = true
-	nodeCfg.P2P.MaxPeers = 0
-	nodeCfg.Name = clientIdentifier
-	nodeCfg.HTTPModules = append(nodeCfg.HTTPModules, "eth", "web3", "net")
-	nodeCfg.WSModules = append(nodeCfg.WSModules, "eth")
-	nodeCfg.HTTPHost = "0.0.0.0"
-	nodeCfg.WSHost = "0.0.0.0"
-	nodeCfg.WSOrigins = []string{"*"}
-	nodeCfg.HTTPCors = []string{"*"}
-	nodeCfg.HTTPVirtualHosts = []string{"*"}
-	nodeCfg.GraphQLCors = []string{"*"}
-	nodeCfg.GraphQLVirtualHosts = []string{"*"}
-	return &nodeCfg
-}
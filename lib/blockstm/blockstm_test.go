@@ -0,0 +1,338 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright (c) 2023 Blackchain Foundation
+//
+// Permission is hereby granted, free of charge, to any person
+// obtaining a copy of this software and associated documentation
+// files (the "Software"), to deal in the Software without
+// restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following
+// conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+
+package blockstm
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/rand"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestBlockSTM(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "lib/blockstm")
+}
+
+// kvState is the state a test transaction runs on.
+type kvState interface {
+	get(key string) uint64
+	set(key string, value uint64)
+	del(key string)
+	sum(prefix string) uint64
+}
+
+// mapState is the state of sequential execution.
+type mapState map[string][]byte
+
+func (s mapState) get(key string) uint64 { return decode(s[key]) }
+
+func (s mapState) set(key string, value uint64) { s[key] = encode(value) }
+
+func (s mapState) del(key string) { delete(s, key) }
+
+func (s mapState) sum(prefix string) uint64 {
+	total := uint64(0)
+	for key, value := range s {
+		if strings.HasPrefix(key, prefix) {
+			total += decode(value)
+		}
+	}
+	return total
+}
+
+// viewState is the state of parallel execution, a view on top of read-only storage.
+type viewState struct {
+	view    *View
+	storage mapState
+}
+
+func (s *viewState) get(key string) uint64 {
+	if value, found := s.view.Get(key); found {
+		return decode(value)
+	}
+	return s.storage.get(key)
+}
+
+func (s *viewState) set(key string, value uint64) { s.view.Set(key, encode(value)) }
+
+func (s *viewState) del(key string) { s.view.Delete(key) }
+
+func (s *viewState) sum(prefix string) uint64 {
+	merged := make(map[string][]byte)
+	for key, value := range s.storage {
+		if strings.HasPrefix(key, prefix) {
+			merged[key] = value
+		}
+	}
+	for _, pair := range s.view.Range([]byte(prefix), []byte(prefix+"\xff")) {
+		if pair.Value == nil {
+			delete(merged, pair.Key)
+		} else {
+			merged[pair.Key] = pair.Value
+		}
+	}
+	return mapState(merged).sum(prefix)
+}
+
+func encode(value uint64) []byte {
+	return binary.BigEndian.AppendUint64(nil, value)
+}
+
+func decode(bz []byte) uint64 {
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// transfer moves amount from one account to another and returns whether it succeeded.
+func transfer(s kvState, from, to string, amount uint64) bool {
+	balance := s.get(from)
+	if balance < amount {
+		return false
+	}
+	s.set(from, balance-amount)
+	s.set(to, s.get(to)+amount)
+	s.set("nonce/"+from, s.get("nonce/"+from)+1)
+	return true
+}
+
+// txFunc is a test transaction, which returns its result.
+type txFunc func(s kvState) uint64
+
+// randomBlock returns a block of transfers between the given number of accounts, with some
+// transactions reading or deleting ranges of accounts.
+func randomBlock(r *rand.Rand, numTxs, numAccounts int) []txFunc {
+	txs := make([]txFunc, numTxs)
+	for i := range txs {
+		from := fmt.Sprintf("acc/%03d", r.Intn(numAccounts))
+		to := fmt.Sprintf("acc/%03d", r.Intn(numAccounts))
+		amount := uint64(r.Intn(100))
+		switch r.Intn(10) {
+		case 0:
+			txs[i] = func(s kvState) uint64 {
+				total := s.sum("acc/")
+				s.set("total", total)
+				return total
+			}
+		case 1:
+			txs[i] = func(s kvState) uint64 {
+				s.del(to)
+				return 0
+			}
+		default:
+			txs[i] = func(s kvState) uint64 {
+				if transfer(s, from, to, amount) {
+					return 1
+				}
+				return 0
+			}
+		}
+	}
+	return txs
+}
+
+// genesis returns the storage with the given number of funded accounts.
+func genesis(numAccounts int) mapState {
+	storage := make(mapState)
+	for i := 0; i < numAccounts; i++ {
+		storage.set(fmt.Sprintf("acc/%03d", i), 1000)
+	}
+	return storage
+}
+
+// runSequential runs the block in order and returns the results and final state.
+func runSequential(txs []txFunc, storage mapState) ([]uint64, mapState) {
+	state := make(mapState, len(storage))
+	for key, value := range storage {
+		state[key] = value
+	}
+	results := make([]uint64, len(txs))
+	for i, tx := range txs {
+		results[i] = tx(state)
+	}
+	return results, state
+}
+
+// runParallel runs the block with Block-STM and returns the results and final state.
+func runParallel(txs []txFunc, storage mapState, workers int) ([]uint64, mapState) {
+	results := make([]uint64, len(txs))
+	writes, err := Execute(len(txs), workers, func(txIdx int, view *View) {
+		results[txIdx] = txs[txIdx](&viewState{view: view, storage: storage})
+	})
+	Expect(err).ToNot(HaveOccurred())
+	Expect(sort.SliceIsSorted(writes, func(i, j int) bool {
+		return writes[i].Key < writes[j].Key
+	})).To(BeTrue())
+
+	return results, apply(storage, writes)
+}
+
+// apply returns the storage with the given writes applied.
+func apply(storage mapState, writes []KVPair) mapState {
+	_, state := runSequential(nil, storage)
+	for _, pair := range writes {
+		if pair.Value == nil {
+			delete(state, pair.Key)
+		} else {
+			state[pair.Key] = pair.Value
+		}
+	}
+	return state
+}
+
+var _ = Describe("Execute", func() {
+	It("should match sequential execution", func() {
+		r := rand.New(rand.NewSource(1))
+		for _, numAccounts := range []int{2, 10, 100} {
+			for _, workers := range []int{1, 4, 16} {
+				storage := genesis(numAccounts)
+				txs := randomBlock(r, 200, numAccounts)
+
+				expectedResults, expectedState := runSequential(txs, storage)
+				results, state := runParallel(txs, storage, workers)
+				Expect(results).To(Equal(expectedResults))
+				Expect(state).To(Equal(expectedState))
+			}
+		}
+	})
+
+	It("should execute independent transactions once", func() {
+		var executions atomic.Int64
+		storage := genesis(100)
+		writes, err := Execute(50, 8, func(txIdx int, view *View) {
+			executions.Add(1)
+			s := &viewState{view: view, storage: storage}
+			transfer(s, fmt.Sprintf("acc/%03d", 2*txIdx), fmt.Sprintf("acc/%03d", 2*txIdx+1), 10)
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(executions.Load()).To(Equal(int64(50)))
+		Expect(writes).To(HaveLen(150))
+	})
+
+	It("should execute conflicting transactions in order", func() {
+		storage := genesis(1)
+		results := make([]uint64, 100)
+		_, err := Execute(100, 8, func(txIdx int, view *View) {
+			s := &viewState{view: view, storage: storage}
+			counter := s.get("counter")
+			s.set("counter", counter+1)
+			results[txIdx] = counter
+		})
+		Expect(err).ToNot(HaveOccurred())
+		for i, result := range results {
+			Expect(result).To(Equal(uint64(i)))
+		}
+	})
+
+	It("should abort an incarnation that recovers from a dependency", func() {
+		storage := genesis(1)
+		results := make([]uint64, 20)
+		_, err := Execute(20, 4, func(txIdx int, view *View) {
+			defer func() { _ = recover() }()
+			s := &viewState{view: view, storage: storage}
+			counter := s.get("counter")
+			s.set("counter", counter+1)
+			results[txIdx] = counter
+		})
+		Expect(err).ToNot(HaveOccurred())
+		for i, result := range results {
+			Expect(result).To(Equal(uint64(i)))
+		}
+	})
+
+	It("should discard the writes of rejected transactions", func() {
+		// every transaction sends a third of the balance of the first account to the second, but
+		// is rejected if the balance left would be odd.
+		tx := func(s kvState) bool {
+			transfer(s, "acc/000", "acc/001", s.get("acc/000")/3)
+			return s.get("acc/000")%2 == 0
+		}
+		storage := genesis(2)
+		storage.set("acc/000", 1<<20)
+
+		_, expectedState := runSequential(nil, storage)
+		for i := 0; i < 50; i++ {
+			_, scratch := runSequential(nil, expectedState)
+			if tx(scratch) {
+				expectedState = scratch
+			}
+		}
+
+		writes, err := Execute(50, 8, func(txIdx int, view *View) {
+			if !tx(&viewState{view: view, storage: storage}) {
+				view.Discard()
+			}
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(apply(storage, writes)).To(Equal(expectedState))
+	})
+
+	It("should return the panic of a final incarnation", func() {
+		_, err := Execute(10, 4, func(txIdx int, view *View) {
+			view.Set(fmt.Sprint(txIdx), []byte{1})
+			if txIdx == 7 {
+				panic("boom")
+			}
+		})
+		Expect(err).To(MatchError(ErrTaskPanicked))
+		Expect(err.Error()).To(ContainSubstring("tx 7: boom"))
+	})
+
+	It("should report the accesses to watched keys", func() {
+		touched := make([]bool, 6)
+		_, err := Execute(len(touched), 4, func(txIdx int, view *View) {
+			view.Watch("acc/001", "\xff\xff")
+			switch txIdx {
+			case 0:
+				view.Get("acc/0010")
+			case 1:
+				view.Set("acc/001", []byte{1})
+			case 2:
+				view.Delete("acc/002")
+			case 3:
+				view.Range([]byte("acc/000"), []byte("acc/0011"))
+			case 4:
+				view.Range([]byte("acc/002"), nil)
+			case 5:
+				view.Range([]byte("acc/000"), []byte("acc/001"))
+			}
+			touched[txIdx] = view.Touched()
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(touched).To(Equal([]bool{true, true, false, true, true, false}))
+	})
+
+	It("should do nothing without transactions", func() {
+		writes, err := Execute(0, 4, func(int, *View) { Fail("unexpected task") })
+		Expect(err).ToNot(HaveOccurred())
+		Expect(writes).To(BeEmpty())
+	})
+})
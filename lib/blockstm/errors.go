@@ -0,0 +1,43 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright (c) 2023 Blackchain Foundation
+//
+// Permission is hereby granted, free of charge, to any person
+// obtaining a copy of this software and associated documentation
+// files (the "Software"), to deal in the Software without
+// restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following
+// conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+
+package blockstm
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrTaskPanicked is returned when the final incarnation of a transaction panicked.
+var ErrTaskPanicked = errors.New("task panicked")
+
+// DependencyError is raised when a transaction reads a value that a lower transaction is about to
+// write again.
+type DependencyError struct {
+	TxIdx         int
+	BlockingTxIdx int
+}
+
+// Error implements `error`.
+func (e *DependencyError) Error() string {
+	return fmt.Sprintf("tx %d depends on tx %d", e.TxIdx, e.BlockingTxIdx)
+}
@@ -0,0 +1,143 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright (c) 2023 Blackchain Foundation
+//
+// Permission is hereby granted, free of charge, to any person
+// obtaining a copy of this software and associated documentation
+// files (the "Software"), to deal in the Software without
+// restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following
+// conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+
+package blockstm
+
+import (
+	"runtime"
+	"sync"
+
+	"pkg.berachain.dev/jinx/lib/errors"
+)
+
+// Task executes the transaction at the given index in the block, reading and writing the state
+// of the block through the given view. A task is run once per incarnation of the transaction,
+// possibly on top of the writes of lower transactions that are later aborted, so it must not
+// have side effects outside of the view, except for keeping the result of the transaction.
+// Incarnations of the same transaction never run concurrently and the result of the last one is
+// the result of the transaction.
+type Task func(txIdx int, view *View)
+
+// executor runs the tasks of a block with Block-STM.
+type executor struct {
+	task      Task
+	mv        *mvMemory
+	scheduler *scheduler
+
+	// panics holds the panic of the latest incarnation of every transaction, if any.
+	panics []any
+}
+
+// Execute runs the tasks of the transactions of a block on the given number of workers, with
+// optimistic concurrency. Transactions are executed speculatively and in parallel, and every
+// incarnation is validated once lower transactions are done. An incarnation that read a value
+// that has since changed is aborted and executed again, so that the outcome is the one of
+// executing the transactions in order. It returns the final value of every key written by the
+// block, in ascending order of the keys.
+func Execute(numTxs, workers int, task Task) ([]KVPair, error) {
+	if workers < 1 {
+		workers = 1
+	}
+
+	e := &executor{
+		task:      task,
+		mv:        newMVMemory(numTxs),
+		scheduler: newScheduler(numTxs),
+		panics:    make([]any, numTxs),
+	}
+	if numTxs == 0 {
+		return nil, nil
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for i := 0; i < workers; i++ {
+		go func() {
+			defer wg.Done()
+			e.run()
+		}()
+	}
+	wg.Wait()
+
+	for txIdx, r := range e.panics {
+		if r != nil {
+			return nil, errors.Wrapf(ErrTaskPanicked, "tx %d: %v", txIdx, r)
+		}
+	}
+	return e.mv.snapshot(), nil
+}
+
+// run is the loop of a worker, which runs tasks until the scheduler is done.
+func (e *executor) run() {
+	var t task
+	for !e.scheduler.isDone() {
+		switch t.kind {
+		case executionTask:
+			t = e.tryExecute(t.version)
+		case validationTask:
+			t = e.needsReexecution(t.version)
+		case noTask:
+			if t = e.scheduler.nextTask(); t.kind == noTask {
+				runtime.Gosched()
+			}
+		}
+	}
+}
+
+// tryExecute executes the incarnation and records its reads and writes. If it reads a value that
+// is about to be written again, it waits for the blocking transaction instead.
+func (e *executor) tryExecute(version Version) task {
+	for {
+		view := e.execute(version)
+		if view.blockedOn == nil {
+			wroteNewKey := e.mv.record(version, &view.reads, view.writes)
+			return e.scheduler.finishExecution(version, wroteNewKey)
+		}
+		if e.scheduler.addDependency(version.TxIdx, view.blockedOn.BlockingTxIdx) {
+			return task{}
+		}
+	}
+}
+
+// execute runs the task of the incarnation and returns its view. A panic of the task discards the
+// writes of the incarnation and is kept, to be returned if the incarnation is the final one.
+func (e *executor) execute(version Version) (view *View) {
+	view = newView(e.mv, version.TxIdx)
+	e.panics[version.TxIdx] = nil
+	defer func() {
+		if r := recover(); r != nil && view.blockedOn == nil {
+			e.panics[version.TxIdx] = r
+			view.writes = make(map[string][]byte)
+		}
+	}()
+	e.task(version.TxIdx, view)
+	return view
+}
+
+// needsReexecution validates the incarnation and aborts it if its reads have changed.
+func (e *executor) needsReexecution(version Version) task {
+	aborted := !e.mv.validateReadSet(version.TxIdx) && e.scheduler.tryValidationAbort(version)
+	if aborted {
+		e.mv.convertWritesToEstimates(version.TxIdx)
+	}
+	return e.scheduler.finishValidation(version.TxIdx, aborted)
+}
@@ -0,0 +1,314 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright (c) 2023 Blackchain Foundation
+//
+// Permission is hereby granted, free of charge, to any person
+// obtaining a copy of this software and associated documentation
+// files (the "Software"), to deal in the Software without
+// restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following
+// conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+
+package blockstm
+
+import (
+	"sort"
+	"sync"
+	"sync/atomic"
+)
+
+// Version identifies the incarnation of a transaction that wrote a value.
+type Version struct {
+	TxIdx       int
+	Incarnation int
+}
+
+// KVPair is a key and the value written to it. A nil value marks a deleted key.
+type KVPair struct {
+	Key   string
+	Value []byte
+}
+
+// entry is a value written to a key by an incarnation of a transaction. An estimate marks the
+// value of an aborted incarnation, which is likely to be written again by the next incarnation.
+type entry struct {
+	version  Version
+	value    []byte
+	estimate bool
+}
+
+// versions holds the values written to a key by the transactions of the block, in order of the
+// transactions.
+type versions struct {
+	mu      sync.RWMutex
+	entries []*entry
+}
+
+// search returns the position of the first entry written by a transaction at or after txIdx.
+func (vs *versions) search(txIdx int) int {
+	return sort.Search(len(vs.entries), func(i int) bool {
+		return vs.entries[i].version.TxIdx >= txIdx
+	})
+}
+
+// readStatus is the outcome of reading a key from the multi-version memory.
+type readStatus int
+
+const (
+	// readStorage means that no lower transaction wrote the key, so it is read from storage.
+	readStorage readStatus = iota
+	// readValue means that the value written by a lower transaction is read.
+	readValue
+	// readDependency means that the value is an estimate of a lower transaction, so the reader
+	// must wait for it to be executed again.
+	readDependency
+)
+
+// readDescriptor records a read of a key by a transaction. The version is only set if the value
+// was written by a lower transaction.
+type readDescriptor struct {
+	key     string
+	status  readStatus
+	version Version
+}
+
+// rangeDescriptor records a read of the keys in [start, end), written by lower transactions.
+type rangeDescriptor struct {
+	start, end []byte
+	reads      []readDescriptor
+}
+
+// readSet is the set of reads of an incarnation of a transaction.
+type readSet struct {
+	reads  []readDescriptor
+	ranges []rangeDescriptor
+}
+
+// mvMemory is the multi-version memory of Block-STM. It holds, for every key, the values written
+// to it by the latest incarnation of every transaction, so that a transaction reads the value
+// written by the highest transaction below it.
+type mvMemory struct {
+	mu   sync.RWMutex
+	data map[string]*versions
+
+	// lastWrites are the keys written by the latest incarnation of every transaction.
+	lastWrites [][]string
+	// lastReads are the reads of the latest incarnation of every transaction.
+	lastReads []atomic.Pointer[readSet]
+}
+
+// newMVMemory creates a new, empty multi-version memory for a block of numTxs transactions.
+func newMVMemory(numTxs int) *mvMemory {
+	return &mvMemory{
+		data:       make(map[string]*versions),
+		lastWrites: make([][]string, numTxs),
+		lastReads:  make([]atomic.Pointer[readSet], numTxs),
+	}
+}
+
+// versionsOf returns the versions of the given key, creating them if create is true.
+func (m *mvMemory) versionsOf(key string, create bool) *versions {
+	m.mu.RLock()
+	vs, found := m.data[key]
+	m.mu.RUnlock()
+	if found || !create {
+		return vs
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if vs, found = m.data[key]; !found {
+		vs = &versions{}
+		m.data[key] = vs
+	}
+	return vs
+}
+
+// read returns the value of the key written by the highest transaction below txIdx.
+func (m *mvMemory) read(key string, txIdx int) ([]byte, readDescriptor) {
+	rd := readDescriptor{key: key, status: readStorage}
+	vs := m.versionsOf(key, false)
+	if vs == nil {
+		return nil, rd
+	}
+
+	vs.mu.RLock()
+	defer vs.mu.RUnlock()
+	pos := vs.search(txIdx)
+	if pos == 0 {
+		return nil, rd
+	}
+	e := vs.entries[pos-1]
+	rd.version = e.version
+	if e.estimate {
+		rd.status = readDependency
+		return nil, rd
+	}
+	rd.status = readValue
+	return e.value, rd
+}
+
+// readRange returns the keys in [start, end) written by transactions below txIdx and their
+// values, in ascending order of the keys.
+func (m *mvMemory) readRange(start, end []byte, txIdx int) ([]KVPair, rangeDescriptor) {
+	m.mu.RLock()
+	keys := make([]string, 0)
+	for key := range m.data {
+		if inRange(key, start, end) {
+			keys = append(keys, key)
+		}
+	}
+	m.mu.RUnlock()
+	sort.Strings(keys)
+
+	rd := rangeDescriptor{start: start, end: end}
+	pairs := make([]KVPair, 0, len(keys))
+	for _, key := range keys {
+		value, r := m.read(key, txIdx)
+		switch r.status {
+		case readStorage:
+			continue
+		case readDependency:
+			rd.reads = []readDescriptor{r}
+			return nil, rd
+		case readValue:
+			rd.reads = append(rd.reads, r)
+			pairs = append(pairs, KVPair{Key: key, Value: value})
+		}
+	}
+	return pairs, rd
+}
+
+// record stores the writes of the given incarnation of a transaction and its reads. It returns
+// whether a key was written that the previous incarnation did not write.
+func (m *mvMemory) record(version Version, rs *readSet, writes map[string][]byte) bool {
+	txIdx := version.TxIdx
+	wroteNewKey := false
+	prevWrites := make(map[string]struct{}, len(m.lastWrites[txIdx]))
+	for _, key := range m.lastWrites[txIdx] {
+		prevWrites[key] = struct{}{}
+	}
+
+	keys := make([]string, 0, len(writes))
+	for key, value := range writes {
+		keys = append(keys, key)
+		if _, found := prevWrites[key]; found {
+			delete(prevWrites, key)
+		} else {
+			wroteNewKey = true
+		}
+		m.write(key, &entry{version: version, value: value})
+	}
+
+	// remove the values the previous incarnation wrote which this one did not write.
+	for key := range prevWrites {
+		m.remove(key, txIdx)
+	}
+
+	m.lastWrites[txIdx] = keys
+	m.lastReads[txIdx].Store(rs)
+	return wroteNewKey
+}
+
+// write sets the value of the key written by the transaction of the entry.
+func (m *mvMemory) write(key string, e *entry) {
+	vs := m.versionsOf(key, true)
+	vs.mu.Lock()
+	defer vs.mu.Unlock()
+	pos := vs.search(e.version.TxIdx)
+	if pos < len(vs.entries) && vs.entries[pos].version.TxIdx == e.version.TxIdx {
+		vs.entries[pos] = e
+		return
+	}
+	vs.entries = append(vs.entries, nil)
+	copy(vs.entries[pos+1:], vs.entries[pos:])
+	vs.entries[pos] = e
+}
+
+// remove removes the value of the key written by the given transaction.
+func (m *mvMemory) remove(key string, txIdx int) {
+	vs := m.versionsOf(key, false)
+	vs.mu.Lock()
+	defer vs.mu.Unlock()
+	if pos := vs.search(txIdx); pos < len(vs.entries) && vs.entries[pos].version.TxIdx == txIdx {
+		vs.entries = append(vs.entries[:pos], vs.entries[pos+1:]...)
+	}
+}
+
+// convertWritesToEstimates marks the values written by the latest incarnation of the given
+// transaction as estimates, after it was aborted.
+func (m *mvMemory) convertWritesToEstimates(txIdx int) {
+	for _, key := range m.lastWrites[txIdx] {
+		vs := m.versionsOf(key, false)
+		vs.mu.Lock()
+		if pos := vs.search(txIdx); pos < len(vs.entries) && vs.entries[pos].version.TxIdx == txIdx {
+			vs.entries[pos].estimate = true
+		}
+		vs.mu.Unlock()
+	}
+}
+
+// validateReadSet returns whether the reads of the latest incarnation of the given transaction
+// would still read the same values.
+func (m *mvMemory) validateReadSet(txIdx int) bool {
+	rs := m.lastReads[txIdx].Load()
+	if rs == nil {
+		return false
+	}
+	for _, prev := range rs.reads {
+		if _, cur := m.read(prev.key, txIdx); !sameRead(prev, cur) {
+			return false
+		}
+	}
+	for _, prev := range rs.ranges {
+		_, cur := m.readRange(prev.start, prev.end, txIdx)
+		if len(cur.reads) != len(prev.reads) {
+			return false
+		}
+		for i := range cur.reads {
+			if !sameRead(prev.reads[i], cur.reads[i]) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// snapshot returns the final value of every key written by the block, in ascending order of the
+// keys.
+func (m *mvMemory) snapshot() []KVPair {
+	pairs := make([]KVPair, 0, len(m.data))
+	for key, vs := range m.data {
+		if len(vs.entries) == 0 {
+			continue
+		}
+		pairs = append(pairs, KVPair{Key: key, Value: vs.entries[len(vs.entries)-1].value})
+	}
+	sort.Slice(pairs, func(i, j int) bool { return pairs[i].Key < pairs[j].Key })
+	return pairs
+}
+
+// sameRead returns whether two reads of the same key read the same value. Reading an estimate
+// is never the same.
+func sameRead(prev, cur readDescriptor) bool {
+	if prev.key != cur.key || prev.status != cur.status || cur.status == readDependency {
+		return false
+	}
+	return cur.status == readStorage || prev.version == cur.version
+}
+
+// inRange returns whether the key is in [start, end), where nil bounds are unbounded.
+func inRange(key string, start, end []byte) bool {
+	return (start == nil || key >= string(start)) && (end == nil || key < string(end))
+}
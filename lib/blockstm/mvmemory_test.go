@@ -0,0 +1,89 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright (c) 2023 Blackchain Foundation
+//
+// Permission is hereby granted, free of charge, to any person
+// obtaining a copy of this software and associated documentation
+// files (the "Software"), to deal in the Software without
+// restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following
+// conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+
+package blockstm
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("mvMemory", func() {
+	var mv *mvMemory
+
+	BeforeEach(func() {
+		mv = newMVMemory(4)
+		mv.record(Version{TxIdx: 0}, &readSet{}, map[string][]byte{"a": {0}, "b": {0}})
+		mv.record(Version{TxIdx: 2}, &readSet{}, map[string][]byte{"a": {2}})
+	})
+
+	It("should read the value of the highest lower transaction", func() {
+		value, rd := mv.read("a", 0)
+		Expect(value).To(BeNil())
+		Expect(rd.status).To(Equal(readStorage))
+
+		value, rd = mv.read("a", 2)
+		Expect(value).To(Equal([]byte{0}))
+		Expect(rd.version).To(Equal(Version{TxIdx: 0}))
+
+		value, rd = mv.read("a", 3)
+		Expect(value).To(Equal([]byte{2}))
+		Expect(rd.version).To(Equal(Version{TxIdx: 2}))
+	})
+
+	It("should read estimates as dependencies", func() {
+		mv.convertWritesToEstimates(2)
+		_, rd := mv.read("a", 3)
+		Expect(rd.status).To(Equal(readDependency))
+		Expect(rd.version.TxIdx).To(Equal(2))
+	})
+
+	It("should remove the writes of a previous incarnation", func() {
+		Expect(mv.record(Version{TxIdx: 2, Incarnation: 1}, &readSet{}, map[string][]byte{"c": {2}})).
+			To(BeTrue())
+		value, _ := mv.read("a", 3)
+		Expect(value).To(Equal([]byte{0}))
+		Expect(mv.record(Version{TxIdx: 2, Incarnation: 2}, &readSet{}, map[string][]byte{"c": {3}})).
+			To(BeFalse())
+	})
+
+	It("should read ranges", func() {
+		pairs, rd := mv.readRange([]byte("a"), []byte("c"), 3)
+		Expect(pairs).To(Equal([]KVPair{{Key: "a", Value: []byte{2}}, {Key: "b", Value: []byte{0}}}))
+		Expect(rd.reads).To(HaveLen(2))
+	})
+
+	It("should validate read sets", func() {
+		view := newView(mv, 3)
+		view.Get("a")
+		view.Range(nil, nil)
+		mv.record(Version{TxIdx: 3}, &view.reads, view.writes)
+		Expect(mv.validateReadSet(3)).To(BeTrue())
+
+		mv.record(Version{TxIdx: 1}, &readSet{}, map[string][]byte{"d": {1}})
+		Expect(mv.validateReadSet(3)).To(BeFalse())
+	})
+
+	It("should snapshot the final values", func() {
+		Expect(mv.snapshot()).To(Equal([]KVPair{{Key: "a", Value: []byte{2}}, {Key: "b", Value: []byte{0}}}))
+	})
+})
@@ -0,0 +1,291 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright (c) 2023 Blackchain Foundation
+//
+// Permission is hereby granted, free of charge, to any person
+// obtaining a copy of this software and associated documentation
+// files (the "Software"), to deal in the Software without
+// restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following
+// conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+
+package blockstm
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// status is the execution status of a transaction.
+type status int
+
+const (
+	readyToExecute status = iota
+	executing
+	executed
+	aborting
+)
+
+// taskKind is the kind of a task handed out by the scheduler.
+type taskKind int
+
+const (
+	noTask taskKind = iota
+	executionTask
+	validationTask
+)
+
+// task is an execution or validation of an incarnation of a transaction.
+type task struct {
+	kind    taskKind
+	version Version
+}
+
+// txStatus is the incarnation and execution status of a transaction.
+type txStatus struct {
+	mu          sync.Mutex
+	incarnation int
+	status      status
+}
+
+// txDependencies are the transactions that wait for a transaction to be executed.
+type txDependencies struct {
+	mu  sync.Mutex
+	txs []int
+}
+
+// scheduler is the collaborative scheduler of Block-STM. It hands out the execution and
+// validation tasks of the transactions of the block to the workers, in order of the
+// transactions, preferring validations over executions of higher transactions.
+type scheduler struct {
+	numTxs int
+
+	executionIdx   atomic.Int64
+	validationIdx  atomic.Int64
+	decreaseCnt    atomic.Int64
+	numActiveTasks atomic.Int64
+	done           atomic.Bool
+
+	statuses     []txStatus
+	dependencies []txDependencies
+}
+
+// newScheduler creates a new scheduler for a block of numTxs transactions.
+func newScheduler(numTxs int) *scheduler {
+	return &scheduler{
+		numTxs:       numTxs,
+		statuses:     make([]txStatus, numTxs),
+		dependencies: make([]txDependencies, numTxs),
+	}
+}
+
+// isDone returns whether all the transactions are executed and validated.
+func (s *scheduler) isDone() bool {
+	return s.done.Load()
+}
+
+// decreaseExecutionIdx lowers the next transaction to execute to target.
+func (s *scheduler) decreaseExecutionIdx(target int) {
+	storeMin(&s.executionIdx, int64(target))
+	s.decreaseCnt.Add(1)
+}
+
+// decreaseValidationIdx lowers the next transaction to validate to target.
+func (s *scheduler) decreaseValidationIdx(target int) {
+	storeMin(&s.validationIdx, int64(target))
+	s.decreaseCnt.Add(1)
+}
+
+// checkDone marks the scheduler done if there are no more tasks to hand out and no active tasks
+// that may create new ones.
+func (s *scheduler) checkDone() {
+	observedCnt := s.decreaseCnt.Load()
+	if s.executionIdx.Load() >= int64(s.numTxs) && s.validationIdx.Load() >= int64(s.numTxs) &&
+		s.numActiveTasks.Load() == 0 && observedCnt == s.decreaseCnt.Load() {
+		s.done.Store(true)
+	}
+}
+
+// tryIncarnate starts the execution of the next incarnation of the transaction, if it is ready
+// to be executed.
+func (s *scheduler) tryIncarnate(txIdx int) (Version, bool) {
+	if txIdx < s.numTxs {
+		st := &s.statuses[txIdx]
+		st.mu.Lock()
+		if st.status == readyToExecute {
+			st.status = executing
+			incarnation := st.incarnation
+			st.mu.Unlock()
+			return Version{TxIdx: txIdx, Incarnation: incarnation}, true
+		}
+		st.mu.Unlock()
+	}
+	s.numActiveTasks.Add(-1)
+	return Version{}, false
+}
+
+// nextVersionToExecute returns the next incarnation to execute, if any.
+func (s *scheduler) nextVersionToExecute() (Version, bool) {
+	if s.executionIdx.Load() >= int64(s.numTxs) {
+		s.checkDone()
+		return Version{}, false
+	}
+	s.numActiveTasks.Add(1)
+	return s.tryIncarnate(int(s.executionIdx.Add(1) - 1))
+}
+
+// nextVersionToValidate returns the next executed incarnation to validate, if any.
+func (s *scheduler) nextVersionToValidate() (Version, bool) {
+	if s.validationIdx.Load() >= int64(s.numTxs) {
+		s.checkDone()
+		return Version{}, false
+	}
+	s.numActiveTasks.Add(1)
+	if txIdx := int(s.validationIdx.Add(1) - 1); txIdx < s.numTxs {
+		st := &s.statuses[txIdx]
+		st.mu.Lock()
+		incarnation, status := st.incarnation, st.status
+		st.mu.Unlock()
+		if status == executed {
+			return Version{TxIdx: txIdx, Incarnation: incarnation}, true
+		}
+	}
+	s.numActiveTasks.Add(-1)
+	return Version{}, false
+}
+
+// nextTask returns the next task to run, validations of lower transactions first.
+func (s *scheduler) nextTask() task {
+	if s.validationIdx.Load() < s.executionIdx.Load() {
+		if version, ok := s.nextVersionToValidate(); ok {
+			return task{kind: validationTask, version: version}
+		}
+	} else if version, ok := s.nextVersionToExecute(); ok {
+		return task{kind: executionTask, version: version}
+	}
+	return task{}
+}
+
+// addDependency suspends the execution of the transaction until the blocking transaction is
+// executed. It returns false if the blocking transaction was executed in the meantime, in which
+// case the execution should be retried.
+func (s *scheduler) addDependency(txIdx, blockingTxIdx int) bool {
+	deps := &s.dependencies[blockingTxIdx]
+	deps.mu.Lock()
+	defer deps.mu.Unlock()
+
+	blocking := &s.statuses[blockingTxIdx]
+	blocking.mu.Lock()
+	if blocking.status == executed {
+		blocking.mu.Unlock()
+		return false
+	}
+	blocking.mu.Unlock()
+
+	st := &s.statuses[txIdx]
+	st.mu.Lock()
+	st.status = aborting
+	st.mu.Unlock()
+
+	deps.txs = append(deps.txs, txIdx)
+	s.numActiveTasks.Add(-1)
+	return true
+}
+
+// setReady makes the next incarnation of an aborted transaction ready to be executed.
+func (s *scheduler) setReady(txIdx int) {
+	st := &s.statuses[txIdx]
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	st.incarnation++
+	st.status = readyToExecute
+}
+
+// finishExecution marks the incarnation executed and resumes the transactions that waited for
+// it. It returns the validation of the incarnation, if it is to be run right away.
+func (s *scheduler) finishExecution(version Version, wroteNewKey bool) task {
+	st := &s.statuses[version.TxIdx]
+	st.mu.Lock()
+	st.status = executed
+	st.mu.Unlock()
+
+	deps := &s.dependencies[version.TxIdx]
+	deps.mu.Lock()
+	resumed := deps.txs
+	deps.txs = nil
+	deps.mu.Unlock()
+
+	if len(resumed) > 0 {
+		minTxIdx := resumed[0]
+		for _, txIdx := range resumed {
+			s.setReady(txIdx)
+			if txIdx < minTxIdx {
+				minTxIdx = txIdx
+			}
+		}
+		s.decreaseExecutionIdx(minTxIdx)
+	}
+
+	if s.validationIdx.Load() > int64(version.TxIdx) {
+		// Higher transactions may have read the keys that are new, so they must be validated
+		// again, otherwise only this transaction is validated.
+		if !wroteNewKey {
+			return task{kind: validationTask, version: version}
+		}
+		s.decreaseValidationIdx(version.TxIdx)
+	}
+	s.numActiveTasks.Add(-1)
+	return task{}
+}
+
+// tryValidationAbort aborts the incarnation if it is still the executed one. Only the first
+// failed validation of an incarnation aborts it.
+func (s *scheduler) tryValidationAbort(version Version) bool {
+	st := &s.statuses[version.TxIdx]
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	if st.incarnation == version.Incarnation && st.status == executed {
+		st.status = aborting
+		return true
+	}
+	return false
+}
+
+// finishValidation schedules the aborted transaction to execute again, as well as the
+// validation of all higher transactions. It returns the execution of the next incarnation, if it
+// is to be run right away.
+func (s *scheduler) finishValidation(txIdx int, aborted bool) task {
+	if aborted {
+		s.setReady(txIdx)
+		s.decreaseValidationIdx(txIdx + 1)
+		if s.executionIdx.Load() > int64(txIdx) {
+			if version, ok := s.tryIncarnate(txIdx); ok {
+				return task{kind: executionTask, version: version}
+			}
+			return task{}
+		}
+	}
+	s.numActiveTasks.Add(-1)
+	return task{}
+}
+
+// storeMin atomically lowers the value to target, if it is higher.
+func storeMin(value *atomic.Int64, target int64) {
+	for {
+		cur := value.Load()
+		if cur <= target || value.CompareAndSwap(cur, target) {
+			return
+		}
+	}
+}
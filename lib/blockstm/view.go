@@ -0,0 +1,174 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright (c) 2023 Blackchain Foundation
+//
+// Permission is hereby granted, free of charge, to any person
+// obtaining a copy of this software and associated documentation
+// files (the "Software"), to deal in the Software without
+// restriction, including without limitation the rights to use,
+// copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following
+// conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+// HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY,
+
+package blockstm
+
+import (
+	"sort"
+	"strings"
+)
+
+// View is the state of the block as seen by an incarnation of a transaction. Reads return the
+// values written by the transaction itself or by the highest transaction below it, and are
+// recorded so that the incarnation can be validated. Writes are buffered in the view until the
+// incarnation is done. Keys that were not written by the block must be read from storage.
+//
+// Reading a value that a lower transaction is about to write again suspends the incarnation, by
+// panicking with a `*DependencyError` that the executor recovers from. The incarnation is
+// aborted even if the panic is recovered by the task.
+type View struct {
+	mv    *mvMemory
+	txIdx int
+
+	reads     readSet
+	writes    map[string][]byte
+	blockedOn *DependencyError
+
+	// watched are the prefixes of the keys watched by Watch, and touched is whether the
+	// incarnation accessed one of them.
+	watched []string
+	touched bool
+}
+
+// newView creates a new, empty view for an incarnation of the transaction at txIdx.
+func newView(mv *mvMemory, txIdx int) *View {
+	return &View{
+		mv:     mv,
+		txIdx:  txIdx,
+		writes: make(map[string][]byte),
+	}
+}
+
+// TxIndex returns the index in the block of the transaction of the view.
+func (v *View) TxIndex() int {
+	return v.txIdx
+}
+
+// Get returns the value of the key and true if it was written by the block, where a nil value
+// means that the key was deleted. It returns false if the key must be read from storage.
+func (v *View) Get(key string) ([]byte, bool) {
+	v.touch(key)
+	if value, found := v.writes[key]; found {
+		return value, true
+	}
+
+	value, rd := v.mv.read(key, v.txIdx)
+	if rd.status == readDependency {
+		v.suspend(rd.version.TxIdx)
+	}
+	v.reads.reads = append(v.reads.reads, rd)
+	return value, rd.status == readValue
+}
+
+// Set sets the value of the key.
+func (v *View) Set(key string, value []byte) {
+	v.touch(key)
+	v.writes[key] = value
+}
+
+// Delete deletes the key.
+func (v *View) Delete(key string) {
+	v.touch(key)
+	v.writes[key] = nil
+}
+
+// Discard discards the writes of the incarnation, e.g. when the transaction is rejected. Its
+// reads are kept, as they decided the outcome.
+func (v *View) Discard() {
+	v.writes = make(map[string][]byte)
+}
+
+// Range returns the keys in [start, end) that were written by the block, in ascending order, and
+// their values, where a nil value means that the key was deleted. The keys must be merged with
+// the keys in storage. Nil bounds are unbounded.
+func (v *View) Range(start, end []byte) []KVPair {
+	for _, prefix := range v.watched {
+		after, bounded := prefixEnd(prefix)
+		if (end == nil || string(end) > prefix) &&
+			(start == nil || !bounded || string(start) < after) {
+			v.touched = true
+		}
+	}
+	pairs, rd := v.mv.readRange(start, end, v.txIdx)
+	if len(rd.reads) > 0 && rd.reads[0].status == readDependency {
+		v.suspend(rd.reads[0].version.TxIdx)
+	}
+	v.reads.ranges = append(v.reads.ranges, rd)
+
+	// overlay the writes of the transaction itself.
+	overlaid := make(map[string][]byte, len(pairs))
+	for _, pair := range pairs {
+		overlaid[pair.Key] = pair.Value
+	}
+	for key, value := range v.writes {
+		if inRange(key, start, end) {
+			overlaid[key] = value
+		}
+	}
+
+	pairs = make([]KVPair, 0, len(overlaid))
+	for key, value := range overlaid {
+		pairs = append(pairs, KVPair{Key: key, Value: value})
+	}
+	sort.Slice(pairs, func(i, j int) bool { return pairs[i].Key < pairs[j].Key })
+	return pairs
+}
+
+// Watch watches the keys with the given prefixes, which the caller can not allow the incarnation
+// to access, e.g. as it keeps their state outside of the view. Touched returns whether the
+// incarnation read, wrote or ranged over any of them.
+func (v *View) Watch(prefixes ...string) {
+	v.watched = append(v.watched, prefixes...)
+}
+
+// Touched returns whether the incarnation accessed any of the keys watched by Watch.
+func (v *View) Touched() bool {
+	return v.touched
+}
+
+// touch records an access to the given key, if it is watched.
+func (v *View) touch(key string) {
+	for _, prefix := range v.watched {
+		if strings.HasPrefix(key, prefix) {
+			v.touched = true
+		}
+	}
+}
+
+// prefixEnd returns the first key after all the keys with the given prefix, and false if there is
+// none, i.e. if the prefix is made of 0xff bytes only.
+func prefixEnd(prefix string) (string, bool) {
+	end := []byte(prefix)
+	for i := len(end) - 1; i >= 0; i-- {
+		if end[i] < 0xff { //nolint:gomnd // the highest byte.
+			end[i]++
+			return string(end[:i+1]), true
+		}
+	}
+	return "", false
+}
+
+// suspend aborts the incarnation until the blocking transaction is executed again.
+func (v *View) suspend(blockingTxIdx int) {
+	v.blockedOn = &DependencyError{TxIdx: v.txIdx, BlockingTxIdx: blockingTxIdx}
+	panic(v.blockedOn)
+}